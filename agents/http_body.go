@@ -0,0 +1,138 @@
+package agents
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+const acceptEncoding = "gzip, deflate, br"
+
+// responseBody is a response body that has been decoded and streamed to a
+// temporary file.
+type responseBody struct {
+	Filename  string
+	Size      int64
+	Truncated bool
+	Err       error
+}
+
+// saveResponseBody decodes the content encodings of resp and streams the
+// body to a temporary file in dir, keeping at most limit bytes of the decoded
+// body. A limit of 0 means no limit. Errors that leave the saved body
+// incomplete are recorded in Err; if the content encoding can't be decoded
+// at all, the body is saved as received.
+func saveResponseBody(resp *http.Response, dir string, limit int64) (*responseBody, error) {
+	f, err := ioutil.TempFile(dir, "body-*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	f.Chmod(0644)
+
+	body := &responseBody{Filename: f.Name()}
+	var reader io.Reader = resp.Body
+	if encoding := resp.Header.Get("Content-Encoding"); encoding != "" {
+		capture := &captureReader{r: resp.Body, capture: true}
+		decoded, err := decodeContent(capture, encoding)
+		capture.capture = false
+		if err != nil {
+			body.Err = fmt.Errorf("saved without decoding, %v", err)
+			reader = io.MultiReader(&capture.buf, resp.Body)
+		} else {
+			reader = decoded
+		}
+	}
+	if limit > 0 {
+		reader = io.LimitReader(reader, limit+1)
+	}
+
+	n, err := io.Copy(f, reader)
+	if err != nil && body.Err == nil {
+		body.Err = err
+	}
+	if limit > 0 && n > limit {
+		if err := f.Truncate(limit); err != nil {
+			return body, err
+		}
+		n = limit
+		body.Truncated = true
+	}
+	body.Size = n
+	return body, nil
+}
+
+// Remove deletes the temporary file unless it has been moved elsewhere.
+func (b *responseBody) Remove() {
+	if b != nil {
+		os.Remove(b.Filename)
+	}
+}
+
+// decodeContent wraps r in readers that decode the content codings listed
+// in encoding, in the reverse order of which they were applied.
+func decodeContent(r io.Reader, encoding string) (io.Reader, error) {
+	codings := strings.Split(encoding, ",")
+	for i := len(codings) - 1; i >= 0; i-- {
+		coding := strings.ToLower(strings.TrimSpace(codings[i]))
+		br := bufio.NewReader(r)
+		if _, err := br.Peek(1); err == io.EOF {
+			return br, nil
+		}
+		switch coding {
+		case "", "identity":
+			r = br
+		case "gzip", "x-gzip":
+			zr, err := gzip.NewReader(br)
+			if err != nil {
+				return nil, fmt.Errorf("invalid gzip content: %v", err)
+			}
+			r = zr
+		case "deflate":
+			r = newDeflateReader(br)
+		case "br":
+			r = brotli.NewReader(br)
+		default:
+			return nil, fmt.Errorf("unsupported content encoding: %s", coding)
+		}
+	}
+	return r, nil
+}
+
+// newDeflateReader returns a reader for deflate content, which should be
+// zlib wrapped but is sent as raw deflate data by some servers.
+func newDeflateReader(r *bufio.Reader) io.Reader {
+	header, err := r.Peek(2)
+	if err == nil && header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		if zr, err := zlib.NewReader(r); err == nil {
+			return zr
+		}
+	}
+	return flate.NewReader(r)
+}
+
+// captureReader keeps a copy of the data read while capture is set, so that
+// the raw body can still be saved when setting up decoders fails.
+type captureReader struct {
+	r       io.Reader
+	buf     bytes.Buffer
+	capture bool
+}
+
+func (c *captureReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	if c.capture {
+		c.buf.Write(b[:n])
+	}
+	return n, err
+}
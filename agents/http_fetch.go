@@ -0,0 +1,138 @@
+package agents
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+// fetchRequest describes a small HTTP request made by agents that need more
+// than the page response, like favicons or well-known files.
+type fetchRequest struct {
+	Method         string
+	URL            string
+	Host           string
+	Header         http.Header
+	Body           string
+	FollowRedirect bool
+	Limit          int64
+	Transcript     bool
+}
+
+type fetchResponse struct {
+	URL        string
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+	Truncated  bool
+	Transcript []byte
+}
+
+// fetchLimit returns the body size limit for a fetch whose body is read into
+// memory: max, or -max-body-size when it is smaller.
+func fetchLimit(s *core.Session, max int64) int64 {
+	if limit := int64(s.Options.MaxBodySize); limit > 0 && limit < max {
+		return limit
+	}
+	return max
+}
+
+// fetchURL performs r through the session's proxies, client certificates and
+// HTTP settings, reading at most r.Limit bytes of the response body.
+func fetchURL(s *core.Session, component string, r fetchRequest) (*fetchResponse, error) {
+	agent, proxy, err := Gorequest(s, component, HostnameFromURL(r.URL))
+	if err != nil {
+		return nil, err
+	}
+
+	method := r.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if r.Body != "" {
+		body = strings.NewReader(r.Body)
+	}
+	req, err := http.NewRequest(method, r.URL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", RandomUserAgent())
+	for _, h := range s.Options.HTTPHeaders {
+		header := strings.SplitN(h, ":", 2)
+		if len(header) > 1 {
+			req.Header.Set(header[0], strings.TrimSpace(header[1]))
+		}
+	}
+	for name, values := range r.Header {
+		req.Header[name] = values
+	}
+	if r.Host != "" {
+		req.Host = r.Host
+		// The Host header is also sent as SNI value, as HTTPS virtual hosts
+		// are selected by it.
+		agent.Transport.TLSClientConfig.ServerName = r.Host
+		if host, _, err := net.SplitHostPort(r.Host); err == nil {
+			agent.Transport.TLSClientConfig.ServerName = host
+		}
+	}
+
+	var recorder *transcriptRecorder
+	if r.Transcript {
+		recorder = newTranscriptRecorder()
+		tunneled := proxy != nil && (proxy.IsSOCKS() || strings.HasPrefix(r.URL, "https://"))
+		recorder.Attach(agent.Transport, agent.Transport.TLSClientConfig, tunneled)
+	}
+
+	client := &http.Client{
+		Transport: agent.Transport,
+		Timeout:   time.Duration(s.Options.HTTPTimeout) * time.Millisecond,
+	}
+	if !r.FollowRedirect {
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		proxy.CheckError(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	proxy.MarkAlive()
+
+	var reader io.Reader = resp.Body
+	if r.Limit > 0 {
+		reader = io.LimitReader(resp.Body, r.Limit+1)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &fetchResponse{
+		URL:        resp.Request.URL.String(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       data,
+	}
+	if r.Limit > 0 && int64(len(data)) > r.Limit {
+		response.Body = data[:r.Limit]
+		response.Truncated = true
+	}
+	if recorder != nil {
+		if recorder.LastExchange() == nil || recorder.Reconstructed {
+			recorder.Reconstruct(resp)
+		}
+		response.Transcript = recorder.Bytes(r.URL, resp.Proto)
+	}
+	return response, nil
+}
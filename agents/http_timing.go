@@ -0,0 +1,91 @@
+package agents
+
+import (
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+// requestTimer collects the timing of each phase of a HTTP request through
+// net/http/httptrace hooks.
+type requestTimer struct {
+	sync.Mutex
+	start        time.Time
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	firstByte    time.Time
+	done         time.Time
+}
+
+func newRequestTimer() *requestTimer {
+	return &requestTimer{}
+}
+
+func (t *requestTimer) ClientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.record(&t.dnsStart)
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.record(&t.dnsDone)
+		},
+		ConnectStart: func(string, string) {
+			t.record(&t.connectStart)
+		},
+		ConnectDone: func(string, string, error) {
+			t.record(&t.connectDone)
+		},
+		TLSHandshakeStart: func() {
+			t.record(&t.tlsStart)
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.record(&t.tlsDone)
+		},
+		GotFirstResponseByte: func() {
+			t.record(&t.firstByte)
+		},
+	}
+}
+
+func (t *requestTimer) record(field *time.Time) {
+	t.Lock()
+	defer t.Unlock()
+	*field = time.Now()
+}
+
+func (t *requestTimer) Start() {
+	t.record(&t.start)
+}
+
+func (t *requestTimer) Done() {
+	t.record(&t.done)
+}
+
+// Timing returns the duration of each phase of the request. Phases that did
+// not happen, like DNS lookups of IP addresses, are left at zero.
+func (t *requestTimer) Timing() *core.Timing {
+	t.Lock()
+	defer t.Unlock()
+	return &core.Timing{
+		DNSLookup:    milliseconds(t.dnsStart, t.dnsDone),
+		TCPConnect:   milliseconds(t.connectStart, t.connectDone),
+		TLSHandshake: milliseconds(t.tlsStart, t.tlsDone),
+		FirstByte:    milliseconds(t.start, t.firstByte),
+		Download:     milliseconds(t.firstByte, t.done),
+		Total:        milliseconds(t.start, t.done),
+	}
+}
+
+func milliseconds(start time.Time, end time.Time) float64 {
+	if start.IsZero() || end.IsZero() || end.Before(start) {
+		return 0
+	}
+	return float64(end.Sub(start).Microseconds()) / 1000
+}
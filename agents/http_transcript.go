@@ -0,0 +1,238 @@
+package agents
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/http/httputil"
+	"sync"
+	"time"
+)
+
+const maxTranscriptRequestSize = 64 * 1024
+
+type transcriptExchange struct {
+	RemoteAddr string
+	TLS        *tls.ConnectionState
+	Request    []byte
+	Response   []byte
+}
+
+// transcriptRecorder records the raw bytes of HTTP requests and response
+// heads as they go over the wire, so that headers keep their original order
+// and casing.
+type transcriptRecorder struct {
+	sync.Mutex
+	StartedAt     time.Time
+	Exchanges     []*transcriptExchange
+	Reconstructed bool
+}
+
+func newTranscriptRecorder() *transcriptRecorder {
+	return &transcriptRecorder{
+		StartedAt: time.Now(),
+	}
+}
+
+// Attach hooks the recorder into the dial functions of transport. Requests
+// to HTTPS URLs through a proxy are encrypted inside the tunnel and can't be
+// recorded, so those transcripts are reconstructed from the parsed response.
+func (r *transcriptRecorder) Attach(transport *http.Transport, tlsConfig *tls.Config, tunneled bool) {
+	if tunneled {
+		r.Reconstructed = true
+		return
+	}
+
+	dial := transport.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+
+	transport.DialContext = func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		return r.wrap(conn, nil), nil
+	}
+
+	transport.DialTLSContext = func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		conf := tlsConfig.Clone()
+		if conf.ServerName == "" {
+			conf.ServerName, _, _ = net.SplitHostPort(addr)
+		}
+		trace := httptrace.ContextClientTrace(ctx)
+		if trace != nil && trace.TLSHandshakeStart != nil {
+			trace.TLSHandshakeStart()
+		}
+		tlsConn := tls.Client(conn, conf)
+		err = tlsConn.HandshakeContext(ctx)
+		state := tlsConn.ConnectionState()
+		if trace != nil && trace.TLSHandshakeDone != nil {
+			trace.TLSHandshakeDone(state, err)
+		}
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return r.wrap(tlsConn, &state), nil
+	}
+}
+
+func (r *transcriptRecorder) wrap(conn net.Conn, state *tls.ConnectionState) net.Conn {
+	return &recordingConn{
+		Conn:     conn,
+		recorder: r,
+		tls:      state,
+	}
+}
+
+func (r *transcriptRecorder) recordRequest(conn *recordingConn, b []byte) {
+	r.Lock()
+	defer r.Unlock()
+	var exchange *transcriptExchange
+	if n := len(r.Exchanges); n > 0 && r.Exchanges[n-1].Response == nil && r.Exchanges[n-1].RemoteAddr == conn.RemoteAddr().String() {
+		exchange = r.Exchanges[n-1]
+	} else {
+		exchange = &transcriptExchange{
+			RemoteAddr: conn.RemoteAddr().String(),
+			TLS:        conn.tls,
+		}
+		r.Exchanges = append(r.Exchanges, exchange)
+	}
+	if len(exchange.Request)+len(b) <= maxTranscriptRequestSize {
+		exchange.Request = append(exchange.Request, b...)
+	}
+}
+
+func (r *transcriptRecorder) recordResponseHead(head []byte) {
+	r.Lock()
+	defer r.Unlock()
+	if n := len(r.Exchanges); n > 0 {
+		r.Exchanges[n-1].Response = append([]byte{}, head...)
+	}
+}
+
+// Reconstruct fills in the transcript from the parsed response when the raw
+// exchange couldn't be recorded.
+func (r *transcriptRecorder) Reconstruct(resp *http.Response) {
+	r.Lock()
+	defer r.Unlock()
+	if len(r.Exchanges) > 0 && !r.Reconstructed {
+		return
+	}
+	r.Reconstructed = true
+	exchange := &transcriptExchange{
+		TLS: resp.TLS,
+	}
+	if resp.Request != nil {
+		exchange.Request, _ = httputil.DumpRequestOut(resp.Request, false)
+	}
+	var head bytes.Buffer
+	fmt.Fprintf(&head, "%s %s\r\n", resp.Proto, resp.Status)
+	resp.Header.Write(&head)
+	head.WriteString("\r\n")
+	exchange.Response = head.Bytes()
+	r.Exchanges = []*transcriptExchange{exchange}
+}
+
+// LastExchange returns the exchange that produced the final response.
+func (r *transcriptRecorder) LastExchange() *transcriptExchange {
+	r.Lock()
+	defer r.Unlock()
+	if len(r.Exchanges) == 0 {
+		return nil
+	}
+	return r.Exchanges[len(r.Exchanges)-1]
+}
+
+func (r *transcriptRecorder) Bytes(url string, protocol string) []byte {
+	r.Lock()
+	defer r.Unlock()
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "URL: %s\n", url)
+	fmt.Fprintf(&b, "Timestamp: %s\n", r.StartedAt.UTC().Format(time.RFC3339Nano))
+	fmt.Fprintf(&b, "Protocol: %s\n", protocol)
+	if r.Reconstructed {
+		b.WriteString("Note: raw exchange was not available (e.g. tunneled through a proxy); transcript is reconstructed from the parsed response and header order is not preserved\n")
+	}
+
+	for i, exchange := range r.Exchanges {
+		fmt.Fprintf(&b, "\n===== Exchange %d =====\n", i+1)
+		if exchange.RemoteAddr != "" {
+			fmt.Fprintf(&b, "Remote Address: %s\n", exchange.RemoteAddr)
+		}
+		if exchange.TLS != nil {
+			alpn := exchange.TLS.NegotiatedProtocol
+			if alpn == "" {
+				alpn = "none"
+			}
+			fmt.Fprintf(&b, "TLS: %s, %s, ALPN: %s\n", tls.VersionName(exchange.TLS.Version), tls.CipherSuiteName(exchange.TLS.CipherSuite), alpn)
+		}
+		b.WriteString("\n----- Request -----\n")
+		b.Write(redactProxyAuthorization(exchange.Request))
+		b.WriteString("\n----- Response -----\n")
+		b.Write(exchange.Response)
+	}
+	return b.Bytes()
+}
+
+// redactProxyAuthorization replaces the credentials in the Proxy-Authorization
+// header of a raw request head, which the transport sends along with requests
+// for plain HTTP URLs through a HTTP proxy.
+func redactProxyAuthorization(request []byte) []byte {
+	end := bytes.Index(request, []byte("\r\n\r\n"))
+	if end < 0 {
+		end = len(request)
+	}
+	name := []byte("Proxy-Authorization:")
+	lines := bytes.Split(request[:end], []byte("\r\n"))
+	for i, line := range lines {
+		if len(line) < len(name) || !bytes.EqualFold(line[:len(name)], name) {
+			continue
+		}
+		value := bytes.Fields(line[len(name):])
+		redacted := append([]byte{}, line[:len(name)]...)
+		if len(value) > 1 {
+			redacted = append(append(redacted, ' '), value[0]...)
+		}
+		lines[i] = append(redacted, " [redacted]"...)
+	}
+	return append(bytes.Join(lines, []byte("\r\n")), request[end:]...)
+}
+
+type recordingConn struct {
+	net.Conn
+	recorder *transcriptRecorder
+	tls      *tls.ConnectionState
+	head     bytes.Buffer
+	reading  bool
+}
+
+func (c *recordingConn) Write(b []byte) (int, error) {
+	c.recorder.recordRequest(c, b)
+	c.reading = true
+	c.head.Reset()
+	return c.Conn.Write(b)
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if c.reading && n > 0 {
+		c.head.Write(b[:n])
+		if i := bytes.Index(c.head.Bytes(), []byte("\r\n\r\n")); i >= 0 {
+			c.recorder.recordResponseHead(c.head.Bytes()[:i+4])
+			c.reading = false
+			c.head.Reset()
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,172 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+type SchemeParityChecker struct {
+	session *core.Session
+}
+
+func NewSchemeParityChecker() *SchemeParityChecker {
+	return &SchemeParityChecker{}
+}
+
+func (a *SchemeParityChecker) ID() string {
+	return "agent:scheme_parity_checker"
+}
+
+func (a *SchemeParityChecker) Register(s *core.Session) error {
+	a.session = s
+	s.EventBus.SubscribeAsync(core.SessionEnd, a.OnSessionEnd, false)
+	return nil
+}
+
+// OnSessionEnd pairs the HTTP and HTTPS pages of the same host and path once
+// all pages are known.
+func (a *SchemeParityChecker) OnSessionEnd() {
+	pairs := a.pairs()
+	a.session.Out.Debug("[%s] Comparing %d HTTP and HTTPS page pairs\n", a.ID(), len(pairs))
+	for _, pair := range pairs {
+		a.session.WaitGroup.Add()
+		go func(httpPage *core.Page, httpsPage *core.Page) {
+			defer a.session.WaitGroup.Done()
+			a.check(httpPage, httpsPage)
+		}(pair[0], pair[1])
+	}
+}
+
+// pairs returns the HTTP and HTTPS pages with the same hostname and request
+// URI. When a host has pages on several ports, the pages on the lowest ports
+// are paired, which are the default ports when they are present.
+func (a *SchemeParityChecker) pairs() [][2]*core.Page {
+	var urls []string
+	for url := range a.session.Pages {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	pages := map[string]map[string]*core.Page{
+		"http":  make(map[string]*core.Page),
+		"https": make(map[string]*core.Page),
+	}
+	var keys []string
+	for _, url := range urls {
+		page := a.session.Pages[url]
+		u := page.ParsedURL()
+		key := strings.ToLower(u.Hostname()) + u.RequestURI()
+		if pages[u.Scheme] == nil || pages[u.Scheme][key] != nil {
+			continue
+		}
+		pages[u.Scheme][key] = page
+		if u.Scheme == "http" {
+			keys = append(keys, key)
+		}
+	}
+
+	var pairs [][2]*core.Page
+	for _, key := range keys {
+		if httpsPage, ok := pages["https"][key]; ok {
+			pairs = append(pairs, [2]*core.Page{pages["http"][key], httpsPage})
+		}
+	}
+	return pairs
+}
+
+func (a *SchemeParityChecker) check(httpPage *core.Page, httpsPage *core.Page) {
+	parity := &core.SchemeParity{
+		HTTPURL:  httpPage.URL,
+		HTTPSURL: httpsPage.URL,
+		HSTS:     httpsPage.GetHeader("Strict-Transport-Security"),
+	}
+
+	// The redirect is requested again, as the HTTP page may have followed it.
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:   httpPage.URL,
+		Limit: 1,
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), httpPage.URL, err)
+		return
+	}
+	if resp.StatusCode >= 300 && resp.StatusCode <= 399 {
+		parity.RedirectStatus = resp.StatusCode
+		parity.RedirectLocation = resp.Header.Get("Location")
+		if location, err := httpPage.ParsedURL().Parse(parity.RedirectLocation); err == nil {
+			parity.RedirectsToHTTPS = location.Scheme == "https"
+		}
+	}
+
+	parity.HSTSIssues = core.HSTSPreloadIssues(parity.HSTS)
+	parity.HSTSPreloadable = len(parity.HSTSIssues) == 0 && a.redirectsToSameHost(httpPage, parity)
+
+	contentDiffers := false
+	if !parity.RedirectsToHTTPS {
+		parity.Findings = append(parity.Findings, "HTTP serves content without redirecting to HTTPS")
+		if similarity, ok := a.similarity(httpPage, httpsPage); ok {
+			parity.Similarity = similarity
+			if similarity < a.session.Options.Similarity {
+				contentDiffers = true
+				parity.Findings = append(parity.Findings, fmt.Sprintf("HTTP and HTTPS serve different content (%.0f%% similar)", similarity*100))
+			}
+		}
+	} else if !a.redirectsToSameHost(httpPage, parity) {
+		parity.Findings = append(parity.Findings, "HTTP redirects to HTTPS on another host, so HSTS can't be set for this host first")
+	}
+	if parity.HSTS == "" {
+		parity.Findings = append(parity.Findings, "HTTPS doesn't send an HSTS header")
+	} else if !parity.HSTSPreloadable {
+		parity.Findings = append(parity.Findings, "HSTS can't be preloaded: "+strings.Join(parity.HSTSIssues, ", "))
+	}
+
+	for _, page := range []*core.Page{httpPage, httpsPage} {
+		page.Lock()
+		page.SchemeParity = parity
+		page.Unlock()
+		if !parity.RedirectsToHTTPS {
+			page.AddTag("HTTP Not Redirected", "warning", parity.HTTPURL)
+		}
+		if contentDiffers {
+			page.AddTag("HTTP/HTTPS Content Differs", "info", parity.HTTPURL)
+		}
+		if parity.HSTS == "" {
+			page.AddTag("HSTS Missing", "warning", parity.HTTPSURL)
+		} else if parity.HSTSPreloadable {
+			page.AddTag("HSTS Preloadable", "success", "https://hstspreload.org/?domain="+url.QueryEscape(httpsPage.ParsedURL().Hostname()))
+		}
+		noteType := "info"
+		if len(parity.Findings) > 0 {
+			noteType = "warning"
+		}
+		page.AddNote(parity.Summary(), noteType)
+	}
+}
+
+func (a *SchemeParityChecker) redirectsToSameHost(httpPage *core.Page, parity *core.SchemeParity) bool {
+	if !parity.RedirectsToHTTPS {
+		return false
+	}
+	location, err := httpPage.ParsedURL().Parse(parity.RedirectLocation)
+	return err == nil && strings.EqualFold(location.Hostname(), httpPage.ParsedURL().Hostname())
+}
+
+// similarity compares the structure of the saved bodies of both pages. It
+// returns false if a body wasn't saved.
+func (a *SchemeParityChecker) similarity(httpPage *core.Page, httpsPage *core.Page) (float64, bool) {
+	var structures [2][]string
+	for i, page := range []*core.Page{httpPage, httpsPage} {
+		body, err := a.session.ReadBody(page)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading body of %s: %v\n", a.ID(), page.URL, err)
+			return 0, false
+		}
+		structures[i], _ = core.GetPageStructure(bytes.NewReader(body))
+	}
+	return core.GetSimilarity(structures[0], structures[1]), true
+}
@@ -0,0 +1,104 @@
+package agents
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+type SecretScanner struct {
+	session *core.Session
+	results map[string][]core.Secret
+	mutex   sync.Mutex
+}
+
+func NewSecretScanner() *SecretScanner {
+	return &SecretScanner{
+		results: make(map[string][]core.Secret),
+	}
+}
+
+func (a *SecretScanner) ID() string {
+	return "agent:secret_scanner"
+}
+
+func (a *SecretScanner) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.Secrets {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.SessionEnd, a.OnSessionEnd, false)
+	return nil
+}
+
+// OnSessionEnd scans the pages when all other agents are done, so that the
+// scripts collected for them are scanned as well.
+func (a *SecretScanner) OnSessionEnd() {
+	a.session.Out.Debug("[%s] Scanning %d pages for secrets\n", a.ID(), len(a.session.Pages))
+	for _, page := range a.session.Pages {
+		a.session.WaitGroup.Add()
+		go func(page *core.Page) {
+			defer a.session.WaitGroup.Done()
+			a.scan(page)
+		}(page)
+	}
+}
+
+func (a *SecretScanner) scan(page *core.Page) {
+	files := []string{page.HeadersPath, page.BodyPath}
+	if page.JavaScript != nil {
+		for _, script := range page.JavaScript.Scripts {
+			// Inline scripts are scanned as part of the body.
+			if !script.Inline {
+				files = append(files, script.Path)
+			}
+		}
+	}
+
+	var secrets []core.Secret
+	for _, file := range files {
+		if file != "" {
+			secrets = append(secrets, a.scanOnce(file)...)
+		}
+	}
+	if len(secrets) == 0 {
+		return
+	}
+
+	page.Lock()
+	page.Secrets = secrets
+	page.Unlock()
+	tagged := make(map[string]bool)
+	for _, secret := range secrets {
+		if !tagged[secret.Rule] {
+			tagged[secret.Rule] = true
+			page.AddTag(secret.Rule, "danger", secret.File)
+		}
+	}
+	a.session.Out.Warn("%s: %s\n", page.URL, Red(fmt.Sprintf("%d possible secrets found", len(secrets))))
+}
+
+// scanOnce scans each file only once, as scripts are often shared by many
+// pages.
+func (a *SecretScanner) scanOnce(file string) []core.Secret {
+	a.mutex.Lock()
+	secrets, ok := a.results[file]
+	a.mutex.Unlock()
+	if ok {
+		return secrets
+	}
+
+	data, err := ioutil.ReadFile(a.session.GetFilePath(file))
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to read %s: %v\n", a.ID(), file, err)
+		return nil
+	}
+	secrets = core.ScanSecrets(a.session.SecretRules, data, file, a.session.SecretIgnore)
+
+	a.mutex.Lock()
+	a.results[file] = secrets
+	a.mutex.Unlock()
+	return secrets
+}
@@ -3,6 +3,7 @@ package agents
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/shelld3v/aquatone/core"
@@ -45,7 +46,7 @@ func (a *TCPPortScanner) OnHost(host string) {
 }
 
 func (a *TCPPortScanner) scanPort(port int, host string) bool {
-	conn, _ := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", host, port), time.Duration(a.session.Options.ScanTimeout)*time.Millisecond)
+	conn, _ := DialTimeout(a.session, a.ID(), "tcp", net.JoinHostPort(host, strconv.Itoa(port)), time.Duration(a.session.Options.ScanTimeout)*time.Millisecond)
 	if conn != nil {
 		conn.Close()
 		return true
@@ -0,0 +1,216 @@
+package agents
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+const maxAPIDocumentSize = 10 * 1024 * 1024
+
+var openAPIPaths = []string{
+	"/swagger.json",
+	"/swagger.yaml",
+	"/openapi.json",
+	"/openapi.yaml",
+	"/api-docs",
+	"/v2/api-docs",
+	"/v3/api-docs",
+	"/swagger/v1/swagger.json",
+	"/swagger/doc.json",
+	"/api/swagger.json",
+	"/api/openapi.json",
+	"/api/v1/swagger.json",
+	"/api/docs/swagger.json",
+}
+
+var graphQLPaths = []string{
+	"/graphql",
+	"/api/graphql",
+	"/v1/graphql",
+	"/graphql/v1",
+	"/query",
+	"/gql",
+}
+
+type URLAPIDiscoverer struct {
+	session *core.Session
+	origins map[string]bool
+	mutex   sync.Mutex
+}
+
+func NewURLAPIDiscoverer() *URLAPIDiscoverer {
+	return &URLAPIDiscoverer{
+		origins: make(map[string]bool),
+	}
+}
+
+func (a *URLAPIDiscoverer) ID() string {
+	return "agent:url_api_discoverer"
+}
+
+func (a *URLAPIDiscoverer) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.APIDiscovery {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLAPIDiscoverer) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	origin := fmt.Sprintf("%s://%s", page.ParsedURL().Scheme, page.ParsedURL().Host)
+	a.mutex.Lock()
+	if a.origins[origin] {
+		a.mutex.Unlock()
+		return
+	}
+	a.origins[origin] = true
+	a.mutex.Unlock()
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page, origin string) {
+		defer a.session.WaitGroup.Done()
+		api := &core.APISurface{}
+		a.discoverOpenAPI(origin, api)
+		a.discoverGraphQL(origin, api)
+		if len(api.OpenAPISpecs) == 0 && len(api.GraphQLEndpoints) == 0 {
+			a.session.Out.Debug("[%s] No API documents found on %s\n", a.ID(), origin)
+			return
+		}
+
+		page.Lock()
+		page.API = api
+		page.Unlock()
+		for _, spec := range api.OpenAPISpecs {
+			page.AddTag(strings.SplitN(spec.Version, " ", 2)[0], "info", spec.Path)
+			page.AddNote(spec.Summary(), "info")
+		}
+		for _, endpoint := range api.GraphQLEndpoints {
+			if endpoint.Introspection {
+				page.AddTag("GraphQL Introspection", "warning", endpoint.Path)
+				page.AddNote(endpoint.Summary(), "warning")
+			} else {
+				page.AddTag("GraphQL", "info", endpoint.URL)
+				page.AddNote(endpoint.Summary(), "info")
+			}
+		}
+	}(page, origin)
+}
+
+// discoverOpenAPI requests common OpenAPI and Swagger document locations and
+// saves every distinct document found.
+func (a *URLAPIDiscoverer) discoverOpenAPI(origin string, api *core.APISurface) {
+	seen := make(map[[md5.Size]byte]bool)
+	for _, path := range openAPIPaths {
+		specURL := origin + path
+		resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+			URL:   specURL,
+			Limit: fetchLimit(a.session, maxAPIDocumentSize),
+		})
+		if err != nil {
+			a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), specURL, err)
+			continue
+		}
+		if resp.StatusCode != http.StatusOK || resp.Truncated || isHTML(resp.Body) {
+			continue
+		}
+		sum := md5.Sum(resp.Body)
+		if seen[sum] {
+			continue
+		}
+		spec, err := core.ParseOpenAPISpec(resp.Body)
+		if err != nil {
+			a.session.Out.Debug("[%s] No OpenAPI spec at %s: %v\n", a.ID(), specURL, err)
+			continue
+		}
+		seen[sum] = true
+
+		ext := ".json"
+		if !json.Valid(resp.Body) {
+			ext = ".yaml"
+		}
+		spec.URL = specURL
+		spec.Path = a.save(origin, fmt.Sprintf("openapi-%d%s", len(api.OpenAPISpecs)+1, ext), resp.Body)
+		api.OpenAPISpecs = append(api.OpenAPISpecs, *spec)
+		a.session.Out.Debug("[%s] Found %s\n", a.ID(), spec.Summary())
+	}
+}
+
+// discoverGraphQL sends a {__typename} query to common GraphQL endpoint
+// locations and runs an introspection query on the endpoints that answer.
+func (a *URLAPIDiscoverer) discoverGraphQL(origin string, api *core.APISurface) {
+	for _, path := range graphQLPaths {
+		endpointURL := origin + path
+		data, err := a.graphQLQuery(endpointURL, "query{__typename}")
+		if err != nil {
+			a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), endpointURL, err)
+			continue
+		}
+		if !core.IsGraphQLResponse(data) {
+			continue
+		}
+
+		endpoint := core.GraphQLEndpoint{URL: endpointURL}
+		data, err = a.graphQLQuery(endpointURL, core.GraphQLIntrospectionQuery)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), endpointURL, err)
+		} else if err := core.ParseGraphQLIntrospection(data, &endpoint); err != nil {
+			a.session.Out.Debug("[%s] Introspection failed on %s: %v\n", a.ID(), endpointURL, err)
+		} else {
+			endpoint.Path = a.save(origin, fmt.Sprintf("graphql-%d.json", len(api.GraphQLEndpoints)+1), data)
+		}
+		api.GraphQLEndpoints = append(api.GraphQLEndpoints, endpoint)
+		a.session.Out.Debug("[%s] Found %s\n", a.ID(), endpoint.Summary())
+	}
+}
+
+func (a *URLAPIDiscoverer) graphQLQuery(endpointURL string, query string) ([]byte, error) {
+	body, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		Method: http.MethodPost,
+		URL:    endpointURL,
+		Header: http.Header{
+			"Content-Type": []string{"application/json"},
+			"Accept":       []string{"application/json"},
+		},
+		Body:  string(body),
+		Limit: fetchLimit(a.session, maxAPIDocumentSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (a *URLAPIDiscoverer) save(origin string, name string, data []byte) string {
+	filepath := fmt.Sprintf("api/%s__%s", originFilename(origin), name)
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), data, 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write %s for %s to %s\n", name, origin, a.session.GetFilePath(filepath))
+		return ""
+	}
+	return filepath
+}
+
+func isHTML(data []byte) bool {
+	trimmed := bytes.ToLower(bytes.TrimSpace(data))
+	return bytes.HasPrefix(trimmed, []byte("<!doctype html")) || bytes.HasPrefix(trimmed, []byte("<html"))
+}
@@ -0,0 +1,143 @@
+package agents
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+type URLAuthDetector struct {
+	session *core.Session
+}
+
+func NewURLAuthDetector() *URLAuthDetector {
+	return &URLAuthDetector{}
+}
+
+func (a *URLAuthDetector) ID() string {
+	return "agent:url_auth_detector"
+}
+
+func (a *URLAuthDetector) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLAuthDetector) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+
+		auth := &core.Authentication{
+			Challenges: core.ParseWWWAuthenticate(a.headerValues(page.Headers, "WWW-Authenticate")),
+		}
+		if location := page.GetHeader("Location"); location != "" {
+			if u, err := page.ParsedURL().Parse(location); err == nil {
+				if provider := core.LoginProvider(u); provider != "" {
+					auth.LoginRedirect = u.String()
+					auth.LoginProvider = provider
+				}
+			}
+		}
+		if len(auth.Challenges) == 0 && auth.LoginProvider == "" {
+			return
+		}
+		for _, scheme := range []string{"NTLM", "Negotiate"} {
+			if a.hasScheme(auth.Challenges, scheme) {
+				auth.NTLM = a.requestNTLMChallenge(page.URL, scheme)
+				break
+			}
+		}
+
+		page.Lock()
+		page.Auth = auth
+		page.Unlock()
+		a.report(page, auth)
+	}(page)
+}
+
+func (a *URLAuthDetector) report(page *core.Page, auth *core.Authentication) {
+	for _, challenge := range auth.Challenges {
+		tagType := "info"
+		note := fmt.Sprintf("WWW-Authenticate: %s", challenge.Scheme)
+		if challenge.Realm != "" {
+			note += fmt.Sprintf(" (realm %q)", challenge.Realm)
+		}
+		// Basic credentials are only encoded, so they are sent in the clear
+		// over plain HTTP.
+		if strings.EqualFold(challenge.Scheme, "Basic") && page.ParsedURL().Scheme != "https" {
+			tagType = "danger"
+			note += " over plain HTTP"
+		}
+		page.AddTag(challenge.Scheme+" Auth", tagType, page.HeadersPath)
+		page.AddNote(note, tagType)
+	}
+	if auth.LoginProvider != "" {
+		page.AddTag(auth.LoginProvider+" Login", "info", auth.LoginRedirect)
+		page.AddNote(fmt.Sprintf("Redirects to %s login: %s", auth.LoginProvider, auth.LoginRedirect), "info")
+	}
+
+	// Internal names leaked through NTLM are reported for every page, as
+	// different pages can be served by different hosts.
+	if ntlm := auth.NTLM; ntlm != nil && (ntlm.NetBIOSDomain != "" || ntlm.DNSDomain != "" || ntlm.DNSComputer != "" || ntlm.NetBIOSComputer != "") {
+		page.AddTag("NTLM Info Disclosure", "warning", page.HeadersPath)
+		page.AddNote(ntlm.Summary(), "warning")
+		a.session.Out.Warn("%s: %s\n", page.URL, Red(ntlm.Summary()))
+	}
+}
+
+// requestNTLMChallenge sends an NTLM negotiate message to get the challenge
+// message, which servers only send in response to one.
+func (a *URLAuthDetector) requestNTLMChallenge(url string, scheme string) *core.NTLMChallenge {
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:    url,
+		Header: http.Header{"Authorization": []string{scheme + " " + core.NTLMNegotiateMessage()}},
+		Limit:  1024,
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error requesting NTLM challenge from %s: %v\n", a.ID(), url, err)
+		return nil
+	}
+	for _, challenge := range core.ParseWWWAuthenticate(resp.Header.Values("WWW-Authenticate")) {
+		if !strings.EqualFold(challenge.Scheme, scheme) || challenge.Token == "" {
+			continue
+		}
+		ntlm, err := core.ParseNTLMChallenge(challenge.Token)
+		if err != nil {
+			a.session.Out.Debug("[%s] Invalid NTLM challenge from %s: %v\n", a.ID(), url, err)
+			continue
+		}
+		return ntlm
+	}
+	return nil
+}
+
+func (a *URLAuthDetector) hasScheme(challenges []core.AuthChallenge, scheme string) bool {
+	for _, challenge := range challenges {
+		if strings.EqualFold(challenge.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *URLAuthDetector) headerValues(headers []core.Header, name string) []string {
+	var values []string
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			values = append(values, header.Value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,177 @@
+package agents
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+const corsAttackerDomain = "aquatone-cors-check.com"
+
+// corsTest is a crafted Origin to send to a page, built from the URL of the
+// page. Severity is the severity of a page trusting the origin with
+// credentials; without credentials, only public data can be read and the
+// severity is lowered.
+type corsTest struct {
+	Name     string
+	Severity string
+	Origin   func(u *url.URL) string
+}
+
+var corsTests = []corsTest{
+	{"Arbitrary origin", core.SeverityHigh, func(u *url.URL) string {
+		return "https://" + corsAttackerDomain
+	}},
+	{"Null origin", core.SeverityHigh, func(u *url.URL) string {
+		return "null"
+	}},
+	{"Suffix of trusted origin", core.SeverityHigh, func(u *url.URL) string {
+		return fmt.Sprintf("%s://%s.%s", u.Scheme, u.Hostname(), corsAttackerDomain)
+	}},
+	{"Prefix of trusted origin", core.SeverityHigh, func(u *url.URL) string {
+		return fmt.Sprintf("%s://%s%s", u.Scheme, strings.TrimSuffix(corsAttackerDomain, ".com"), u.Host)
+	}},
+	{"Arbitrary subdomain", core.SeverityLow, func(u *url.URL) string {
+		return fmt.Sprintf("%s://%s.%s", u.Scheme, strings.TrimSuffix(corsAttackerDomain, ".com"), u.Host)
+	}},
+	{"Plain HTTP origin", core.SeverityMedium, func(u *url.URL) string {
+		if u.Scheme != "https" {
+			return ""
+		}
+		return "http://" + u.Hostname()
+	}},
+}
+
+type URLCORSProber struct {
+	session *core.Session
+}
+
+func NewURLCORSProber() *URLCORSProber {
+	return &URLCORSProber{}
+}
+
+func (a *URLCORSProber) ID() string {
+	return "agent:url_cors_prober"
+}
+
+func (a *URLCORSProber) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.CORS {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLCORSProber) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		a.probe(page)
+	}(page)
+}
+
+// probe sends a simple request with every crafted origin, and a preflight
+// request when the simple request isn't trusted. Once an arbitrary origin is
+// trusted, the other tests would only repeat the finding.
+func (a *URLCORSProber) probe(page *core.Page) {
+	for _, test := range corsTests {
+		origin := test.Origin(page.ParsedURL())
+		if origin == "" {
+			continue
+		}
+		finding := a.check(page.URL, test, origin, http.MethodGet)
+		if finding == nil {
+			finding = a.check(page.URL, test, origin, http.MethodOptions)
+		}
+		if finding == nil {
+			continue
+		}
+		a.addFinding(page, finding)
+		if test.Name == "Arbitrary origin" {
+			return
+		}
+	}
+}
+
+func (a *URLCORSProber) check(url string, test corsTest, origin string, method string) *core.CORSFinding {
+	header := http.Header{"Origin": []string{origin}}
+	if method == http.MethodOptions {
+		header.Set("Access-Control-Request-Method", "PUT")
+		header.Set("Access-Control-Request-Headers", "authorization,content-type")
+	}
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		Method:     method,
+		URL:        url,
+		Header:     header,
+		Limit:      1024,
+		Transcript: true,
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error requesting %s with Origin %s: %v\n", a.ID(), url, origin, err)
+		return nil
+	}
+
+	allowOrigin := strings.TrimSpace(resp.Header.Get("Access-Control-Allow-Origin"))
+	if allowOrigin != origin {
+		return nil
+	}
+	finding := &core.CORSFinding{
+		Test:             test.Name,
+		Origin:           origin,
+		Method:           method,
+		AllowOrigin:      allowOrigin,
+		AllowCredentials: strings.EqualFold(strings.TrimSpace(resp.Header.Get("Access-Control-Allow-Credentials")), "true"),
+		Severity:         test.Severity,
+	}
+	if !finding.AllowCredentials {
+		switch finding.Severity {
+		case core.SeverityHigh:
+			finding.Severity = core.SeverityLow
+		default:
+			finding.Severity = core.SeverityInfo
+		}
+	}
+	a.writeEvidence(url, finding, resp)
+	return finding
+}
+
+func (a *URLCORSProber) addFinding(page *core.Page, finding *core.CORSFinding) {
+	credentials := "without credentials"
+	if finding.AllowCredentials {
+		credentials = "with credentials"
+	}
+	description := fmt.Sprintf("CORS: %s %s trusted %s (%s severity)", finding.Test, finding.Origin, credentials, finding.Severity)
+
+	page.Lock()
+	page.CORS = append(page.CORS, *finding)
+	page.Unlock()
+	page.AddTag("CORS: "+finding.Test, core.SeverityTagType(finding.Severity), finding.EvidencePath)
+	page.AddNote(description, core.SeverityTagType(finding.Severity))
+	if finding.Severity == core.SeverityHigh || finding.Severity == core.SeverityMedium {
+		a.session.Out.Warn("%s: %s\n", page.URL, Red(description))
+	}
+}
+
+func (a *URLCORSProber) writeEvidence(url string, finding *core.CORSFinding, resp *fetchResponse) {
+	filepath := fmt.Sprintf("cors/%s__%s.txt", BaseFilenameFromURL(url), strings.ToLower(strings.Replace(finding.Test, " ", "_", -1)))
+	content := fmt.Sprintf("Test: %s\nOrigin: %s\nAccess-Control-Allow-Origin: %s\nAccess-Control-Allow-Credentials: %t\nSeverity: %s\n\n",
+		finding.Test, finding.Origin, finding.AllowOrigin, finding.AllowCredentials, finding.Severity)
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), append([]byte(content), resp.Transcript...), 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write CORS evidence for %s to %s\n", url, a.session.GetFilePath(filepath))
+		return
+	}
+	finding.EvidencePath = filepath
+}
@@ -0,0 +1,128 @@
+package agents
+
+import (
+	"net/url"
+	"sync"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+// crawlOrigin is where a crawled URL was found.
+type crawlOrigin struct {
+	Depth   int
+	FoundOn string
+}
+
+type URLCrawler struct {
+	session *core.Session
+	found   map[string]crawlOrigin
+	crawled map[string]bool
+	counts  map[string]int
+	mutex   sync.Mutex
+}
+
+func NewURLCrawler() *URLCrawler {
+	return &URLCrawler{
+		found:   make(map[string]crawlOrigin),
+		crawled: make(map[string]bool),
+		counts:  make(map[string]int),
+	}
+}
+
+func (a *URLCrawler) ID() string {
+	return "agent:url_crawler"
+}
+
+func (a *URLCrawler) Register(s *core.Session) error {
+	a.session = s
+	if s.Options.CrawlDepth <= 0 {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLCrawler) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.mutex.Lock()
+	origin, found := a.found[url]
+	if a.crawled[url] {
+		a.mutex.Unlock()
+		return
+	}
+	a.crawled[url] = true
+	a.mutex.Unlock()
+
+	if found {
+		page.Lock()
+		page.Depth = origin.Depth
+		page.FoundOn = origin.FoundOn
+		page.Unlock()
+	}
+	if origin.Depth >= a.session.Options.CrawlDepth {
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page, depth int) {
+		defer a.session.WaitGroup.Done()
+		a.crawl(page, depth)
+	}(page, origin.Depth)
+}
+
+// crawl publishes the unseen links of page that are on the same origin, up
+// to the page limit of the host.
+func (a *URLCrawler) crawl(page *core.Page, depth int) {
+	body, err := a.session.ReadBody(page)
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to read body of %s: %v\n", a.ID(), page.URL, err)
+		return
+	}
+	links, err := core.ExtractLinks(body, page.ParsedURL())
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to parse body of %s: %v\n", a.ID(), page.URL, err)
+		return
+	}
+
+	base, _ := url.Parse(core.NormalizeURL(page.ParsedURL()))
+	var inScope []string
+	for _, link := range links {
+		u, err := url.Parse(link)
+		if err != nil || u.Scheme != base.Scheme || u.Host != base.Host {
+			continue
+		}
+		inScope = append(inScope, link)
+	}
+	page.Lock()
+	page.Links = inScope
+	page.Unlock()
+
+	hostname := page.ParsedURL().Hostname()
+	for _, link := range inScope {
+		if link == base.String() || a.session.GetPage(link) != nil {
+			continue
+		}
+		a.mutex.Lock()
+		if _, ok := a.found[link]; ok {
+			a.mutex.Unlock()
+			continue
+		}
+		if a.counts[hostname] >= a.session.Options.CrawlLimit {
+			a.mutex.Unlock()
+			a.session.Out.Debug("[%s] Reached limit of %d crawled pages for %s\n", a.ID(), a.session.Options.CrawlLimit, hostname)
+			return
+		}
+		a.counts[hostname]++
+		a.found[link] = crawlOrigin{Depth: depth + 1, FoundOn: page.URL}
+		a.mutex.Unlock()
+
+		a.session.Out.Debug("[%s] Found %s on %s (depth %d)\n", a.ID(), link, page.URL, depth+1)
+		a.session.EventBus.Publish(core.URL, link)
+	}
+}
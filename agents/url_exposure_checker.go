@@ -0,0 +1,148 @@
+package agents
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+const maxExposureBodySize = 512 * 1024
+
+type URLExposureChecker struct {
+	session    *core.Session
+	signatures []*core.ExposureSignature
+	origins    map[string]bool
+	mutex      sync.Mutex
+}
+
+func NewURLExposureChecker() *URLExposureChecker {
+	return &URLExposureChecker{
+		origins: make(map[string]bool),
+	}
+}
+
+func (a *URLExposureChecker) ID() string {
+	return "agent:url_exposure_checker"
+}
+
+func (a *URLExposureChecker) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.Exposures {
+		return nil
+	}
+
+	data, err := s.Asset("static/exposures.json")
+	if err != nil {
+		return err
+	}
+	if a.signatures, err = core.LoadExposureSignatures(data); err != nil {
+		return err
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLExposureChecker) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	origin := fmt.Sprintf("%s://%s", page.ParsedURL().Scheme, page.ParsedURL().Host)
+	a.mutex.Lock()
+	if a.origins[origin] {
+		a.mutex.Unlock()
+		return
+	}
+	a.origins[origin] = true
+	a.mutex.Unlock()
+
+	a.session.WaitGroup.Add()
+	go func(origin string) {
+		defer a.session.WaitGroup.Done()
+		a.check(origin)
+	}(origin)
+}
+
+func (a *URLExposureChecker) check(origin string) {
+	for _, sig := range a.signatures {
+		for _, path := range sig.Paths {
+			exposureURL := origin + path
+			resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+				URL:   exposureURL,
+				Limit: maxExposureBodySize,
+			})
+			if err != nil {
+				a.session.Out.Debug("[%s] Error requesting %s: %v\n", a.ID(), exposureURL, err)
+				continue
+			}
+			evidence, ok := sig.Match(resp.StatusCode, resp.Header, resp.Body)
+			if !ok {
+				continue
+			}
+
+			exposure := &core.Exposure{
+				Name:     sig.Name,
+				Severity: sig.Severity,
+				URL:      exposureURL,
+				Status:   resp.StatusCode,
+				Evidence: evidence,
+			}
+			a.writeEvidence(exposure, resp)
+			a.session.Out.Warn("%s: %s\n", exposureURL, Red(fmt.Sprintf("%s (%s)", sig.Name, sig.Severity)))
+			a.addExposurePage(exposure, resp)
+			break
+		}
+	}
+}
+
+// addExposurePage adds a page for the exposed URL, built from the response
+// of the check. Exposed files can be large dumps and backups, so they are not
+// requested again by the URL requester or the screenshotter.
+func (a *URLExposureChecker) addExposurePage(exposure *core.Exposure, resp *fetchResponse) {
+	existing := a.session.GetPage(exposure.URL) != nil
+	page, err := a.session.AddPage(exposure.URL)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to create page for URL: %s\n", exposure.URL)
+		return
+	}
+	if !existing {
+		page.Status = resp.Status
+		for name, values := range resp.Header {
+			for _, value := range values {
+				page.AddHeader(name, value)
+			}
+		}
+	}
+	a.addExposure(page, exposure)
+}
+
+func (a *URLExposureChecker) addExposure(page *core.Page, exposure *core.Exposure) {
+	page.Lock()
+	page.Exposures = append(page.Exposures, *exposure)
+	page.Unlock()
+	page.AddTag(exposure.Name, core.SeverityTagType(exposure.Severity), exposure.EvidencePath)
+	page.AddNote(fmt.Sprintf("%s (%s severity): %s", exposure.Name, exposure.Severity, exposure.Evidence), core.SeverityTagType(exposure.Severity))
+}
+
+func (a *URLExposureChecker) writeEvidence(exposure *core.Exposure, resp *fetchResponse) {
+	filepath := fmt.Sprintf("exposures/%s.txt", BaseFilenameFromURL(exposure.URL))
+	content := fmt.Sprintf("URL: %s\nSignature: %s\nSeverity: %s\nStatus: %s\n\n----- Matched content -----\n%s\n\n----- Response headers -----\n",
+		exposure.URL, exposure.Name, exposure.Severity, resp.Status, exposure.Evidence)
+	for name, values := range resp.Header {
+		for _, value := range values {
+			content += fmt.Sprintf("%s: %s\n", name, value)
+		}
+	}
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), []byte(content), 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write exposure evidence for %s to %s\n", exposure.URL, a.session.GetFilePath(filepath))
+		return
+	}
+	exposure.EvidencePath = filepath
+}
@@ -0,0 +1,207 @@
+package agents
+
+import (
+	"bytes"
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/shelld3v/aquatone/core"
+)
+
+const maxFaviconSize = 1024 * 1024
+
+type faviconFetch struct {
+	once    sync.Once
+	favicon *core.Favicon
+}
+
+type URLFaviconFetcher struct {
+	session  *core.Session
+	database *core.FaviconDatabase
+	fetches  map[string]*faviconFetch
+	mutex    sync.Mutex
+}
+
+func NewURLFaviconFetcher() *URLFaviconFetcher {
+	return &URLFaviconFetcher{
+		fetches: make(map[string]*faviconFetch),
+	}
+}
+
+func (a *URLFaviconFetcher) ID() string {
+	return "agent:url_favicon_fetcher"
+}
+
+func (a *URLFaviconFetcher) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.Favicons {
+		return nil
+	}
+
+	data, err := s.Asset("static/favicons.json")
+	if err != nil {
+		return err
+	}
+	if a.database, err = core.LoadFaviconDatabase(data); err != nil {
+		return err
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLFaviconFetcher) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		for _, iconURL := range a.faviconURLs(page) {
+			favicon := a.fetchOnce(iconURL)
+			if favicon == nil {
+				continue
+			}
+			page.Favicon = favicon
+			if favicon.Name != "" {
+				page.AddTag(favicon.Name, "info", fmt.Sprintf("https://www.shodan.io/search?query=http.favicon.hash%%3A%d", favicon.MMH3))
+			}
+			return
+		}
+		a.session.Out.Debug("[%s] No favicon found for %s\n", a.ID(), page.URL)
+	}(page)
+}
+
+// faviconURLs returns the icons linked from the page, followed by
+// /favicon.ico as a fallback.
+func (a *URLFaviconFetcher) faviconURLs(page *core.Page) []string {
+	base := page.ParsedURL()
+	var urls []string
+	seen := make(map[string]bool)
+	add := func(u *url.URL) {
+		if (u.Scheme == "http" || u.Scheme == "https") && !seen[u.String()] {
+			seen[u.String()] = true
+			urls = append(urls, u.String())
+		}
+	}
+
+	if body, err := a.session.ReadBody(page); err == nil {
+		if doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body)); err == nil {
+			if href, ok := doc.Find("base[href]").First().Attr("href"); ok {
+				if u, err := base.Parse(strings.TrimSpace(href)); err == nil {
+					base = u
+				}
+			}
+			doc.Find("link[rel][href]").Each(func(i int, s *goquery.Selection) {
+				rel, _ := s.Attr("rel")
+				if !isIconRel(rel) {
+					return
+				}
+				href, _ := s.Attr("href")
+				if u, err := base.Parse(strings.TrimSpace(href)); err == nil {
+					add(u)
+				}
+			})
+		}
+	}
+
+	add(&url.URL{Scheme: page.ParsedURL().Scheme, Host: page.ParsedURL().Host, Path: "/favicon.ico"})
+	return urls
+}
+
+func isIconRel(rel string) bool {
+	for _, r := range strings.Fields(strings.ToLower(rel)) {
+		if r == "icon" {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchOnce fetches each favicon URL only once, as many pages on the same
+// site tend to link to the same favicon.
+func (a *URLFaviconFetcher) fetchOnce(iconURL string) *core.Favicon {
+	a.mutex.Lock()
+	f, ok := a.fetches[iconURL]
+	if !ok {
+		f = &faviconFetch{}
+		a.fetches[iconURL] = f
+	}
+	a.mutex.Unlock()
+
+	f.once.Do(func() {
+		f.favicon = a.fetch(iconURL)
+	})
+	return f.favicon
+}
+
+func (a *URLFaviconFetcher) fetch(iconURL string) *core.Favicon {
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:            iconURL,
+		FollowRedirect: true,
+		Limit:          maxFaviconSize,
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error fetching favicon %s: %v\n", a.ID(), iconURL, err)
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK || len(resp.Body) == 0 || resp.Truncated {
+		a.session.Out.Debug("[%s] No usable favicon at %s (%s, %d bytes)\n", a.ID(), iconURL, resp.Status, len(resp.Body))
+		return nil
+	}
+	contentType := http.DetectContentType(resp.Body)
+	if strings.HasPrefix(contentType, "text/html") {
+		a.session.Out.Debug("[%s] Favicon %s is a HTML page\n", a.ID(), iconURL)
+		return nil
+	}
+
+	favicon := &core.Favicon{
+		URL:  iconURL,
+		MMH3: core.FaviconHash(resp.Body),
+		MD5:  fmt.Sprintf("%x", md5.Sum(resp.Body)),
+	}
+	favicon.Name = a.database.Lookup(favicon.MMH3, favicon.MD5)
+
+	filepath := fmt.Sprintf("favicons/%s%s", favicon.MD5, faviconExtension(iconURL, contentType))
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), resp.Body, 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write favicon for %s to %s\n", iconURL, a.session.GetFilePath(filepath))
+	} else {
+		favicon.Path = filepath
+	}
+
+	a.session.Out.Debug("[%s] Favicon %s has mmh3 %d and md5 %s\n", a.ID(), iconURL, favicon.MMH3, favicon.MD5)
+	return favicon
+}
+
+func faviconExtension(iconURL string, contentType string) string {
+	if u, err := url.Parse(iconURL); err == nil {
+		switch ext := strings.ToLower(path.Ext(u.Path)); ext {
+		case ".ico", ".png", ".gif", ".jpg", ".jpeg", ".svg", ".webp", ".bmp":
+			return ext
+		}
+	}
+	switch contentType {
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/webp":
+		return ".webp"
+	case "image/bmp":
+		return ".bmp"
+	}
+	return ".ico"
+}
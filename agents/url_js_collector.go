@@ -0,0 +1,222 @@
+package agents
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+const maxScriptSize = 10 * 1024 * 1024
+
+type scriptFetch struct {
+	once      sync.Once
+	script    *core.Script
+	endpoints []string
+}
+
+type URLJSCollector struct {
+	session *core.Session
+	fetches map[string]*scriptFetch
+	saved   map[string]bool
+	mutex   sync.Mutex
+}
+
+func NewURLJSCollector() *URLJSCollector {
+	return &URLJSCollector{
+		fetches: make(map[string]*scriptFetch),
+		saved:   make(map[string]bool),
+	}
+}
+
+func (a *URLJSCollector) ID() string {
+	return "agent:url_js_collector"
+}
+
+func (a *URLJSCollector) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.CollectJS {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLJSCollector) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+		a.collect(page)
+	}(page)
+}
+
+func (a *URLJSCollector) collect(page *core.Page) {
+	body, err := a.session.ReadBody(page)
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to read body of %s: %v\n", a.ID(), page.URL, err)
+		return
+	}
+	external, inline, err := core.ExtractScripts(body, page.ParsedURL())
+	if err != nil {
+		a.session.Out.Debug("[%s] Unable to parse body of %s: %v\n", a.ID(), page.URL, err)
+		return
+	}
+	if len(external) == 0 && len(inline) == 0 {
+		return
+	}
+
+	js := &core.JavaScript{}
+	pageOrigin := scriptOrigin(core.NormalizeURL(page.ParsedURL()))
+	thirdParty := make(map[string]bool)
+	for _, scriptURL := range external {
+		if origin := scriptOrigin(scriptURL); origin != pageOrigin && !thirdParty[origin] {
+			thirdParty[origin] = true
+			js.ThirdPartyOrigins = append(js.ThirdPartyOrigins, origin)
+		}
+		f := a.fetchOnce(scriptURL)
+		if f.script == nil {
+			continue
+		}
+		js.Scripts = append(js.Scripts, *f.script)
+		js.Endpoints = core.MergeEndpoints(js.Endpoints, f.endpoints)
+	}
+	for _, data := range inline {
+		script := &core.Script{Inline: true}
+		a.save(script, data)
+		js.Scripts = append(js.Scripts, *script)
+		js.Endpoints = core.MergeEndpoints(js.Endpoints, core.ExtractEndpoints(data))
+	}
+	sort.Strings(js.ThirdPartyOrigins)
+
+	page.Lock()
+	page.JavaScript = js
+	page.Unlock()
+	for _, script := range js.Scripts {
+		if script.SourceMapPath != "" {
+			page.AddTag("Source Map", "warning", script.SourceMapPath)
+		}
+	}
+	page.AddNote(js.Summary(), "info")
+}
+
+// fetchOnce fetches each script URL only once, as pages on the same site
+// tend to share most of their scripts.
+func (a *URLJSCollector) fetchOnce(scriptURL string) *scriptFetch {
+	a.mutex.Lock()
+	f, ok := a.fetches[scriptURL]
+	if !ok {
+		f = &scriptFetch{}
+		a.fetches[scriptURL] = f
+	}
+	a.mutex.Unlock()
+
+	f.once.Do(func() {
+		f.script, f.endpoints = a.fetch(scriptURL)
+	})
+	return f
+}
+
+func (a *URLJSCollector) fetch(scriptURL string) (*core.Script, []string) {
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:            scriptURL,
+		FollowRedirect: true,
+		Limit:          fetchLimit(a.session, maxScriptSize),
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error fetching script %s: %v\n", a.ID(), scriptURL, err)
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK || len(resp.Body) == 0 || isHTML(resp.Body) {
+		a.session.Out.Debug("[%s] No usable script at %s (%s)\n", a.ID(), scriptURL, resp.Status)
+		return nil, nil
+	}
+
+	script := &core.Script{URL: scriptURL}
+	a.save(script, resp.Body)
+	a.fetchSourceMap(script, resp)
+	return script, core.ExtractEndpoints(resp.Body)
+}
+
+// fetchSourceMap looks for the source map of a script in the SourceMap
+// headers, in the sourceMappingURL comment or next to the script, and saves it
+// when it is publicly accessible.
+func (a *URLJSCollector) fetchSourceMap(script *core.Script, resp *fetchResponse) {
+	base, err := url.Parse(resp.URL)
+	if err != nil {
+		return
+	}
+	var candidates []string
+	for _, ref := range []string{resp.Header.Get("SourceMap"), resp.Header.Get("X-SourceMap"), core.SourceMappingURL(resp.Body)} {
+		if ref != "" {
+			if u, err := base.Parse(ref); err == nil {
+				candidates = append(candidates, u.String())
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		u := *base
+		u.Path += ".map"
+		u.RawPath = ""
+		u.RawQuery = ""
+		candidates = append(candidates, u.String())
+	}
+
+	for _, mapURL := range candidates {
+		mapResp, err := fetchURL(a.session, a.ID(), fetchRequest{
+			URL:   mapURL,
+			Limit: fetchLimit(a.session, maxScriptSize),
+		})
+		if err != nil || mapResp.StatusCode != http.StatusOK || mapResp.Truncated || !core.IsSourceMap(mapResp.Body) {
+			continue
+		}
+		filepath := fmt.Sprintf("js/%x.js.map", sha256.Sum256(mapResp.Body))
+		if err := ioutil.WriteFile(a.session.GetFilePath(filepath), mapResp.Body, 0644); err != nil {
+			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+			a.session.Out.Error("Failed to write source map %s to %s\n", mapURL, a.session.GetFilePath(filepath))
+			return
+		}
+		script.SourceMap = mapURL
+		script.SourceMapPath = filepath
+		a.session.Out.Warn("%s: %s\n", mapURL, Yellow("exposed source map"))
+		return
+	}
+}
+
+// save writes a script to js/ named by its SHA-256 hash, once per content.
+func (a *URLJSCollector) save(script *core.Script, data []byte) {
+	script.SHA256 = fmt.Sprintf("%x", sha256.Sum256(data))
+	script.Size = len(data)
+	filepath := fmt.Sprintf("js/%s.js", script.SHA256)
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if !a.saved[script.SHA256] {
+		if err := ioutil.WriteFile(a.session.GetFilePath(filepath), data, 0644); err != nil {
+			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+			a.session.Out.Error("Failed to write script to %s\n", a.session.GetFilePath(filepath))
+			return
+		}
+		a.saved[script.SHA256] = true
+	}
+	script.Path = filepath
+}
+
+func scriptOrigin(scriptURL string) string {
+	u, err := url.Parse(scriptURL)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+}
@@ -0,0 +1,208 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/shelld3v/aquatone/core"
+)
+
+const maxMethodBodySize = 64 * 1024
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?><propfind xmlns="DAV:"><prop><resourcetype/></prop></propfind>`
+
+type URLMethodChecker struct {
+	session *core.Session
+	origins map[string]bool
+	mutex   sync.Mutex
+}
+
+func NewURLMethodChecker() *URLMethodChecker {
+	return &URLMethodChecker{
+		origins: make(map[string]bool),
+	}
+}
+
+func (a *URLMethodChecker) ID() string {
+	return "agent:url_method_checker"
+}
+
+func (a *URLMethodChecker) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.Methods {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLMethodChecker) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	origin := fmt.Sprintf("%s://%s", page.ParsedURL().Scheme, page.ParsedURL().Host)
+	a.mutex.Lock()
+	if a.origins[origin] {
+		a.mutex.Unlock()
+		return
+	}
+	a.origins[origin] = true
+	a.mutex.Unlock()
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page, origin string) {
+		defer a.session.WaitGroup.Done()
+		methods := &core.HTTPMethods{
+			Evidence: make(map[string]string),
+		}
+		a.checkOptions(origin, methods)
+		a.checkTrace(origin, methods)
+		a.checkWebDAV(origin, methods)
+		if a.session.Options.MethodsWrite {
+			a.checkWrite(origin, methods)
+		}
+
+		page.Lock()
+		page.Methods = methods
+		page.Unlock()
+		noteType := "info"
+		for _, confirmed := range []struct {
+			enabled bool
+			method  string
+			tag     string
+			tagType string
+		}{
+			{methods.TRACE, http.MethodTrace, "TRACE Enabled", "warning"},
+			{methods.WebDAV, "PROPFIND", "WebDAV", "warning"},
+			{methods.PUT, http.MethodPut, "PUT Enabled", "danger"},
+			{methods.DELETE, http.MethodDelete, "DELETE Enabled", "danger"},
+		} {
+			if confirmed.enabled {
+				page.AddTag(confirmed.tag, confirmed.tagType, methods.Evidence[confirmed.method])
+				a.session.Out.Warn("%s: %s\n", origin, Red(confirmed.tag))
+				noteType = "warning"
+			}
+		}
+		if len(methods.Allowed) > 0 || noteType == "warning" {
+			page.AddNote(methods.Summary(), noteType)
+		}
+	}(page, origin)
+}
+
+// checkOptions records the methods advertised in response to OPTIONS.
+func (a *URLMethodChecker) checkOptions(origin string, methods *core.HTTPMethods) {
+	resp, err := a.request(http.MethodOptions, origin+"/", nil, "")
+	if err != nil {
+		return
+	}
+	methods.Allowed = core.ParseAllowHeader(append(resp.Header["Allow"], resp.Header["Public"]...))
+	methods.DAV = resp.Header.Get("DAV")
+	a.writeEvidence(origin, http.MethodOptions, resp, methods)
+}
+
+// checkTrace sends a TRACE request with a random header and checks whether the
+// request is echoed back, which lets scripts read headers like cookies that
+// are otherwise hidden from them.
+func (a *URLMethodChecker) checkTrace(origin string, methods *core.HTTPMethods) {
+	marker := uuid.New().String()
+	resp, err := a.request(http.MethodTrace, origin+"/", http.Header{"X-Aquatone-Trace": []string{marker}}, "")
+	if err != nil {
+		return
+	}
+	if resp.StatusCode == http.StatusOK && bytes.Contains(resp.Body, []byte(marker)) && bytes.Contains(bytes.ToUpper(resp.Body), []byte("TRACE /")) {
+		methods.TRACE = true
+		a.writeEvidence(origin, http.MethodTrace, resp, methods)
+	}
+}
+
+// checkWebDAV sends a PROPFIND request for the properties of the root, which
+// only WebDAV servers answer with a multistatus response.
+func (a *URLMethodChecker) checkWebDAV(origin string, methods *core.HTTPMethods) {
+	header := http.Header{
+		"Depth":        []string{"0"},
+		"Content-Type": []string{"application/xml; charset=utf-8"},
+	}
+	resp, err := a.request("PROPFIND", origin+"/", header, propfindBody)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode == http.StatusMultiStatus && bytes.Contains(bytes.ToLower(resp.Body), []byte("multistatus")) {
+		methods.WebDAV = true
+		a.writeEvidence(origin, "PROPFIND", resp, methods)
+	}
+}
+
+// checkWrite uploads a file with a random name and content, verifies that it
+// can be read back, and deletes it again. Nothing existing is overwritten or
+// deleted.
+func (a *URLMethodChecker) checkWrite(origin string, methods *core.HTTPMethods) {
+	fileURL := fmt.Sprintf("%s/aquatone-%s.txt", origin, uuid.New().String())
+	marker := uuid.New().String()
+
+	resp, err := a.request(http.MethodPut, fileURL, http.Header{"Content-Type": []string{"text/plain"}}, marker)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return
+	}
+	if !a.fileContains(fileURL, marker) {
+		return
+	}
+	methods.PUT = true
+	a.writeEvidence(origin, http.MethodPut, resp, methods)
+
+	resp, err = a.request(http.MethodDelete, fileURL, nil, "")
+	if err == nil && resp.StatusCode >= 200 && resp.StatusCode <= 299 && !a.fileContains(fileURL, marker) {
+		methods.DELETE = true
+		a.writeEvidence(origin, http.MethodDelete, resp, methods)
+		return
+	}
+	a.session.Out.Warn("%s: %s\n", fileURL, Red("test file could not be deleted and must be removed manually"))
+}
+
+func (a *URLMethodChecker) fileContains(fileURL string, marker string) bool {
+	resp, err := a.request(http.MethodGet, fileURL, nil, "")
+	return err == nil && resp.StatusCode == http.StatusOK && bytes.Contains(resp.Body, []byte(marker))
+}
+
+func (a *URLMethodChecker) request(method string, url string, header http.Header, body string) (*fetchResponse, error) {
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		Method:     method,
+		URL:        url,
+		Header:     header,
+		Body:       body,
+		Limit:      maxMethodBodySize,
+		Transcript: true,
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error sending %s request to %s: %v\n", a.ID(), method, url, err)
+		return nil, err
+	}
+	a.session.Out.Debug("[%s] %s %s: %s\n", a.ID(), method, url, resp.Status)
+	return resp, nil
+}
+
+// writeEvidence saves the request and response of a tested method, including
+// the response body, which holds the echoed request for TRACE and the
+// properties for PROPFIND.
+func (a *URLMethodChecker) writeEvidence(origin string, method string, resp *fetchResponse, methods *core.HTTPMethods) {
+	filepath := fmt.Sprintf("methods/%s__%s.txt", originFilename(origin), strings.ToLower(method))
+	content := append([]byte{}, resp.Transcript...)
+	if len(resp.Body) > 0 {
+		content = append(content, []byte("\n----- Response body -----\n")...)
+		content = append(content, resp.Body...)
+	}
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), content, 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write %s evidence for %s to %s\n", method, origin, a.session.GetFilePath(filepath))
+		return
+	}
+	methods.Evidence[method] = filepath
+}
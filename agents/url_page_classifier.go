@@ -0,0 +1,56 @@
+package agents
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+type URLPageClassifier struct {
+	session *core.Session
+}
+
+func NewURLPageClassifier() *URLPageClassifier {
+	return &URLPageClassifier{}
+}
+
+func (a *URLPageClassifier) ID() string {
+	return "agent:url_page_classifier"
+}
+
+func (a *URLPageClassifier) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLPageClassifier) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+
+		body, err := a.session.ReadBody(page)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+		status, _ := strconv.Atoi(strings.SplitN(page.Status, " ", 2)[0])
+
+		for _, rule := range core.ClassifyPage(a.session.PageClassRules, status, page.Headers, body) {
+			a.session.Out.Debug("[%s] Classified %s as %s\n", a.ID(), page.URL, rule.Name)
+			page.Lock()
+			page.Classes = append(page.Classes, rule.Name)
+			page.Unlock()
+			page.AddTag(rule.Name, rule.Type, page.BodyPath)
+		}
+	}(page)
+}
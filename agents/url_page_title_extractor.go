@@ -2,7 +2,6 @@ package agents
 
 import (
 	"bytes"
-	"fmt"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
@@ -39,7 +38,7 @@ func (a *URLPageTitleExtractor) OnURLResponsive(url string) {
 	a.session.WaitGroup.Add()
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
-		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		body, err := a.session.ReadBody(page)
 		if err != nil {
 			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
 			return
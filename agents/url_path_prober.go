@@ -0,0 +1,195 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/shelld3v/aquatone/core"
+)
+
+const maxProbeBodySize = 512 * 1024
+
+// probeResponse is the part of a response used to tell whether a path
+// exists or was answered by a catch-all handler.
+type probeResponse struct {
+	StatusCode int
+	Status     string
+	Location   string
+	Length     int
+	Structure  []string
+}
+
+type URLPathProber struct {
+	session *core.Session
+	origins map[string]bool
+	budgets map[string]int
+	mutex   sync.Mutex
+}
+
+func NewURLPathProber() *URLPathProber {
+	return &URLPathProber{
+		origins: make(map[string]bool),
+		budgets: make(map[string]int),
+	}
+}
+
+func (a *URLPathProber) ID() string {
+	return "agent:url_path_prober"
+}
+
+func (a *URLPathProber) Register(s *core.Session) error {
+	a.session = s
+	if len(s.Paths) == 0 {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLPathProber) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	origin := fmt.Sprintf("%s://%s", page.ParsedURL().Scheme, page.ParsedURL().Host)
+	a.mutex.Lock()
+	if a.origins[origin] {
+		a.mutex.Unlock()
+		return
+	}
+	a.origins[origin] = true
+	a.mutex.Unlock()
+
+	a.session.WaitGroup.Add()
+	go func(origin string, hostname string) {
+		defer a.session.WaitGroup.Done()
+		a.probe(origin, hostname)
+	}(origin, page.ParsedURL().Hostname())
+}
+
+func (a *URLPathProber) probe(origin string, hostname string) {
+	var baselines []*probeResponse
+	for _, path := range []string{"/" + uuid.New().String(), "/" + uuid.New().String() + "/"} {
+		if !a.spend(hostname) {
+			return
+		}
+		baseline, err := a.request(origin + path)
+		if err != nil {
+			a.session.Out.Debug("[%s] Unable to get catch-all baseline for %s: %v\n", a.ID(), origin, err)
+			return
+		}
+		baseline.Location = strings.Replace(baseline.Location, path, "", 1)
+		baselines = append(baselines, baseline)
+	}
+
+	found := 0
+	for _, path := range a.session.Paths {
+		if !a.spend(hostname) {
+			a.session.Out.Debug("[%s] Request budget for %s spent after %d paths on %s\n", a.ID(), hostname, found, origin)
+			return
+		}
+		pathURL := origin + path
+		resp, err := a.request(pathURL)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error probing %s: %v\n", a.ID(), pathURL, err)
+			continue
+		}
+		if resp.StatusCode == http.StatusNotFound || a.isCatchAll(resp, path, baselines) {
+			continue
+		}
+		if a.session.GetPage(pathURL) != nil {
+			continue
+		}
+		// The URL requester requests the path again to create its page.
+		if !a.spend(hostname) {
+			a.session.Out.Debug("[%s] Request budget for %s spent after %d paths on %s\n", a.ID(), hostname, found, origin)
+			return
+		}
+		found++
+		a.session.Out.Debug("[%s] Found path %s (%d)\n", a.ID(), pathURL, resp.StatusCode)
+		a.session.EventBus.Publish(core.URL, pathURL)
+	}
+}
+
+// spend takes a request from the budget of hostname, which is shared by all
+// origins on the host.
+func (a *URLPathProber) spend(hostname string) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.budgets[hostname] >= a.session.Options.PathsBudget {
+		return false
+	}
+	a.budgets[hostname]++
+	return true
+}
+
+func (a *URLPathProber) request(pathURL string) (*probeResponse, error) {
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:   pathURL,
+		Limit: maxProbeBodySize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	structure, _ := core.GetPageStructure(bytes.NewReader(resp.Body))
+	return &probeResponse{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Location:   resp.Header.Get("Location"),
+		Length:     len(resp.Body),
+		Structure:  structure,
+	}, nil
+}
+
+// isCatchAll reports whether resp looks like the response to a random path,
+// meaning that the server answers every path the same way.
+func (a *URLPathProber) isCatchAll(resp *probeResponse, path string, baselines []*probeResponse) bool {
+	for _, baseline := range baselines {
+		if resp.StatusCode != baseline.StatusCode {
+			continue
+		}
+		if resp.Location != "" || baseline.Location != "" {
+			if strings.Replace(resp.Location, path, "", 1) == baseline.Location || sameRedirectTarget(resp.Location, baseline.Location) {
+				return true
+			}
+			continue
+		}
+		if len(resp.Structure) == 0 && len(baseline.Structure) == 0 {
+			// Bodies may reflect the requested path instead of the random one.
+			diff := resp.Length - baseline.Length
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff <= len(path)+40 {
+				return true
+			}
+			continue
+		}
+		if core.GetSimilarity(resp.Structure, baseline.Structure) >= a.session.Options.Similarity {
+			return true
+		}
+	}
+	return false
+}
+
+// sameRedirectTarget reports whether two redirects lead to the same path,
+// ignoring query strings that often carry the requested path.
+func sameRedirectTarget(a string, b string) bool {
+	ua, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+	ub, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return ua.Host == ub.Host && ua.Path == ub.Path && ua.Path != ""
+}
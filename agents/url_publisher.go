@@ -1,10 +1,10 @@
 package agents
 
 import (
-	"crypto/tls"
+	"net"
 	"strconv"
 	"strings"
-	"fmt"
+	"time"
 
 	"github.com/shelld3v/aquatone/core"
 )
@@ -45,10 +45,8 @@ func (a *URLPublisher) isTLS(port int, host string) bool {
 		return false
 	}
 
-	conf := &tls.Config{
-		InsecureSkipVerify: true,
-	}
-	conn, err := tls.Dial("tcp", fmt.Sprintf("%s:%d", host, port), conf)
+	timeout := time.Duration(a.session.Options.HTTPTimeout) * time.Millisecond
+	conn, err := DialTLS(a.session, a.ID(), "tcp", net.JoinHostPort(host, strconv.Itoa(port)), timeout, TLSConfig(a.session, host))
 	if err != nil {
 		return false
 	}
@@ -1,16 +1,22 @@
 package agents
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 	"strings"
 	"net/http"
+	"net/http/httptrace"
 	"strconv"
 
 	"github.com/shelld3v/aquatone/core"
 	"github.com/parnurzeal/gorequest"
 )
 
+const maxVariantBodySize = 5 * 1024 * 1024
+
 type URLRequester struct {
 	session *core.Session
 }
@@ -34,10 +40,25 @@ func (a *URLRequester) OnURL(url string) {
 	a.session.WaitGroup.Add()
 	go func(url string) {
 		defer a.session.WaitGroup.Done()
-		req := Gorequest(a.session.Options)
+		hostname := HostnameFromURL(url)
+		req, proxy, err := Gorequest(a.session, a.ID(), hostname)
+		if err != nil {
+			a.session.Stats.IncrementRequestFailed()
+			a.session.Out.Error("%s: %s\n", url, Red(err.Error()))
+			return
+		}
+		recorder := newTranscriptRecorder()
+		tunneled := proxy != nil && (proxy.IsSOCKS() || strings.HasPrefix(url, "https://"))
+		recorder.Attach(req.Transport, TLSConfig(a.session, hostname), tunneled)
+
+		userAgent := RandomUserAgent()
+		if len(a.session.UserAgentVariants) > 0 {
+			userAgent = core.DesktopUserAgent
+		}
 		ip := RandomIPv4Address()
 		pre := req.Get(url).
-			Set("User-Agent", RandomUserAgent()).
+			Set("User-Agent", userAgent).
+			Set("Accept-Encoding", acceptEncoding).
 			Set("X-Forwarded-For", ip).
 			Set("X-Real-Ip", ip).
 			Set("X-Client-Ip", ip).
@@ -58,16 +79,23 @@ func (a *URLRequester) OnURL(url string) {
 				pre.Set(header[0], header[1])
 			}
 		}
-		resp, body, errs := pre.End()
+		timer := newRequestTimer()
+		resp, body, errs := a.send(pre, timer)
+		defer body.Remove()
 
 		var status string
 		if errs != nil {
 			a.session.Stats.IncrementRequestFailed()
 			for _, err := range errs {
 				a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+				proxy.CheckError(err)
+				if IsClientCertificateRejection(err) {
+					a.reportClientCertificateRejection(url, hostname, err)
+				}
 			}
 			return
 		}
+		proxy.MarkAlive()
 
 		if a.session.Options.MatchCodes != "" {
 			Matched := false
@@ -106,7 +134,8 @@ func (a *URLRequester) OnURL(url string) {
 		}
 
 		if a.session.Options.FilterString != "" {
-			if strings.Contains(body, a.session.Options.FilterString) {
+			content, _ := ioutil.ReadFile(body.Filename)
+			if strings.Contains(string(content), a.session.Options.FilterString) {
 				a.session.Stats.IncrementRequestFailed()
 				a.session.Out.Debug("[%s] %s has filter string in response body\n", a.ID(), url)
 				return
@@ -136,15 +165,63 @@ func (a *URLRequester) OnURL(url string) {
 			return
 		}
 
+		page.Timing = timer.Timing()
 		a.writeHeaders(page)
+		a.checkCookies(page)
+		a.writeTranscript(page, recorder, resp)
+		a.checkBody(page, body)
+		a.detectCharset(page, body)
+		if len(a.session.UserAgentVariants) > 0 {
+			a.compareUserAgentVariants(page, body)
+		}
 		if a.session.Options.SaveBody {
-			a.writeBody(page, resp)
+			a.writeBody(page, body)
 		}
 
 		a.session.EventBus.Publish(core.URLResponsive, url)
 	}(url)
 }
 
+// send performs the request built with agent like agent.End() does, but
+// traces the request with timer and streams the body to a temporary file
+// instead of reading it into memory.
+func (a *URLRequester) send(agent *gorequest.SuperAgent, timer *requestTimer) (gorequest.Response, *responseBody, []error) {
+	if len(agent.Errors) != 0 {
+		return nil, nil, agent.Errors
+	}
+	req, err := agent.MakeRequest()
+	if err != nil {
+		return nil, nil, []error{err}
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), timer.ClientTrace()))
+	agent.Client.Transport = agent.Transport
+
+	timer.Start()
+	resp, err := agent.Client.Do(req)
+	if err != nil {
+		return nil, nil, []error{err}
+	}
+	defer resp.Body.Close()
+
+	body, err := saveResponseBody(resp, a.session.GetFilePath("html"), int64(a.session.Options.MaxBodySize))
+	timer.Done()
+	if err != nil {
+		body.Remove()
+		return nil, nil, []error{err}
+	}
+	resp.Body = http.NoBody
+
+	return resp, body, nil
+}
+
+func (a *URLRequester) reportClientCertificateRejection(url string, hostname string, err error) {
+	if a.session.ClientCertificates.ForHost(hostname) == nil {
+		a.session.Out.Error("%s: %s (%v)\n", url, Red("server requires a client certificate"), err)
+		return
+	}
+	a.session.Out.Error("%s: %s (%v)\n", url, Red("client certificate rejected"), err)
+}
+
 func (a *URLRequester) createPageFromResponse(url string, resp gorequest.Response) (*core.Page, error) {
 	page, err := a.session.AddPage(url)
 	if err != nil {
@@ -153,8 +230,23 @@ func (a *URLRequester) createPageFromResponse(url string, resp gorequest.Respons
 
 	page.Status = resp.Status
 	for name, value := range resp.Header {
+		switch name {
+		case "Set-Cookie", "Www-Authenticate", "Content-Security-Policy", "Content-Security-Policy-Report-Only":
+			// Cookies, authentication challenges and policies can't be joined
+			// into one header without losing their boundaries.
+			for _, v := range value {
+				page.AddHeader(name, v)
+			}
+			continue
+		}
 		page.AddHeader(name, strings.Join(value, " "))
 	}
+	page.HeaderAudit = core.AuditHeaders(page.ParsedURL().Scheme, page.Headers)
+	page.CSP = core.FindCSP(page.Headers)
+	page.Cookies = core.ParseCookies(page.ParsedURL().Scheme, resp.Header["Set-Cookie"])
+	if page.VirtualHost = a.session.GetVirtualHostForURL(page.ParsedURL()); page.VirtualHost != nil {
+		page.AddTag("Virtual Host", "info", page.VirtualHost.BaseURL)
+	}
 
 	return page, nil
 }
@@ -172,18 +264,138 @@ func (a *URLRequester) writeHeaders(page *core.Page) {
 	page.HeadersPath = filepath
 }
 
-func (a *URLRequester) writeBody(page *core.Page, resp gorequest.Response) {
-	filepath := fmt.Sprintf("html/%s.html", page.BaseFilename())
-	body, err := ioutil.ReadAll(resp.Body)
+func (a *URLRequester) writeTranscript(page *core.Page, recorder *transcriptRecorder, resp gorequest.Response) {
+	if recorder.LastExchange() == nil || recorder.Reconstructed {
+		recorder.Reconstruct(resp)
+	}
+	if exchange := recorder.LastExchange(); exchange != nil {
+		page.RemoteAddr = exchange.RemoteAddr
+	}
+	page.Protocol = resp.Proto
+
+	filepath := fmt.Sprintf("transcripts/%s.txt", page.BaseFilename())
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), recorder.Bytes(page.URL, resp.Proto), 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write HTTP transcript for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
+		return
+	}
+	page.TranscriptPath = filepath
+}
+
+// checkCookies tags page when it sets session cookies without the expected
+// protections.
+func (a *URLRequester) checkCookies(page *core.Page) {
+	insecure := false
+	for _, cookie := range page.Cookies {
+		if len(cookie.Issues) > 0 {
+			a.session.Out.Debug("[%s] Cookie %s on %s: %s\n", a.ID(), cookie.Name, page.URL, strings.Join(cookie.Issues, ", "))
+			insecure = true
+		}
+	}
+	if insecure {
+		page.AddTag("Insecure Cookie", "warning", page.HeadersPath)
+	}
+}
+
+// checkBody adds notes to page about problems with the saved body.
+func (a *URLRequester) checkBody(page *core.Page, body *responseBody) {
+	if body.Truncated {
+		a.session.Out.Debug("[%s] Response body for %s truncated at %d bytes\n", a.ID(), page.URL, body.Size)
+		page.AddNote(fmt.Sprintf("Response body was truncated at %d bytes", body.Size), "warning")
+	}
+	if body.Err != nil {
+		a.session.Out.Debug("[%s] Error reading response body for %s: %v\n", a.ID(), page.URL, body.Err)
+		page.AddNote(fmt.Sprintf("Problem reading response body: %v", body.Err), "warning")
+	}
+}
+
+// detectCharset records the character set of the body on page, so that
+// other agents can decode it to UTF-8.
+func (a *URLRequester) detectCharset(page *core.Page, body *responseBody) {
+	f, err := os.Open(body.Filename)
 	if err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-		a.session.Out.Error("Failed to read response body for %s\n", page.URL)
 		return
 	}
+	defer f.Close()
+
+	head := make([]byte, 1024)
+	n, _ := io.ReadFull(f, head)
+	page.Charset = core.DetectCharset(head[:n], page.GetHeader("Content-Type"))
+}
+
+// compareUserAgentVariants fetches page again with every configured
+// User-Agent and compares the responses with the desktop response, to find
+// pages serving different content to mobile clients or crawlers.
+func (a *URLRequester) compareUserAgentVariants(page *core.Page, body *responseBody) {
+	f, err := os.Open(body.Filename)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return
+	}
+	structure, _ := core.GetPageStructure(f)
+	f.Close()
+
+	for _, variant := range a.session.UserAgentVariants {
+		resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+			URL:            page.URL,
+			Header:         http.Header{"User-Agent": []string{variant.UserAgent}},
+			FollowRedirect: a.session.Options.FollowRedirect,
+			Limit:          fetchLimit(a.session, maxVariantBodySize),
+		})
+		if err != nil {
+			a.session.Out.Debug("[%s] Error requesting %s with %s User-Agent: %v\n", a.ID(), page.URL, variant.Name, err)
+			continue
+		}
+
+		variant.Status = resp.Status
+		variant.Location = resp.Header.Get("Location")
+		variantStructure, _ := core.GetPageStructure(bytes.NewReader(resp.Body))
+		variant.Similarity = core.GetSimilarity(structure, variantStructure)
+		if resp.Status != page.Status {
+			variant.Differences = append(variant.Differences, fmt.Sprintf("status %s instead of %s", resp.Status, page.Status))
+		}
+		if variant.Location != page.GetHeader("Location") {
+			variant.Differences = append(variant.Differences, fmt.Sprintf("redirects to %q instead of %q", variant.Location, page.GetHeader("Location")))
+		}
+		if variant.Similarity < a.session.Options.Similarity {
+			variant.Differences = append(variant.Differences, fmt.Sprintf("%.0f%% similar", variant.Similarity*100))
+		}
+		variant.Differs = len(variant.Differences) > 0
+
+		if a.session.Options.SaveBody {
+			name := strings.Map(func(r rune) rune {
+				if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' {
+					return r
+				}
+				return '_'
+			}, variant.Name)
+			filepath := fmt.Sprintf("html/%s__%s.html", page.BaseFilename(), name)
+			if err := ioutil.WriteFile(a.session.GetFilePath(filepath), resp.Body, 0644); err != nil {
+				a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+				a.session.Out.Error("Failed to write %s response body for %s to %s\n", variant.Name, page.URL, a.session.GetFilePath(filepath))
+			} else {
+				variant.BodyPath = filepath
+			}
+		}
+
+		page.Lock()
+		page.UserAgentVariants = append(page.UserAgentVariants, variant)
+		page.Unlock()
+		if variant.Differs {
+			a.session.Out.Debug("[%s] %s\n", a.ID(), variant.Summary())
+			page.AddTag(fmt.Sprintf("Differs for %s", variant.Name), "warning", variant.BodyPath)
+			page.AddNote(variant.Summary(), "warning")
+		}
+	}
+}
 
-	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), body, 0644); err != nil {
+func (a *URLRequester) writeBody(page *core.Page, body *responseBody) {
+	filepath := fmt.Sprintf("html/%s.html", page.BaseFilename())
+	if err := os.Rename(body.Filename, a.session.GetFilePath(filepath)); err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response body for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
+		return
 	}
 	page.BodyPath = filepath
 }
@@ -1,17 +1,25 @@
 package agents
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/fetch"
 	"github.com/chromedp/cdproto/network"
 	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/cdproto/runtime"
 	"github.com/chromedp/chromedp"
+	"github.com/chromedp/chromedp/device"
 	"github.com/shelld3v/aquatone/core"
 )
 
@@ -32,6 +40,13 @@ func (a *URLScreenshotter) Register(s *core.Session) error {
 	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
 	a.session = s
 
+	if s.Proxies.Enabled() {
+		for _, proxy := range s.Proxies.Proxies {
+			if proxy.URL.User != nil {
+				s.Out.Warn("Chrome does not support proxy authentication, screenshots through %s will fail\n", proxy)
+			}
+		}
+	}
 	return nil
 }
 
@@ -47,15 +62,27 @@ func (a *URLScreenshotter) OnURLResponsive(url string) {
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
 		a.screenshotPage(page)
+		if a.session.Options.ScreenshotMobile {
+			a.screenshotMobile(page)
+		}
 	}(page)
 }
 
 // execAllocator turns the chrome instance allocator options into a derivative context.Context
-func (a URLScreenshotter) execAllocator(parent context.Context) (context.Context, context.CancelFunc) {
+func (a URLScreenshotter) execAllocator(parent context.Context, proxy *core.Proxy, p *core.Page) (context.Context, context.CancelFunc) {
 	options := []chromedp.ExecAllocatorOption{}
 
-	if a.session.Options.Proxy != "" {
-		options = append(options, chromedp.ProxyServer(a.session.Options.Proxy))
+	if p.VirtualHost != nil {
+		// Virtual hosts may not resolve to the address they were found on.
+		host, _, _ := net.SplitHostPort(p.VirtualHost.Addr)
+		if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		options = append(options, chromedp.Flag("host-resolver-rules", fmt.Sprintf("MAP %s %s", p.VirtualHost.Hostname, host)))
+	}
+
+	if proxy != nil {
+		options = append(options, chromedp.ProxyServer(chromeProxyServer(proxy)))
 	}
 
 	if a.session.Options.ChromePath != "" {
@@ -88,26 +115,86 @@ func (a URLScreenshotter) execAllocator(parent context.Context) (context.Context
 func (a *URLScreenshotter) screenshotPage(p *core.Page) {
 	filePath := fmt.Sprintf("screenshots/%s.png", p.BaseFilename())
 
+	pic, err := a.capture(p, nil)
+	if err != nil {
+		a.session.Out.Debug("[%s] Screenshot failed for %s: %v\n", a.ID(), p.URL, err)
+		a.session.Stats.IncrementScreenshotFailed()
+		a.session.Out.Error("%s: %s\n", p.URL, Red("screenshot failed"))
+		return
+	}
+
+	if err := ioutil.WriteFile(a.session.GetFilePath(filePath), pic, 0700); err != nil {
+		a.session.Out.Debug("[%s] Screenshot failed for %s: %v\n", a.ID(), p.URL, err)
+		a.session.Stats.IncrementScreenshotFailed()
+		a.session.Out.Error("%s: %s\n", p.URL, Red("screenshot failed"))
+		return
+	}
+
+	a.session.Out.Debug("[%s] Screenshotted successfully for %s\n", a.ID(), p.URL)
+	a.session.Stats.IncrementScreenshotSuccessful()
+	a.session.Out.Info("%s: %s\n", p.URL, Green("screenshot successful"))
+	p.ScreenshotPath = filePath
+	p.HasScreenshot = true
+}
+
+// screenshotMobile takes a second screenshot of p emulating a phone, which
+// also sends the User-Agent of the phone.
+func (a *URLScreenshotter) screenshotMobile(p *core.Page) {
+	filePath := fmt.Sprintf("screenshots/%s__mobile.png", p.BaseFilename())
+
+	pic, err := a.capture(p, device.IPhone13)
+	if err == nil {
+		err = ioutil.WriteFile(a.session.GetFilePath(filePath), pic, 0700)
+	}
+	if err != nil {
+		a.session.Out.Debug("[%s] Mobile screenshot failed for %s: %v\n", a.ID(), p.URL, err)
+		a.session.Out.Error("%s: %s\n", p.URL, Red("mobile screenshot failed"))
+		return
+	}
+
+	a.session.Out.Debug("[%s] Mobile screenshotted successfully for %s\n", a.ID(), p.URL)
+	p.Lock()
+	p.MobileScreenshotPath = filePath
+	p.Unlock()
+}
+
+// capture loads p in Chrome and returns a screenshot of it, emulating dev
+// unless it is nil.
+func (a *URLScreenshotter) capture(p *core.Page, dev chromedp.Device) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(a.session.Options.ScreenshotTimeout)*time.Millisecond)
 	defer cancel()
 
-	ctx, cancel = a.execAllocator(ctx)
+	proxy, err := a.session.Proxies.Next(p.ParsedURL().Hostname())
+	if err != nil {
+		return nil, err
+	}
+	if proxy != nil && proxy.URL.User != nil {
+		// Chrome would connect without the credentials and capture the
+		// proxy's authentication error instead of the page.
+		return nil, fmt.Errorf("Chrome does not support authenticated proxy %s", proxy)
+	}
+	a.session.RecordProxyUse(a.ID(), proxy != nil)
+
+	ctx, cancel = a.execAllocator(ctx, proxy, p)
 	defer cancel()
 
 	ctx, cancel = chromedp.NewContext(ctx)
 	defer cancel()
 
+	interceptRequests := !a.session.ClientCertificates.Empty()
 	chromedp.ListenTarget(ctx, func(ev interface{}) {
 		if _, ok := ev.(*page.EventJavascriptDialogOpening); ok {
 			a.session.Stats.IncrementScreenshotFailed()
 			a.session.Out.Debug("[%s] %s: screenshot failed: alert box popped up\n", a.ID(), p.URL)
 			return
 		}
+		if ev, ok := ev.(*fetch.EventRequestPaused); ok && interceptRequests {
+			go a.handlePausedRequest(ctx, ev, proxy)
+		}
 	})
 
 	var pic []byte
 	var res *runtime.RemoteObject
-	var err error
 
 	headers := make(map[string]interface{})
 	for _, h := range a.session.Options.HTTPHeaders {
@@ -117,44 +204,121 @@ func (a *URLScreenshotter) screenshotPage(p *core.Page) {
 		}
 	}
 
+	tasks := chromedp.Tasks{
+		network.Enable(),
+		network.SetExtraHTTPHeaders(network.Headers(headers)),
+	}
+	if interceptRequests {
+		tasks = append(tasks, fetch.Enable())
+	}
+	if dev != nil {
+		tasks = append(tasks, chromedp.Emulate(dev))
+	}
+
 	if a.session.Options.FullPage {
 		// Source: https://github.com/chromedp/examples/blob/master/screenshot/main.go
-		err = chromedp.Run(ctx, chromedp.Tasks{
-			network.Enable(),
-			network.SetExtraHTTPHeaders(network.Headers(headers)),
+		err = chromedp.Run(ctx, append(tasks,
 			chromedp.Navigate(p.URL),
-			chromedp.Sleep(time.Duration(a.session.Options.ScreenshotDelay) * time.Millisecond),
+			chromedp.Sleep(time.Duration(a.session.Options.ScreenshotDelay)*time.Millisecond),
 			chromedp.EvaluateAsDevTools(`window.alert = window.confirm = window.prompt = function (txt){return txt}`, &res),
 			chromedp.FullScreenshot(&pic, 100),
-		})
+		))
 	} else {
-		err = chromedp.Run(ctx, chromedp.Tasks{
-			network.Enable(),
-			network.SetExtraHTTPHeaders(network.Headers(headers)),
+		err = chromedp.Run(ctx, append(tasks,
 			chromedp.Navigate(p.URL),
-			chromedp.Sleep(time.Duration(a.session.Options.ScreenshotDelay) * time.Millisecond),
+			chromedp.Sleep(time.Duration(a.session.Options.ScreenshotDelay)*time.Millisecond),
 			chromedp.EvaluateAsDevTools(`window.alert = window.confirm = window.prompt = function (txt){return txt}`, &res),
 			chromedp.CaptureScreenshot(&pic),
-		})
+		))
+	}
+	return pic, err
+}
+
+// handlePausedRequest performs requests intercepted from Chrome itself when a
+// client certificate applies to the requested host, as headless Chrome has no
+// way of presenting certificates that are not in the system store.
+func (a *URLScreenshotter) handlePausedRequest(ctx context.Context, ev *fetch.EventRequestPaused, proxy *core.Proxy) {
+	ctx = cdp.WithExecutor(ctx, chromedp.FromContext(ctx).Target)
+
+	u, err := url.Parse(ev.Request.URL)
+	if err != nil || u.Scheme != "https" || a.session.ClientCertificates.ForHost(u.Hostname()) == nil {
+		if err := fetch.ContinueRequest(ev.RequestID).Do(ctx); err != nil {
+			a.session.Out.Debug("[%s] Failed to continue request for %s: %v\n", a.ID(), ev.Request.URL, err)
+		}
+		return
 	}
 
+	var body []byte
+	for _, entry := range ev.Request.PostDataEntries {
+		data, _ := base64.StdEncoding.DecodeString(entry.Bytes)
+		body = append(body, data...)
+	}
+	req, err := http.NewRequest(ev.Request.Method, ev.Request.URL, bytes.NewReader(body))
 	if err != nil {
-		a.session.Out.Debug("[%s] Screenshot failed for %s: %v\n", a.ID(), p.URL, err)
-		a.session.Stats.IncrementScreenshotFailed()
-		a.session.Out.Error("%s: %s\n", p.URL, Red("screenshot failed"))
+		fetch.FailRequest(ev.RequestID, network.ErrorReasonFailed).Do(ctx)
 		return
 	}
+	for name, value := range ev.Request.Headers {
+		req.Header.Set(name, fmt.Sprint(value))
+	}
 
-	if err := ioutil.WriteFile(a.session.GetFilePath(filePath), pic, 0700); err != nil {
-		a.session.Out.Debug("[%s] Screenshot failed for %s: %v\n", a.ID(), p.URL, err)
-		a.session.Stats.IncrementScreenshotFailed()
-		a.session.Out.Error("%s: %s\n", p.URL, Red("screenshot failed"))
+	client := &http.Client{
+		Timeout: time.Duration(a.session.Options.HTTPTimeout) * time.Millisecond,
+		Transport: &http.Transport{
+			Proxy:           proxyFunc(proxy),
+			TLSClientConfig: TLSConfig(a.session, u.Hostname()),
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		a.session.Out.Debug("[%s] Intercepted request for %s failed: %v\n", a.ID(), ev.Request.URL, err)
+		if IsClientCertificateRejection(err) {
+			a.session.Out.Error("%s: %s (%v)\n", ev.Request.URL, Red("client certificate rejected"), err)
+		}
+		fetch.FailRequest(ev.RequestID, network.ErrorReasonConnectionFailed).Do(ctx)
 		return
 	}
+	defer resp.Body.Close()
 
-	a.session.Out.Debug("[%s] Screenshotted successfully for %s\n", a.ID(), p.URL)
-	a.session.Stats.IncrementScreenshotSuccessful()
-	a.session.Out.Info("%s: %s\n", p.URL, Green("screenshot successful"))
-	p.ScreenshotPath = filePath
-	p.HasScreenshot = true
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		fetch.FailRequest(ev.RequestID, network.ErrorReasonFailed).Do(ctx)
+		return
+	}
+
+	var headers []*fetch.HeaderEntry
+	for name, values := range resp.Header {
+		for _, value := range values {
+			headers = append(headers, &fetch.HeaderEntry{Name: name, Value: value})
+		}
+	}
+	err = fetch.FulfillRequest(ev.RequestID, int64(resp.StatusCode)).
+		WithResponseHeaders(headers).
+		WithBody(base64.StdEncoding.EncodeToString(respBody)).
+		Do(ctx)
+	if err != nil {
+		a.session.Out.Debug("[%s] Failed to fulfill request for %s: %v\n", a.ID(), ev.Request.URL, err)
+	}
+}
+
+func proxyFunc(proxy *core.Proxy) func(*http.Request) (*url.URL, error) {
+	if proxy == nil {
+		return nil
+	}
+	return http.ProxyURL(proxy.URL)
+}
+
+// chromeProxyServer formats a proxy for Chrome's --proxy-server flag, which
+// always resolves hostnames through SOCKS5 proxies and has no socks5h scheme.
+// Proxies with credentials are never passed to Chrome.
+func chromeProxyServer(proxy *core.Proxy) string {
+	u := *proxy.URL
+	u.User = nil
+	if u.Scheme == "socks5h" {
+		u.Scheme = "socks5"
+	}
+	return u.String()
 }
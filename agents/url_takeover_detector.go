@@ -39,6 +39,11 @@ func (a *URLTakeoverDetector) OnURLResponsive(u string) {
 		return
 	}
 
+	if page.VirtualHost != nil {
+		a.session.Out.Debug("[%s] Skipping takeover detection on virtual host URL %s\n", a.ID(), u)
+		return
+	}
+
 	a.session.WaitGroup.Add()
 	go func(p *core.Page) {
 		defer a.session.WaitGroup.Done()
@@ -62,7 +67,7 @@ func (a *URLTakeoverDetector) runDetectorFunctions(page *core.Page) {
 	a.session.Out.Debug("[%s] IP addresses for %s: %v\n", a.ID(), hostname, addrs)
 	a.session.Out.Debug("[%s] CNAME for %s: %s\n", a.ID(), hostname, cname)
 
-	body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+	body, err := a.session.ReadBody(page)
 	if err != nil {
 		a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
 		return
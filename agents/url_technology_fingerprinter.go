@@ -1,8 +1,6 @@
 package agents
 
 import (
-	"fmt"
-
 	"github.com/shelld3v/aquatone/core"
 	wappalyzer "github.com/projectdiscovery/wappalyzergo"
 )
@@ -38,7 +36,7 @@ func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 	go func(page *core.Page) {
 		defer a.session.WaitGroup.Done()
 
-		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		body, err := a.session.ReadBody(page)
 		if err != nil {
 			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
 			return
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/shelld3v/aquatone/core"
 )
@@ -33,7 +34,6 @@ func (a *URLTlsChecker) OnURLResponsive(url string) {
 	var conn *tls.Conn
 	var err  error
 
-	tlsConfig := http.DefaultTransport.(*http.Transport).TLSClientConfig
 	versions := map[uint16]string{
 		tls.VersionSSL30: "SSLv3",
 		tls.VersionTLS10: "TLS 1.0",
@@ -56,10 +56,16 @@ func (a *URLTlsChecker) OnURLResponsive(url string) {
 			return
 		}
 
+		tlsConfig := TLSConfig(a.session, page.ParsedURL().Hostname())
 		client := &http.Client{
 			Transport: &http.Transport{
+				Dial: func(network, addr string) (net.Conn, error) {
+					timeout := time.Duration(a.session.Options.HTTPTimeout) * time.Millisecond
+					return DialTimeout(a.session, a.ID(), network, addr, timeout)
+				},
 				DialTLS: func(network, addr string) (net.Conn, error) {
-					conn, err = tls.Dial(network, addr, tlsConfig)
+					timeout := time.Duration(a.session.Options.HTTPTimeout) * time.Millisecond
+					conn, err = DialTLS(a.session, a.ID(), network, addr, timeout, tlsConfig)
 					return conn, err
 				},
 			},
@@ -68,6 +74,9 @@ func (a *URLTlsChecker) OnURLResponsive(url string) {
 
 		if err != nil {
 			a.session.Out.Debug("[%s] Unable to identify TLS information for %s: %s\n", a.ID(), page.URL, err)
+			if IsClientCertificateRejection(err) {
+				a.session.Out.Error("%s: %s (%v)\n", page.URL, Red("client certificate rejected"), err)
+			}
 			return
 		}
 
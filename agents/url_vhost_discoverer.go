@@ -0,0 +1,251 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/shelld3v/aquatone/core"
+)
+
+type URLVHostDiscoverer struct {
+	session   *core.Session
+	origins   map[string]bool
+	hostnames map[string]bool
+	mutex     sync.Mutex
+}
+
+func NewURLVHostDiscoverer() *URLVHostDiscoverer {
+	return &URLVHostDiscoverer{
+		origins:   make(map[string]bool),
+		hostnames: make(map[string]bool),
+	}
+}
+
+func (a *URLVHostDiscoverer) ID() string {
+	return "agent:url_vhost_discoverer"
+}
+
+func (a *URLVHostDiscoverer) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.VHosts {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.Host, a.OnHost, false)
+	s.EventBus.SubscribeAsync(core.URL, a.OnURL, false)
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+// OnHost records hostnames from the input to try as virtual hosts.
+func (a *URLVHostDiscoverer) OnHost(host string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.hostnames[strings.ToLower(host)] = true
+}
+
+// OnURL records the hostnames of URLs to try as virtual hosts.
+func (a *URLVHostDiscoverer) OnURL(url string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if hostname := HostnameFromURL(url); hostname != "" {
+		a.hostnames[strings.ToLower(hostname)] = true
+	}
+}
+
+func (a *URLVHostDiscoverer) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+	if !page.IsIPHost() {
+		return
+	}
+
+	origin := fmt.Sprintf("%s://%s", page.ParsedURL().Scheme, page.ParsedURL().Host)
+	a.mutex.Lock()
+	if a.origins[origin] {
+		a.mutex.Unlock()
+		return
+	}
+	a.origins[origin] = true
+	a.mutex.Unlock()
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page, origin string) {
+		defer a.session.WaitGroup.Done()
+		a.discover(page, origin)
+	}(page, origin)
+}
+
+// discover requests the origin with every candidate hostname in the Host
+// header and SNI, and publishes the URLs of hostnames that get a different
+// response than the default virtual host and a random hostname. Hostnames
+// that resolve to other addresses are only noted on page.
+func (a *URLVHostDiscoverer) discover(page *core.Page, origin string) {
+	u := page.ParsedURL()
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	var baselines []*probeResponse
+	for _, host := range []string{"", uuid.New().String() + ".invalid"} {
+		baseline, err := a.request(origin, host)
+		if err != nil {
+			a.session.Out.Debug("[%s] Unable to get default virtual host baseline for %s: %v\n", a.ID(), origin, err)
+			return
+		}
+		if host != "" {
+			baseline.Location = strings.Replace(baseline.Location, host, "", -1)
+		}
+		baselines = append(baselines, baseline)
+	}
+
+	var found, elsewhere []string
+	for _, hostname := range a.candidates() {
+		vhostURL := fmt.Sprintf("%s://%s/", u.Scheme, hostname)
+		if u.Port() != "" {
+			vhostURL = fmt.Sprintf("%s://%s/", u.Scheme, net.JoinHostPort(hostname, port))
+		}
+		// Hostnames from the input that don't resolve have no page yet,
+		// and are exactly the ones worth trying on the IP.
+		if a.session.GetPage(vhostURL) != nil {
+			continue
+		}
+
+		resp, err := a.request(origin, hostname)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error requesting %s with Host %s: %v\n", a.ID(), origin, hostname, err)
+			continue
+		}
+		similarity, isDefault := a.isDefault(resp, hostname, baselines)
+		if isDefault {
+			continue
+		}
+
+		if addrs := a.resolvesElsewhere(hostname, u.Hostname()); len(addrs) > 0 {
+			// Requests for the hostname must keep going to the addresses it
+			// resolves to, so it is only reported on the IP page.
+			elsewhere = append(elsewhere, fmt.Sprintf("%s (%s)", hostname, strings.Join(addrs, ", ")))
+			a.session.Out.Info("%s: %s\n", origin, Green(fmt.Sprintf("found virtual host %s (%s), resolves to %s", hostname, resp.Status, strings.Join(addrs, ", "))))
+			continue
+		}
+
+		vhost := &core.VirtualHost{
+			Hostname:   hostname,
+			Addr:       net.JoinHostPort(u.Hostname(), port),
+			BaseURL:    page.URL,
+			Status:     resp.Status,
+			Similarity: similarity,
+		}
+		if !a.session.AddVirtualHost(vhost, port) {
+			continue
+		}
+		found = append(found, hostname)
+		a.session.Out.Info("%s: %s\n", origin, Green(fmt.Sprintf("found virtual host %s (%s)", hostname, resp.Status)))
+		a.session.EventBus.Publish(core.URL, vhostURL)
+	}
+
+	if total := len(found) + len(elsewhere); total > 0 {
+		page.AddTag(fmt.Sprintf("Virtual Hosts: %d", total), "info", "")
+	}
+	if len(found) > 0 {
+		page.AddNote(fmt.Sprintf("Virtual hosts found on %s: %s", origin, strings.Join(found, ", ")), "info")
+	}
+	if len(elsewhere) > 0 {
+		page.AddNote(fmt.Sprintf("Virtual hosts found on %s that resolve to other addresses: %s", origin, strings.Join(elsewhere, ", ")), "warning")
+	}
+}
+
+// resolvesElsewhere returns the addresses hostname resolves to if none of
+// them is ip. It returns nil if the hostname doesn't resolve or resolves to
+// ip, in which case its requests can safely be sent to ip.
+func (a *URLVHostDiscoverer) resolvesElsewhere(hostname string, ip string) []string {
+	addrs, err := net.LookupHost(fmt.Sprintf("%s.", hostname))
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if net.ParseIP(addr).Equal(net.ParseIP(ip)) {
+			return nil
+		}
+	}
+	return addrs
+}
+
+// candidates returns the hostnames to try, built from the hostnames known so
+// far and the wordlist.
+func (a *URLVHostDiscoverer) candidates() []string {
+	a.mutex.Lock()
+	var known []string
+	for hostname := range a.hostnames {
+		known = append(known, hostname)
+	}
+	a.mutex.Unlock()
+	return core.VirtualHostCandidates(known, a.session.VHostWordlist)
+}
+
+func (a *URLVHostDiscoverer) request(origin string, host string) (*probeResponse, error) {
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:   origin + "/",
+		Host:  host,
+		Limit: maxProbeBodySize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	structure, _ := core.GetPageStructure(bytes.NewReader(resp.Body))
+	return &probeResponse{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Location:   resp.Header.Get("Location"),
+		Length:     len(resp.Body),
+		Structure:  structure,
+	}, nil
+}
+
+// isDefault reports whether resp looks like the response of the default
+// virtual host, and returns its highest similarity to the baselines.
+// Redirects and bodies may reflect the requested hostname, so it is removed
+// before comparing them.
+func (a *URLVHostDiscoverer) isDefault(resp *probeResponse, hostname string, baselines []*probeResponse) (float64, bool) {
+	highest := 0.0
+	for _, baseline := range baselines {
+		similarity := core.GetSimilarity(resp.Structure, baseline.Structure)
+		if similarity > highest {
+			highest = similarity
+		}
+		if resp.StatusCode != baseline.StatusCode {
+			continue
+		}
+		if resp.Location != "" || baseline.Location != "" {
+			if strings.Replace(resp.Location, hostname, "", -1) == baseline.Location || sameRedirectTarget(resp.Location, baseline.Location) {
+				return highest, true
+			}
+			continue
+		}
+		if len(resp.Structure) == 0 && len(baseline.Structure) == 0 {
+			diff := resp.Length - baseline.Length
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff <= len(hostname)+40 {
+				return highest, true
+			}
+			continue
+		}
+		if similarity >= a.session.Options.Similarity {
+			return highest, true
+		}
+	}
+	return highest, false
+}
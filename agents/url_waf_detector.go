@@ -0,0 +1,83 @@
+package agents
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+type URLWAFDetector struct {
+	session *core.Session
+}
+
+func NewURLWAFDetector() *URLWAFDetector {
+	return &URLWAFDetector{}
+}
+
+func (a *URLWAFDetector) ID() string {
+	return "agent:url_waf_detector"
+}
+
+func (a *URLWAFDetector) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLWAFDetector) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.Done()
+
+		// Headers and cookies are enough for most rules, so pages without a
+		// saved body are still checked.
+		body, err := a.session.ReadBody(page)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+		}
+		status, _ := strconv.Atoi(strings.SplitN(page.Status, " ", 2)[0])
+
+		matches := core.DetectWAF(a.session.WAFRules, a.session.IPRanges, status, page.Headers, page.Cookies, body, a.addrs(page))
+		if len(matches) == 0 {
+			return
+		}
+
+		page.Lock()
+		page.WAF = matches
+		page.Unlock()
+		for _, match := range matches {
+			a.session.Out.Debug("[%s] Detected %s %s in front of %s\n", a.ID(), match.Name, match.Category, page.URL)
+			page.AddTag(fmt.Sprintf("%s: %s", match.Category, match.Name), "info", page.HeadersPath)
+			page.AddNote(fmt.Sprintf("Behind %s (%s): %s", match.Name, match.Category, strings.Join(match.Evidence, ", ")), "info")
+		}
+	}(page)
+}
+
+// addrs returns the addresses of the host of a page when IP ranges are
+// loaded. The host is resolved here, as the hostname resolver may not have
+// finished yet.
+func (a *URLWAFDetector) addrs(page *core.Page) []string {
+	if len(a.session.IPRanges) == 0 {
+		return nil
+	}
+	if page.IsIPHost() {
+		return []string{page.ParsedURL().Hostname()}
+	}
+	addrs, err := net.LookupHost(fmt.Sprintf("%s.", page.ParsedURL().Hostname()))
+	if err != nil {
+		a.session.Out.Debug("[%s] Failed to resolve hostname for %s: %v\n", a.ID(), page.URL, err)
+		return nil
+	}
+	return addrs
+}
@@ -0,0 +1,244 @@
+package agents
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/shelld3v/aquatone/core"
+)
+
+const (
+	maxSitemaps           = 10
+	maxWellKnownPublished = 250
+	maxWellKnownSize      = 10 * 1024 * 1024
+)
+
+type URLWellKnownFetcher struct {
+	session   *core.Session
+	origins   map[string]bool
+	published map[string]bool
+	mutex     sync.Mutex
+}
+
+func NewURLWellKnownFetcher() *URLWellKnownFetcher {
+	return &URLWellKnownFetcher{
+		origins:   make(map[string]bool),
+		published: make(map[string]bool),
+	}
+}
+
+func (a *URLWellKnownFetcher) ID() string {
+	return "agent:url_well_known_fetcher"
+}
+
+func (a *URLWellKnownFetcher) Register(s *core.Session) error {
+	a.session = s
+	if !s.Options.WellKnown {
+		return nil
+	}
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	return nil
+}
+
+func (a *URLWellKnownFetcher) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	origin := fmt.Sprintf("%s://%s", page.ParsedURL().Scheme, page.ParsedURL().Host)
+	a.mutex.Lock()
+	if a.origins[origin] {
+		a.mutex.Unlock()
+		return
+	}
+	a.origins[origin] = true
+	a.mutex.Unlock()
+
+	a.session.WaitGroup.Add()
+	go func(page *core.Page, origin string) {
+		defer a.session.WaitGroup.Done()
+		wellKnown := &core.WellKnown{}
+		sitemaps := a.fetchRobotsTxt(origin, wellKnown)
+		a.fetchSitemaps(origin, sitemaps, wellKnown)
+		a.fetchSecurityTxt(origin, wellKnown)
+		a.fetchOpenIDConfiguration(origin, wellKnown)
+		if len(wellKnown.Files) == 0 {
+			a.session.Out.Debug("[%s] No well-known files found on %s\n", a.ID(), origin)
+			return
+		}
+
+		page.WellKnown = wellKnown
+		for _, f := range wellKnown.Files {
+			if !strings.HasPrefix(f.Name, "sitemap-") {
+				page.AddTag(f.Name, "dark", f.Path)
+			}
+		}
+		page.AddNote(wellKnown.Summary(), "info")
+
+		if a.session.Options.WellKnownPublish {
+			a.publish(origin, wellKnown)
+		}
+	}(page, origin)
+}
+
+// fetchRobotsTxt fetches robots.txt and returns the sitemaps listed in it.
+func (a *URLWellKnownFetcher) fetchRobotsTxt(origin string, wellKnown *core.WellKnown) []string {
+	data := a.fetchFile(origin, "/robots.txt", core.RobotsTxt, wellKnown)
+	if data == nil {
+		return nil
+	}
+	var sitemaps []string
+	wellKnown.DisallowedPaths, sitemaps = core.ParseRobotsTxt(data)
+	return sitemaps
+}
+
+// fetchSitemaps fetches /sitemap.xml and the sitemaps listed in robots.txt or
+// in sitemap indexes on the same origin.
+func (a *URLWellKnownFetcher) fetchSitemaps(origin string, robotsSitemaps []string, wellKnown *core.WellKnown) {
+	queue := append([]string{origin + "/sitemap.xml"}, robotsSitemaps...)
+
+	seen := make(map[string]bool)
+	for i := 0; i < len(queue) && len(seen) < maxSitemaps; i++ {
+		sitemapURL := queue[i]
+		u, err := url.Parse(sitemapURL)
+		if err != nil || seen[u.String()] || fmt.Sprintf("%s://%s", u.Scheme, u.Host) != origin {
+			continue
+		}
+		seen[u.String()] = true
+
+		name := core.SitemapXML
+		if len(seen) > 1 {
+			name = fmt.Sprintf("sitemap-%d.xml", len(seen))
+		}
+		data := a.fetchFile(origin, u.RequestURI(), name, wellKnown)
+		if data == nil {
+			continue
+		}
+		urls, sitemaps, err := core.ParseSitemap(data)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error parsing sitemap %s: %v\n", a.ID(), sitemapURL, err)
+			continue
+		}
+		wellKnown.SitemapURLs = append(wellKnown.SitemapURLs, urls...)
+		queue = append(queue, sitemaps...)
+	}
+}
+
+func (a *URLWellKnownFetcher) fetchSecurityTxt(origin string, wellKnown *core.WellKnown) {
+	data := a.fetchFile(origin, "/.well-known/security.txt", core.SecurityTxt, wellKnown)
+	if data == nil {
+		return
+	}
+	wellKnown.SecurityContacts = core.ParseSecurityTxt(data)
+}
+
+func (a *URLWellKnownFetcher) fetchOpenIDConfiguration(origin string, wellKnown *core.WellKnown) {
+	data := a.fetchFile(origin, "/.well-known/openid-configuration", core.OpenIDConfiguration, wellKnown)
+	if data == nil {
+		return
+	}
+	wellKnown.OpenIDIssuer, _ = core.ParseOpenIDConfiguration(data)
+}
+
+// fetchFile fetches a file from origin and saves it when it looks like the
+// expected file and not like an error page or a catch-all HTML response.
+func (a *URLWellKnownFetcher) fetchFile(origin string, path string, name string, wellKnown *core.WellKnown) []byte {
+	fileURL := origin + path
+	resp, err := fetchURL(a.session, a.ID(), fetchRequest{
+		URL:   fileURL,
+		Limit: fetchLimit(a.session, maxWellKnownSize),
+	})
+	if err != nil {
+		a.session.Out.Debug("[%s] Error fetching %s: %v\n", a.ID(), fileURL, err)
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK || !a.isExpectedContent(name, resp.Body) {
+		a.session.Out.Debug("[%s] No %s at %s (%s)\n", a.ID(), name, fileURL, resp.Status)
+		return nil
+	}
+
+	filepath := fmt.Sprintf("wellknown/%s__%s", originFilename(origin), name)
+	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), resp.Body, 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write %s for %s to %s\n", name, origin, a.session.GetFilePath(filepath))
+		return nil
+	}
+	wellKnown.Files = append(wellKnown.Files, core.WellKnownFile{
+		Name: name,
+		URL:  fileURL,
+		Path: filepath,
+	})
+	return resp.Body
+}
+
+func (a *URLWellKnownFetcher) isExpectedContent(name string, data []byte) bool {
+	trimmed := bytes.ToLower(bytes.TrimSpace(data))
+	if len(trimmed) == 0 || isHTML(trimmed) {
+		return false
+	}
+	switch name {
+	case core.RobotsTxt:
+		return bytes.Contains(trimmed, []byte("user-agent")) || bytes.Contains(trimmed, []byte("disallow")) || bytes.Contains(trimmed, []byte("sitemap"))
+	case core.SecurityTxt:
+		return bytes.Contains(trimmed, []byte("contact:"))
+	case core.OpenIDConfiguration:
+		issuer, err := core.ParseOpenIDConfiguration(data)
+		return err == nil && issuer != ""
+	}
+	return bytes.HasPrefix(trimmed, []byte("<"))
+}
+
+// publish publishes the disallowed paths and sitemap URLs on the same host
+// as origin as new URLs.
+func (a *URLWellKnownFetcher) publish(origin string, wellKnown *core.WellKnown) {
+	base, _ := url.Parse(origin + "/")
+	var candidates []string
+	for _, p := range wellKnown.DisallowedPaths {
+		if strings.ContainsAny(p, "*$") {
+			continue
+		}
+		if u, err := base.Parse(p); err == nil {
+			candidates = append(candidates, u.String())
+		}
+	}
+	candidates = append(candidates, wellKnown.SitemapURLs...)
+
+	count := 0
+	for _, candidate := range candidates {
+		u, err := url.Parse(candidate)
+		if err != nil || u.Hostname() != base.Hostname() || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		if count >= maxWellKnownPublished {
+			a.session.Out.Debug("[%s] Reached limit of %d published URLs for %s\n", a.ID(), maxWellKnownPublished, origin)
+			return
+		}
+		a.mutex.Lock()
+		if a.published[u.String()] || a.session.GetPage(u.String()) != nil {
+			a.mutex.Unlock()
+			continue
+		}
+		a.published[u.String()] = true
+		a.mutex.Unlock()
+		count++
+		a.session.Out.Debug("[%s] Publishing URL %s\n", a.ID(), u.String())
+		a.session.EventBus.Publish(core.URL, u.String())
+	}
+}
+
+func originFilename(origin string) string {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return ""
+	}
+	host := strings.Replace(u.Host, ":", "__", 1)
+	return strings.ToLower(fmt.Sprintf("%s__%s", u.Scheme, strings.Replace(host, ".", "_", -1)))
+}
@@ -1,11 +1,13 @@
 package agents
 
 import (
+	"context"
 	"crypto/sha1"
 	"crypto/tls"
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/url"
 	"strconv"
 	"strings"
@@ -95,11 +97,120 @@ func URLEscape(s string) string {
 	return url.QueryEscape(s)
 }
 
-func Gorequest(o core.Options) *gorequest.SuperAgent {
-	return gorequest.New().
-		Proxy(o.Proxy).
-		Timeout(time.Duration(o.HTTPTimeout)*time.Millisecond).
-		TLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+// Gorequest returns a request agent for host along with the proxy picked for
+// it from the session's proxy pool, if any.
+func Gorequest(s *core.Session, component string, host string) (*gorequest.SuperAgent, *core.Proxy, error) {
+	proxy, err := s.Proxies.Next(host)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proxyURL := ""
+	if proxy != nil {
+		proxyURL = proxy.URL.String()
+	}
+	s.RecordProxyUse(component, proxy != nil)
+
+	agent := gorequest.New().
+		Proxy(proxyURL).
+		TLSClientConfig(TLSConfig(s, host))
+	agent.Transport.DialContext = timeoutDialContext(s, time.Duration(s.Options.HTTPTimeout)*time.Millisecond)
+	return agent, proxy, nil
+}
+
+// timeoutDialContext works like gorequest's Timeout, but dials with the
+// request context so that httptrace hooks are called for DNS lookups and
+// connects. Connections to discovered virtual hosts go to their address.
+func timeoutDialContext(s *core.Session, timeout time.Duration) func(ctx context.Context, network string, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	return func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		if vhost := s.GetVirtualHost(addr); vhost != nil {
+			addr = vhost.Addr
+		}
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		conn.SetDeadline(time.Now().Add(timeout))
+		return conn, nil
+	}
+}
+
+// DialTimeout connects to addr, through a proxy from the session's proxy pool
+// when proxies are configured.
+func DialTimeout(s *core.Session, component string, network string, addr string, timeout time.Duration) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := s.Proxies.Next(host)
+	if err != nil {
+		return nil, err
+	}
+	s.RecordProxyUse(component, proxy != nil)
+	if proxy == nil {
+		if vhost := s.GetVirtualHost(addr); vhost != nil {
+			addr = vhost.Addr
+		}
+		return net.DialTimeout(network, addr, timeout)
+	}
+	return proxy.DialTimeout(network, addr, timeout)
+}
+
+// DialTLS is like DialTimeout but performs a TLS handshake on the connection.
+func DialTLS(s *core.Session, component string, network string, addr string, timeout time.Duration, conf *tls.Config) (*tls.Conn, error) {
+	rawConn, err := DialTimeout(s, component, network, addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	if conf.ServerName == "" {
+		conf = conf.Clone()
+		conf.ServerName, _, _ = net.SplitHostPort(addr)
+	}
+	conn := tls.Client(rawConn, conf)
+	conn.SetDeadline(time.Now().Add(timeout))
+	if err := conn.Handshake(); err != nil {
+		rawConn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// TLSConfig returns the TLS client configuration to use for connections to
+// host, including any client certificate configured for it.
+func TLSConfig(s *core.Session, host string) *tls.Config {
+	conf := &tls.Config{
+		InsecureSkipVerify: true,
+	}
+	if cert := s.ClientCertificates.ForHost(host); cert != nil {
+		conf.Certificates = []tls.Certificate{*cert}
+	}
+	return conf
+}
+
+// IsClientCertificateRejection reports whether err is a TLS alert sent by a
+// server that did not accept the client certificate (or the lack of one).
+func IsClientCertificateRejection(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, alert := range []string{
+		"tls: bad certificate",
+		"tls: certificate required",
+		"tls: unknown certificate authority",
+		"tls: unknown certificate",
+		"tls: unsupported certificate",
+		"tls: revoked certificate",
+		"tls: expired certificate",
+		"tls: access denied",
+	} {
+		if strings.Contains(msg, alert) {
+			return true
+		}
+	}
+	return false
 }
 
 func BaseFilenameFromURL(s string) string {
@@ -118,6 +229,14 @@ func BaseFilenameFromURL(s string) string {
 	return strings.ToLower(filename)
 }
 
+func HostnameFromURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
 func HostAndPortToURL(host string, port int, protocol string) string {
 	return core.HostAndPortToURL(host, port, protocol)
 }
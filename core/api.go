@@ -0,0 +1,211 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+type APIOperation struct {
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	OperationID string `json:"operationId"`
+	Summary     string `json:"summary"`
+}
+
+// OpenAPISpec is an OpenAPI or Swagger document found on the origin of a
+// page.
+type OpenAPISpec struct {
+	URL        string         `json:"url"`
+	Path       string         `json:"path"`
+	Version    string         `json:"version"`
+	Title      string         `json:"title"`
+	APIVersion string         `json:"apiVersion"`
+	BasePath   string         `json:"basePath"`
+	Operations []APIOperation `json:"operations"`
+}
+
+// GraphQLEndpoint is a GraphQL endpoint found on the origin of a page. The
+// type and field counts are only known when introspection is enabled.
+type GraphQLEndpoint struct {
+	URL           string   `json:"url"`
+	Path          string   `json:"path"`
+	Introspection bool     `json:"introspection"`
+	Types         int      `json:"types"`
+	Queries       []string `json:"queries"`
+	Mutations     []string `json:"mutations"`
+	Subscriptions []string `json:"subscriptions"`
+}
+
+type APISurface struct {
+	OpenAPISpecs     []OpenAPISpec     `json:"openapiSpecs"`
+	GraphQLEndpoints []GraphQLEndpoint `json:"graphqlEndpoints"`
+}
+
+// Summary returns a short description of an OpenAPI spec.
+func (s *OpenAPISpec) Summary() string {
+	title := s.Title
+	if title == "" {
+		title = "Untitled API"
+	}
+	if s.APIVersion != "" {
+		title += " " + s.APIVersion
+	}
+	return fmt.Sprintf("%s spec at %s: %s, %s", s.Version, s.URL, title, pluralize(len(s.Operations), "operation", "operations"))
+}
+
+// Summary returns a short description of a GraphQL endpoint.
+func (e *GraphQLEndpoint) Summary() string {
+	if !e.Introspection {
+		return fmt.Sprintf("GraphQL endpoint at %s, introspection disabled", e.URL)
+	}
+	return fmt.Sprintf("GraphQL endpoint at %s, introspection enabled: %s, %s, %s", e.URL,
+		pluralize(e.Types, "type", "types"),
+		pluralize(len(e.Queries), "query", "queries"),
+		pluralize(len(e.Mutations), "mutation", "mutations"))
+}
+
+type openAPIDocument struct {
+	Swagger string `yaml:"swagger"`
+	OpenAPI string `yaml:"openapi"`
+	Info    struct {
+		Title   string `yaml:"title"`
+		Version string `yaml:"version"`
+	} `yaml:"info"`
+	BasePath string                            `yaml:"basePath"`
+	Paths    map[string]map[string]interface{} `yaml:"paths"`
+}
+
+// ParseOpenAPISpec parses an OpenAPI 3 or Swagger 2 document in JSON or YAML
+// format and lists its operations.
+func ParseOpenAPISpec(data []byte) (*OpenAPISpec, error) {
+	var doc openAPIDocument
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	spec := &OpenAPISpec{
+		Title:      doc.Info.Title,
+		APIVersion: doc.Info.Version,
+		BasePath:   doc.BasePath,
+	}
+	switch {
+	case doc.OpenAPI != "":
+		spec.Version = "OpenAPI " + doc.OpenAPI
+	case doc.Swagger != "":
+		spec.Version = "Swagger " + doc.Swagger
+	default:
+		return nil, fmt.Errorf("Not an OpenAPI or Swagger document")
+	}
+
+	var paths []string
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		for _, method := range openAPIMethods {
+			operation, ok := doc.Paths[path][method]
+			if !ok {
+				continue
+			}
+			op := APIOperation{
+				Method: strings.ToUpper(method),
+				Path:   path,
+			}
+			if fields, ok := operation.(map[string]interface{}); ok {
+				op.OperationID, _ = fields["operationId"].(string)
+				op.Summary, _ = fields["summary"].(string)
+			}
+			spec.Operations = append(spec.Operations, op)
+		}
+	}
+	return spec, nil
+}
+
+// GraphQLIntrospectionQuery asks for the root operation types and the names
+// of all types and their fields.
+const GraphQLIntrospectionQuery = `query IntrospectionQuery { __schema { queryType { name } mutationType { name } subscriptionType { name } types { name kind fields(includeDeprecated: true) { name } } } }`
+
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// IsGraphQLResponse reports whether data is the response of a GraphQL server
+// to a {__typename} query, or an error that only a GraphQL server would give.
+func IsGraphQLResponse(data []byte) bool {
+	var resp graphQLResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return false
+	}
+	if typename, ok := resp.Data["__typename"]; ok {
+		var name string
+		return json.Unmarshal(typename, &name) == nil && name != ""
+	}
+	for _, e := range resp.Errors {
+		message := strings.ToLower(e.Message)
+		if strings.Contains(message, "query") || strings.Contains(message, "graphql") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseGraphQLIntrospection fills endpoint with the types and root fields of
+// an introspection query response. It returns an error when the response has
+// no schema, which usually means that introspection is disabled.
+func ParseGraphQLIntrospection(data []byte, endpoint *GraphQLEndpoint) error {
+	var resp struct {
+		Data struct {
+			Schema *struct {
+				QueryType        *struct{ Name string } `json:"queryType"`
+				MutationType     *struct{ Name string } `json:"mutationType"`
+				SubscriptionType *struct{ Name string } `json:"subscriptionType"`
+				Types            []struct {
+					Name   string `json:"name"`
+					Kind   string `json:"kind"`
+					Fields []struct {
+						Name string `json:"name"`
+					} `json:"fields"`
+				} `json:"types"`
+			} `json:"__schema"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+	schema := resp.Data.Schema
+	if schema == nil {
+		return fmt.Errorf("No schema in introspection response")
+	}
+
+	endpoint.Introspection = true
+	fields := make(map[string][]string)
+	for _, t := range schema.Types {
+		if strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+		endpoint.Types++
+		for _, f := range t.Fields {
+			fields[t.Name] = append(fields[t.Name], f.Name)
+		}
+	}
+	if schema.QueryType != nil {
+		endpoint.Queries = fields[schema.QueryType.Name]
+	}
+	if schema.MutationType != nil {
+		endpoint.Mutations = fields[schema.MutationType.Name]
+	}
+	if schema.SubscriptionType != nil {
+		endpoint.Subscriptions = fields[schema.SubscriptionType.Name]
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package core
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf16"
+)
+
+const ntlmSignature = "NTLMSSP\x00"
+
+// loginProviders are identity providers recognized from the host or path of
+// a login redirect.
+var loginProviders = []struct {
+	Name  string
+	Host  string
+	Path  string
+	Query string
+}{
+	{"Azure AD", "login.microsoftonline.com", "", ""},
+	{"Azure AD", "login.windows.net", "", ""},
+	{"ADFS", "", "/adfs/ls", ""},
+	{"Google", "accounts.google.com", "", ""},
+	{"Okta", ".okta.com", "", ""},
+	{"Auth0", ".auth0.com", "", ""},
+	{"Keycloak", "", "/protocol/openid-connect/auth", ""},
+	{"SAML", "", "", "SAMLRequest"},
+	{"CAS", "", "/cas/login", ""},
+	{"OAuth", "", "", "client_id"},
+}
+
+// AuthChallenge is a challenge from a WWW-Authenticate header. Token holds the
+// token68 value of schemes like NTLM and Negotiate.
+type AuthChallenge struct {
+	Scheme string            `json:"scheme"`
+	Realm  string            `json:"realm"`
+	Token  string            `json:"token"`
+	Params map[string]string `json:"params"`
+}
+
+// NTLMChallenge is the information a server discloses in an NTLM challenge
+// (Type 2) message, which usually includes internal domain and host names.
+type NTLMChallenge struct {
+	TargetName      string `json:"targetName"`
+	NetBIOSDomain   string `json:"netbiosDomain"`
+	NetBIOSComputer string `json:"netbiosComputer"`
+	DNSDomain       string `json:"dnsDomain"`
+	DNSComputer     string `json:"dnsComputer"`
+	DNSTree         string `json:"dnsTree"`
+	OSVersion       string `json:"osVersion"`
+}
+
+// Authentication is what is known about how a page authenticates users.
+type Authentication struct {
+	Challenges    []AuthChallenge `json:"challenges"`
+	NTLM          *NTLMChallenge  `json:"ntlm"`
+	LoginRedirect string          `json:"loginRedirect"`
+	LoginProvider string          `json:"loginProvider"`
+}
+
+// ParseWWWAuthenticate parses the challenges in WWW-Authenticate header
+// values. A value can hold several challenges separated by commas, each with
+// either a token68 value or a list of parameters.
+func ParseWWWAuthenticate(values []string) []AuthChallenge {
+	var challenges []AuthChallenge
+	for _, value := range values {
+		var current *AuthChallenge
+		afterComma := false
+		i, n := 0, len(value)
+		for i < n {
+			for i < n && (value[i] == ' ' || value[i] == '\t' || value[i] == ',') {
+				if value[i] == ',' {
+					afterComma = true
+				}
+				i++
+			}
+			if i == n {
+				break
+			}
+			start := i
+			for i < n && !strings.ContainsRune(" \t,=", rune(value[i])) {
+				i++
+			}
+			token := value[start:i]
+
+			equals := i
+			for equals < n && value[equals] == '=' {
+				equals++
+			}
+			if equals > i && (equals == n || strings.ContainsRune(" \t,", rune(value[equals])) || equals-i > 1) {
+				// A token68 value ends with '=' padding.
+				if current != nil && current.Token == "" && len(current.Params) == 0 {
+					current.Token = value[start:equals]
+				}
+				i = equals
+				continue
+			}
+			if equals > i && current != nil {
+				param, end := parseAuthParamValue(value, equals)
+				current.Params[strings.ToLower(token)] = param
+				i = end
+				afterComma = false
+				continue
+			}
+			if current != nil && !afterComma && current.Token == "" && len(current.Params) == 0 {
+				current.Token = token
+				continue
+			}
+			challenges = append(challenges, AuthChallenge{Scheme: token, Params: make(map[string]string)})
+			current = &challenges[len(challenges)-1]
+			afterComma = false
+		}
+	}
+	for i := range challenges {
+		challenges[i].Realm = challenges[i].Params["realm"]
+	}
+	return challenges
+}
+
+// parseAuthParamValue reads a token or quoted string starting at i and
+// returns it along with the position after it.
+func parseAuthParamValue(value string, i int) (string, int) {
+	n := len(value)
+	if i < n && value[i] == '"' {
+		var b strings.Builder
+		for i++; i < n && value[i] != '"'; i++ {
+			if value[i] == '\\' && i+1 < n {
+				i++
+			}
+			b.WriteByte(value[i])
+		}
+		return b.String(), i + 1
+	}
+	start := i
+	for i < n && value[i] != ',' && value[i] != ' ' && value[i] != '\t' {
+		i++
+	}
+	return value[start:i], i
+}
+
+// NTLMNegotiateMessage returns a base64 encoded NTLM negotiate (Type 1)
+// message that asks the server for a challenge with target information.
+func NTLMNegotiateMessage() string {
+	msg := make([]byte, 32)
+	copy(msg, ntlmSignature)
+	binary.LittleEndian.PutUint32(msg[8:], 1)
+	// Unicode, OEM, request target, NTLM, always sign, extended session
+	// security, 128-bit and 56-bit encryption.
+	binary.LittleEndian.PutUint32(msg[12:], 0xa2088207)
+	return base64.StdEncoding.EncodeToString(msg)
+}
+
+// ParseNTLMChallenge decodes a base64 encoded NTLM challenge (Type 2)
+// message, which may be wrapped in a SPNEGO token for Negotiate.
+func ParseNTLMChallenge(token string) (*NTLMChallenge, error) {
+	msg, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	if i := bytes.Index(msg, []byte(ntlmSignature)); i > 0 {
+		msg = msg[i:]
+	}
+	if len(msg) < 32 || string(msg[:8]) != ntlmSignature || binary.LittleEndian.Uint32(msg[8:]) != 2 {
+		return nil, fmt.Errorf("Not an NTLM challenge message")
+	}
+	flags := binary.LittleEndian.Uint32(msg[20:])
+	unicode := flags&0x1 != 0
+
+	challenge := &NTLMChallenge{}
+	if name, ok := ntlmField(msg, 12); ok {
+		challenge.TargetName = ntlmString(name, unicode)
+	}
+	if len(msg) >= 56 && flags&0x02000000 != 0 {
+		challenge.OSVersion = fmt.Sprintf("%d.%d.%d", msg[48], msg[49], binary.LittleEndian.Uint16(msg[50:]))
+	}
+	info, ok := ntlmField(msg, 40)
+	if !ok || len(msg) < 48 {
+		return challenge, nil
+	}
+	for len(info) >= 4 {
+		id := binary.LittleEndian.Uint16(info)
+		length := int(binary.LittleEndian.Uint16(info[2:]))
+		if id == 0 || len(info) < 4+length {
+			break
+		}
+		value := ntlmString(info[4:4+length], true)
+		switch id {
+		case 1:
+			challenge.NetBIOSComputer = value
+		case 2:
+			challenge.NetBIOSDomain = value
+		case 3:
+			challenge.DNSComputer = value
+		case 4:
+			challenge.DNSDomain = value
+		case 5:
+			challenge.DNSTree = value
+		}
+		info = info[4+length:]
+	}
+	return challenge, nil
+}
+
+// ntlmField returns the payload of the security buffer at offset.
+func ntlmField(msg []byte, offset int) ([]byte, bool) {
+	if len(msg) < offset+8 {
+		return nil, false
+	}
+	length := int(binary.LittleEndian.Uint16(msg[offset:]))
+	start := int(binary.LittleEndian.Uint32(msg[offset+4:]))
+	if length == 0 || start+length > len(msg) {
+		return nil, false
+	}
+	return msg[start : start+length], true
+}
+
+func ntlmString(data []byte, unicode bool) string {
+	if !unicode {
+		return string(data)
+	}
+	u := make([]uint16, len(data)/2)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+	return string(utf16.Decode(u))
+}
+
+func (n *NTLMChallenge) Summary() string {
+	var parts []string
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"NetBIOS domain", n.NetBIOSDomain},
+		{"NetBIOS computer", n.NetBIOSComputer},
+		{"DNS domain", n.DNSDomain},
+		{"DNS computer", n.DNSComputer},
+		{"DNS tree", n.DNSTree},
+		{"OS version", n.OSVersion},
+	} {
+		if field.value != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", field.name, field.value))
+		}
+	}
+	return "NTLM challenge discloses " + strings.Join(parts, ", ")
+}
+
+// LoginProvider returns the name of the identity provider a login redirect
+// goes to, or an empty string if it isn't recognized.
+func LoginProvider(location *url.URL) string {
+	host := strings.ToLower(location.Hostname())
+	query := location.Query()
+	for _, provider := range loginProviders {
+		if provider.Host != "" && host != strings.TrimPrefix(provider.Host, ".") && !strings.HasSuffix(host, provider.Host) {
+			continue
+		}
+		if provider.Path != "" && !strings.Contains(strings.ToLower(location.Path), provider.Path) {
+			continue
+		}
+		if provider.Query != "" && query.Get(provider.Query) == "" {
+			continue
+		}
+		return provider.Name
+	}
+	return ""
+}
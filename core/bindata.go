@@ -1,6 +1,8 @@
 // Code generated by go-bindata.
 // sources:
 // static/build_filelist.sh
+// static/exposures.json
+// static/favicons.json
 // static/filelist.txt
 // static/get_files.sh
 // static/js_local_files/bootstrap.min.css
@@ -14,8 +16,11 @@
 // static/js_local_files/vue-router.min.js
 // static/js_local_files/vue.js
 // static/js_local_files/vue.min.js
+// static/page_classes.json
 // static/report_template.html
 // static/report_template_local.html
+// static/secrets.json
+// static/wafs.json
 // DO NOT EDIT!
 
 package core
@@ -103,6 +108,46 @@ func staticBuild_filelistSh() (*asset, error) {
 	return a, nil
 }
 
+var _staticExposuresJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x7f\x73\x9b\xca\x15\xfd\x3f\x9f\xe2\x8e\x9a\x99\x4a\x8e\x01\x29\x2f\xed\xcc\xd3\x38\xc9\xe0\x98\x67\x3b\xb5\x65\x3d\xa1\xc6\x6d\xb5\x8a\x66\x05\x57\xb0\x4f\xc0\x92\xdd\x45\x8a\x12\xdc\xcf\xde\x59\x40\xb6\x84\xe4\x3e\xec\x3f\x40\xdc\x3d\x77\xcf\xe1\xfe\x5a\x3c\x79\x05\xf0\xb3\x95\xd0\x18\x5b\x7d\x68\x5d\x32\x05\x02\x53\x2e\x99\xe2\x62\xd3\x3a\x85\x96\xc4\x15\x0a\xa6\x36\x7a\x35\x64\x41\xa8\x6d\x29\x55\xa1\x6c\xf5\x61\xd2\xb2\xcc\x80\x29\xeb\xca\xb1\x2f\x5a\xd3\x53\x68\xcd\xb9\x5f\x00\x09\xb1\xdb\x02\x17\x7d\x10\xb8\x90\xd6\xe4\x2b\x21\x72\xfa\x26\x9f\x74\x8d\x5f\xa9\xb1\x98\xfe\x7c\xd7\x7d\xe8\x10\x22\x4f\x08\xf9\xd1\x7a\x38\x3d\x10\xe0\xf1\x64\xc1\x82\x4c\x50\xc5\x78\xd2\x54\x43\xe9\xb4\xa7\xa2\xfd\x31\xee\x7c\x25\x64\xd2\xf6\xb8\xc0\x5c\x60\xcc\x15\x02\x21\xa4\xa5\x05\x91\xd6\xf4\x44\x5f\x3b\x84\x4c\xeb\x22\xdc\x6c\xbe\x42\x21\x19\x4f\x5e\x18\x0c\xb9\x4a\xac\xb5\x67\xfa\xf3\x7a\x34\xdc\xdf\x6f\x98\x42\x58\x70\x11\x53\x05\xbf\x10\xf2\xbd\xdb\xad\xb3\xde\xa2\xf0\x32\xc1\x68\xf4\x42\xd2\x30\xb0\x04\x7e\xcb\x98\x40\x79\xf8\xfa\x6d\x81\xab\x88\x07\xab\x5e\x2e\x95\x8e\xc2\x22\xf1\xa8\x17\x62\xee\x73\x85\x89\xc7\x7d\xec\xbc\xae\xeb\x70\x92\x15\x13\x3c\x89\x31\x51\xb0\x60\x11\x36\xd1\x80\xc9\x4a\x9b\x8a\x1f\x66\xc4\x3d\x1a\x3d\x3d\xa6\x82\xfb\x99\x57\xe4\xb2\x16\x95\xb6\x2e\x82\xbf\xe8\x6c\x24\x3a\x19\x49\xae\x9f\x09\x49\x3a\x27\xfa\xc7\xc4\x36\xfe\x33\xd5\x97\xae\xf1\xeb\x4c\xaf\xcb\x93\xf7\x15\xb6\x2e\x39\xa6\xde\x9d\x0b\xe6\x85\x3b\x73\xf5\x5b\x1e\x95\x1d\xa3\xcf\xb2\xb8\x2e\x7c\xeb\x52\x4f\x58\x91\xa1\xfd\x4b\xef\x3c\xf3\x7b\x75\x66\x3b\xd5\xe1\x04\x89\x62\x85\xc2\x90\x8a\xaa\x4c\x36\x62\xde\xf7\xd8\x65\xaf\xb6\x74\x0b\x00\xb8\x05\x40\x57\xce\xff\xa7\x66\xc9\x82\xbf\x84\xb8\xc0\x3f\x4f\x7b\x9d\x94\xb5\xaa\xb3\x56\xe3\x4d\xc3\x54\x3b\xb7\x3b\x90\xd2\xa0\x59\x98\x2b\x17\x33\x0d\x53\x8d\xb7\x1e\x1f\x76\x05\x9c\x29\xa6\x22\xfc\x50\x61\x09\x69\x13\xd2\x39\xb3\x4a\x63\x7e\x16\xf6\xc0\x8b\xa8\x94\xef\x75\xd3\xa6\xfa\xf2\x61\x78\x35\x84\x2f\x55\x9f\xea\xd9\x72\xd8\xc7\xa9\x60\x49\x00\xe7\x9c\x2b\xb0\x3d\x95\x51\xc5\x05\x54\xa5\xfa\x27\x15\x4d\x2b\xb8\xb5\xad\x6c\x7d\xdf\x55\xab\x15\xb4\xa9\xa7\xd8\x0a\x87\x82\xeb\x82\x93\x79\x2a\x78\x8a\x42\x6d\x5c\x9e\x09\x0f\x65\x47\x63\x74\xd9\xf6\x1b\x09\x0b\x91\xa6\xe0\x67\x71\xfa\x12\x79\xda\xa9\xf0\xd9\xd7\x66\x7f\xb6\xbf\xd8\x30\x1c\xdd\xfd\x76\x7d\xe3\x40\x8f\x10\xb3\x4b\x88\x39\xe9\xbd\x9d\x1e\x1d\x3d\x55\xee\xcd\x50\xa5\x54\xca\xb5\xdf\xb8\xef\xb7\x0e\x7b\xa1\x29\xe6\xee\xe4\x6b\x9f\x10\x79\xf6\x61\xfa\xa6\xdf\x26\xe4\x35\x4d\x45\x8f\x90\xd7\x39\x21\xaf\xdf\x4e\xe8\x7c\x33\x2d\x1f\x7e\xba\x57\x36\x21\x0f\xda\x3c\xe9\xfd\xed\xef\xda\xda\xa9\x8b\xbb\xe7\xc2\x1f\x0a\x94\x12\xca\x19\x0f\x73\xea\x2d\xb3\x26\x51\x5a\xa7\x46\xe9\xa2\x6b\xcd\x9c\xd3\xa5\xf6\xd9\xb7\xfe\xf7\xd0\x64\xf2\xc8\x3f\x62\x95\x74\xb5\x3f\x25\x7c\x5c\xb0\x04\x8b\x3a\x95\x27\x93\xbf\xea\x64\x4f\x2f\xce\x67\x43\xdb\x75\xef\xef\x46\x17\x95\xe5\x20\xd4\xf7\x2e\x78\x02\x7d\x4c\x14\xa3\x91\x6c\x1c\x69\xba\x96\xd6\x8e\xdf\x61\xc0\x75\xa1\xd1\xb5\x9c\x51\xcf\x43\x29\x67\x4b\xdc\xcc\x98\xaf\x8d\xef\xeb\x12\x5c\xf7\x0a\x52\xc1\x56\x54\x21\x2c\xb1\xd9\xc1\x26\x43\x8b\xf9\x33\x21\xa9\x46\x6f\x7f\xee\x6a\x30\xf4\xdf\xb9\x73\x79\x3d\x80\xf6\xc8\xb5\x21\xbf\x1b\x3a\x03\xcd\x94\x3b\x9f\x20\xbf\x70\x6d\xe8\x7c\x1c\x8e\xae\xbf\xd8\x63\x07\xfe\xe1\xfc\x5b\xa3\x8d\x03\x61\xbf\xdf\x34\x6d\x00\x0d\x33\xe5\xb7\xf2\x98\x29\x0b\xe2\xf1\xd1\xa7\x8a\xce\xa9\xc4\x27\xc3\xbc\xf8\x79\x10\xb2\xb6\x61\xc0\xed\x66\xcb\x9a\x1b\x06\x0c\xb9\x54\x81\xc0\xc2\x54\xed\x52\xae\x7d\x1a\x39\x5a\xf9\xd8\x3e\xbf\x71\xf2\xeb\x81\xeb\x8c\xc6\x70\x3d\x18\xdf\xc1\x41\xb9\xda\xee\xd0\x1c\x38\x63\x70\x6e\x6e\xed\x2b\x40\x21\xb8\x80\x88\x07\x8d\xe6\x24\x46\x31\x0d\x4d\xfa\x7d\xbf\x9f\x9c\x62\x8f\x1b\x1e\xe8\x53\x00\xce\x64\x4a\x13\x60\x7e\x31\x08\xed\x34\x8d\x98\x57\x8c\xe9\x01\x8d\x51\x9b\x9e\xd3\xa3\x04\xf5\xb0\x91\x8a\x02\x79\xa0\xa2\x1a\xcf\x3b\x8c\x30\xd6\xc0\xc7\x11\x3d\xc2\x6f\x19\x4a\x25\x41\x71\x50\x21\x93\xb0\x03\xad\x8b\xba\xa1\x82\xae\x30\xd2\x81\x39\xda\x01\x47\x75\xe9\x2f\x18\x1a\xa0\x15\xf1\x40\x5a\x51\xb9\x83\xa9\x43\x7b\xa4\x17\x26\x84\xf8\x3f\xdf\x3d\x18\xfa\xf6\x76\x7b\x83\xf2\xd6\xdf\xbb\x11\x32\x05\x42\xd6\x6f\x08\x31\xdb\xce\x68\x74\x37\xca\xef\xed\xd1\xe0\x7a\x70\x99\x5f\x0f\x7e\xbb\xcb\x2f\x9c\xf3\x7f\x5e\x76\x0e\x26\xf8\x25\x87\x34\x15\x7c\x01\x3e\xce\xb3\x00\x30\xf1\x53\xce\x12\xd5\xe8\x3d\x0a\x17\xab\x70\xb7\xf6\xb4\x8f\x37\x29\x4a\xe0\x0b\x48\xab\x13\x05\xe8\x8a\xb2\x88\xce\x23\xac\xf3\xdf\xa3\x58\xfe\xc0\x2c\xa8\xf8\x3d\x9e\x48\xde\x68\x90\x6c\x91\xbb\xbc\x8f\x9b\x5d\xe8\xcd\x02\x14\xf9\x38\x44\xa8\x90\xc0\x24\x44\xdc\x5b\xa2\x5f\xd7\xe0\x6e\xe2\x05\x4f\x36\x5b\xb5\xa2\xd1\xcb\xcf\xb6\x68\xeb\x58\x75\x6d\xb7\xac\x8e\x54\xb1\x2d\xae\x3a\xb5\x13\x51\xa9\x98\x27\x91\x0a\x2f\x04\x2f\xca\xa4\x42\x01\x21\xd2\x48\x85\x0d\x82\x30\xab\x3c\xac\xca\x63\x57\x89\xee\xa1\x6a\x79\x96\x54\x3d\xa5\xc7\xa8\x3e\xce\x4e\xea\xff\x3d\x68\xdb\xe9\x76\xa1\xfc\xfa\x3b\xd6\x83\x43\x14\x31\x93\x92\xad\x10\x3c\xc1\xa5\xf4\x79\x4c\x59\x62\x7e\x8f\xa3\xba\xd8\x88\xaf\x6b\x5a\xeb\x0e\x7b\x51\xa3\x51\xc4\xd7\x46\x39\xf2\x8d\x85\xe0\x31\x21\xf2\x4d\xb9\x7b\x31\x1f\x08\x39\x39\xa6\x67\xcc\x63\x8f\x2a\xb8\xa5\x09\x0d\x1a\xe6\x2d\x2e\xb1\x56\xa8\x2a\x0d\xd5\x97\x6b\x1f\x26\x6f\xbb\xdd\x53\x78\xd7\xed\xe9\xcb\x2f\x5a\x5e\x88\xd4\x47\xa1\xb7\x6a\x7f\x64\x9d\xf5\x7a\x6d\xd0\x4c\x85\xfa\xe4\xf2\xa8\xc2\x3e\xcc\xa9\x64\x1e\x08\xa4\x51\xfc\x9e\xd4\xc4\xec\x4e\x8d\x43\xe1\x9f\xcf\xb9\x94\xf0\xf9\xf6\x5f\xdb\xea\x6c\x90\xec\x3f\xe2\xef\x46\x85\xb6\x9e\x51\xbe\x1b\xd3\x27\x8a\x32\x3c\xc5\xff\x41\x9f\x4a\xff\x5c\x9b\xed\x40\x5b\xbe\x30\x5c\xd7\xd5\xa5\x61\x7a\xbb\xb1\xfd\x98\x25\x8d\x42\x9a\x86\x69\xbc\xa1\x1a\x6e\x69\xbc\xf5\xe4\x7e\xb4\x37\x9e\x96\x75\xc1\x3d\x8e\xdd\x34\xa6\xb3\xe2\x23\x8c\x8b\x83\x0e\x2d\xb4\x34\x4c\x70\x21\x04\xc5\x73\x9f\xe5\x37\x3c\x60\x09\x18\x50\x6d\xf9\x48\x5f\xb9\x11\x62\x72\x11\xd4\xf9\x5d\x1e\x09\xa0\x8d\x03\x22\x79\xf4\xcc\x58\xd0\xfb\x14\xcc\x5b\xde\x3a\xd3\x67\x4c\x96\x2c\x91\x20\x3d\xc1\x52\xf5\x82\xea\x28\x1d\xf6\x48\xdb\x1f\x65\x67\x4b\x5c\xac\x6e\xd3\x0f\x84\x4c\x2a\x22\x42\xa6\x8f\x21\xd8\x07\x99\x27\x7f\x60\xb2\x64\x89\x6c\x3d\xbc\x9a\xbe\xfa\xdf\x00\x81\x34\x65\x6c\x5f\x11\x00\x00")
+
+func staticExposuresJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticExposuresJson,
+		"static/exposures.json",
+	)
+}
+
+func staticExposuresJson() (*asset, error) {
+	bytes, err := staticExposuresJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/exposures.json", size: 4447, mode: os.FileMode(436), modTime: time.Unix(1792395309, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticFaviconsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xd0\xcf\x8e\xd3\x30\x10\xc7\xf1\x7b\x9f\xc2\xca\x79\x23\xc5\x33\x1e\xff\xd9\x5b\x16\x89\xb0\x88\x8a\x2e\x20\x21\x51\xf5\xe0\x04\x97\x5a\x4d\xec\x28\x71\x11\x08\xf5\xdd\x11\x9c\xe2\x64\x6f\x39\x7c\x7e\x99\xe4\x7b\xdc\x31\xf6\xa7\x08\x76\x70\xc5\x23\x2b\xde\xbb\x70\xf5\x61\x2e\x1e\x58\x31\x0c\x17\x2c\x1e\xd9\x51\x73\xd2\x12\x39\x9c\xee\x0f\x19\x6d\x26\x7b\xb6\xc1\x2e\x29\x70\x40\x2d\x51\x2a\xb9\xc6\x6f\xe3\x94\x7c\x70\x89\xfd\x7f\x68\x6c\x72\xcb\x9d\x11\x24\x2a\x4d\x6a\x73\xe3\x60\xfb\xc8\xea\x3e\x45\xd6\xf4\xb1\xb5\xfd\x61\x8a\xc9\x75\x69\xb9\x2d\x25\x72\x22\xc3\x89\x36\x37\x89\x3d\x3d\x37\xe5\xf3\x21\xe3\x88\x04\x02\x08\xcd\x9a\xd7\xa9\xb7\xf3\xec\x6d\x60\x6f\x62\x38\xf7\x37\x17\xba\xec\x23\x4b\xac\x88\x2b\xf3\x5a\x09\x9f\x3e\xd8\x76\x69\x39\x28\x8d\x80\x52\xf3\xb5\xfd\x1c\x83\x9d\x5e\x6e\x6d\xf6\x6a\x2e\x34\x01\x29\x49\x62\xcd\xc7\xcb\xb8\xff\x5d\x7f\x1f\x7c\x58\xfa\x52\x28\x09\xc8\x4d\xb5\xe9\x5c\x8f\xb6\xbb\x38\xf6\x25\x0e\x9d\xcd\x33\x81\x51\x95\x34\xc2\xe0\x7a\xb2\xf7\xdd\x14\xe7\x78\x4e\xec\xe3\x2d\xf5\x31\x5e\xd9\x57\xd7\xb2\x7a\x1c\x97\x73\xae\xa4\x56\x20\x39\xdf\x64\xfb\x64\xdb\xd6\xa7\xfd\x4b\xa6\x2b\x29\x94\x00\x05\x9b\x54\xdf\xfe\xe9\x5f\x4b\xab\x0d\x90\x00\x43\xdb\x52\xe3\xe4\xc3\x0f\xf6\x14\x63\xf6\x23\x9c\x4b\x04\xd4\xb0\xf1\xef\xfc\xf5\xa7\x9f\x7d\xcc\x4a\x19\x63\x90\x14\x29\x75\xba\xef\x4e\xbb\xbf\x03\x00\x43\x18\x0f\xde\xec\x02\x00\x00")
+
+func staticFaviconsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticFaviconsJson,
+		"static/favicons.json",
+	)
+}
+
+func staticFaviconsJson() (*asset, error) {
+	bytes, err := staticFaviconsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/favicons.json", size: 748, mode: os.FileMode(436), modTime: time.Unix(1792395045, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _staticFilelistTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x92\xc1\x4e\xf3\x30\x10\x84\xef\x7d\x97\xcc\xd6\x4d\xd4\x5f\x3f\xa7\xbc\x8a\x6b\x2f\xaa\xdd\xc4\x36\x5e\x3b\xc0\xdb\xa3\x84\xb6\x40\x39\x34\xc0\x2d\xfa\xa2\x6f\x66\x35\xf2\xb1\x94\x24\x0f\x44\x52\xb4\x39\x25\x5d\x8e\x38\xc4\x58\xa4\x64\x9d\x8c\x0d\x30\x71\xa4\x2b\xa0\x0e\x2d\x14\x19\x91\x0f\x86\xd1\x05\x18\x91\xcd\x25\x68\xb6\xbc\x58\x1e\xdc\x94\x11\xb8\x50\x48\x23\x4d\x4e\xbc\x34\x81\xcb\x73\xcc\xa7\xbe\xc3\xae\xc3\x3f\xb2\x4e\xca\xfc\xe7\x7b\x44\xb4\x0c\xff\x54\x39\xbf\x2e\x07\xbc\x7f\x36\xed\xdc\x0e\x19\xdc\xb8\x18\xfe\x4b\xa7\x17\x98\x21\x56\xfb\x38\xe8\xcc\x8b\xa5\xbd\x7e\xa1\xc1\x1d\x84\x52\x4c\x89\x33\xbc\x90\x82\x9a\x9b\xeb\x68\x2f\xf0\x26\xe9\x07\x33\xf8\xdb\x15\xfc\x9d\x11\x6a\xb0\x9c\xc5\xc4\xcc\xbd\xc2\x7f\xa8\x4f\xa0\x59\xe3\x4f\x95\xfb\x1d\xf6\x50\xdb\xf3\x74\x95\x7f\xe5\xac\xec\x6a\x72\xac\x85\x73\xdf\x62\x8b\xfd\xd5\x3e\xd3\xbf\x07\xac\xba\xe2\xee\xb3\xf1\xb2\x79\x0b\x00\x00\xff\xff\x0f\x28\x9e\x8f\xc2\x02\x00\x00")
 
 func staticFilelistTxtBytes() ([]byte, error) {
@@ -363,7 +408,27 @@ func staticJs_local_filesVueMinJs() (*asset, error) {
 	return a, nil
 }
 
-var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x97\xea\xb6\xb2\x00\xfa\x3d\xbf\xc2\x97\x24\x97\xee\x4b\x83\x6d\xcc\xd8\xe9\xee\x75\x98\xe7\x79\x26\x37\x2f\x47\xb6\xe5\x01\x6c\xc9\xd8\xb2\x0d\xec\xb5\xff\xfb\x5b\x1e\xa0\x19\xbb\x3b\xd3\x7d\xe7\xc3\xdb\xc9\xde\x60\x0d\xa5\xaa\x52\xa9\x54\x2a\x55\x99\x97\xff\x12\xb1\x40\x76\x06\xa4\x14\xa2\x6b\x6f\x3f\xbc\x78\x1f\x94\x06\x90\xfc\x1a\x81\x28\xf2\xf6\xc3\x0f\x2f\x0a\x04\xe2\xdb\x0f\x14\xf5\xa2\x43\x02\x28\x41\x01\xa6\x05\xc9\x6b\xc4\x26\x52\x3c\x17\x79\xaf\x40\x40\x87\xaf\x11\x47\x85\xae\x81\x4d\x12\xa1\x04\x8c\x08\x44\xe4\x35\xe2\xaa\x22\x51\x5e\x45\xe8\xa8\x02\x8c\xfb\x0f\x4f\x94\x8a\x54\xa2\x02\x2d\x6e\x09\x40\x83\xaf\xec\x13\x65\x29\xa6\x8a\xd6\x71\x82\xe3\x92\x4a\x5e\x11\xbe\x02\x2c\x42\x4b\x30\x55\x83\xa8\x18\x9d\xc0\x2e\x6c\x6c\x40\x30\x82\xd4\x10\xfa\xa3\x5e\xf6\x02\x36\x51\xb0\x79\xd2\xa1\xa3\x0a\x0a\x80\x1a\x55\x87\xc8\x54\xd7\x16\x44\xd4\x83\x42\x88\x61\x3d\xd3\x34\x71\x55\x02\xcd\x84\x80\x75\x5a\x57\x05\xe5\xd0\xe0\xf1\x0a\xa8\x0c\x11\x34\x01\x39\x83\x7b\x44\xc4\xf9\xf6\x2d\x31\x85\xa6\xa5\x62\xf4\xfd\xfb\x55\x57\x13\xf3\x98\x58\x27\xfd\x10\x56\x91\x08\xb7\x4f\x14\xc2\x12\xd6\x34\xec\x06\x5d\x88\x4a\x34\xf8\x76\x41\xdd\x0b\x1d\x14\x7b\x0d\x34\x15\xad\x29\x13\x6a\xaf\x11\x8b\xec\x34\x68\x29\x10\x92\x08\xa5\x98\x50\x7a\x8d\x1c\x08\xb2\x08\x10\xd6\x06\x20\x4a\x82\xc7\x98\x58\xc4\x04\x86\x20\x22\x9f\xc0\x63\x01\x9d\x4a\x70\x09\x96\x16\x2c\xeb\xbd\x2c\xa1\xab\x28\x21\x58\x56\xe4\x07\x8a\xa2\x28\x15\x11\x28\x9b\x2a\xd9\xbd\x46\x2c\x05\x70\xb9\x54\x5c\x96\x7b\xbb\x21\xa3\xce\x4b\x7c\x67\xe0\x70\x73\xd5\xd0\x01\x97\xea\x94\x63\x62\x9d\x66\xa5\x41\x36\x97\xa2\x57\x19\x61\x41\xab\xcd\xf1\x60\xd2\x53\x84\x99\x99\xdd\xe6\x9b\x0e\x1e\x6e\xc7\xc9\xce\xd2\x65\xc7\x11\x4a\x30\xb1\x65\x61\x53\x95\x55\xf4\x1a\x01\x08\xa3\x9d\x8e\x6d\x2b\xf2\x65\xca\x3c\x32\x56\x96\x08\x35\xd5\x31\x13\x08\x12\x1a\x19\x3a\xed\xa8\xd6\xca\x8a\x23\x48\x5c\x6c\xae\xff\x95\x4a\x24\x53\x89\x2c\x2d\xaa\x16\xf1\x6a\x3e\xa3\x49\x71\x32\xa3\x71\xa1\x66\xaf\x53\x9b\xb1\xab\x9b\xbb\x2a\xbf\x5c\x8e\x11\x37\x30\x6b\xc3\xdd\x72\xc6\x5a\xb8\x94\x6f\xd1\xe5\x5d\x26\xb7\xb7\x72\x96\xcd\x17\xab\xbd\x49\x26\x4f\x64\xba\x56\x5b\x4a\xeb\x46\x91\xff\x98\x26\x9f\x12\xca\x5b\x66\xaf\x11\x02\xb7\xc4\xe3\xb7\x5f\x43\x51\x12\xc6\x04\x9a\xd4\x37\xff\x81\xa2\x78\x6c\x8a\xd0\x8c\x13\x6c\x3c\x53\xac\xb1\xa5\x2c\xac\xa9\x22\x65\xca\x3c\x78\x60\x9e\xa8\xe0\xff\x04\x9b\x4c\x3f\xfe\x12\x76\xd0\x81\x29\xab\x28\xe8\x90\x66\x8c\xed\xa1\xdc\x00\xa2\xa8\x22\xf9\xbc\xd0\x1b\x3b\x0e\x34\x55\x46\xcf\x94\x00\x11\x81\xe6\xa1\x46\xc2\x88\xc4\x2d\x75\x0f\x9f\x29\x36\xf9\xde\x41\xc0\x1a\x36\x9f\xbd\xf1\x1f\x32\xb9\x27\x2a\xf8\x1b\x8e\xfd\xfd\x87\x53\x02\xc0\x91\x84\xb0\x8f\x8a\x14\x68\xaa\x84\xfa\x2f\x55\xf7\x84\x17\x20\x72\x86\x85\x08\x05\x6c\x02\x6f\x39\x3f\x53\x36\x12\xa1\xa9\xa9\x08\x9e\x01\x4e\x08\xc0\xc4\xb6\x05\xb5\x23\xe4\x90\x56\x1e\x13\x82\xf5\x53\xca\x2e\x7b\xc4\x55\x02\xf5\x4b\x84\x7e\xe4\x72\x9c\x98\x62\x3f\xe3\xc5\x6d\x58\x09\x03\xc8\x30\x2e\x00\x53\x3c\x82\xf5\x55\xd9\x33\x95\xba\xc7\x60\x0d\x4a\xe4\x7c\x96\x9e\xa9\x64\xda\xd8\x52\x2c\x63\x6c\xa9\xf4\xe1\xdb\xa1\x89\xa8\x5a\x86\x06\x76\x1e\xe3\x3c\x56\xc4\x79\x0d\x0b\xeb\x73\x94\x2c\x15\xc9\x1a\x8c\x07\xa8\x60\x44\x80\x8a\xa0\x79\x82\xda\xd3\xe7\xcd\x3c\x65\x0e\x4d\x2b\x4e\x00\xaf\xc1\x0b\xc6\x3e\x53\x1e\x62\x3e\x72\xe1\x97\xf3\xe1\x7d\x00\x96\x60\x42\x88\x2c\x05\x93\x13\xd8\x07\x38\x06\xb6\xd4\x60\x4a\x4d\xa8\x01\xa2\x3a\xf0\x40\x1d\x76\xa0\x29\x69\xd8\x7d\xa6\x14\x55\x14\x21\xfa\xe5\x5c\xde\x0f\x53\xfa\x05\x91\xbf\x83\xcd\x11\x07\x62\x02\x74\xc0\xc2\xff\x2e\x61\x53\xa7\x12\x69\x8b\x82\xc0\x82\x71\x6c\x1f\x27\x45\xb0\x4d\xcb\x13\x8c\x3d\xc6\x7a\x5c\x3d\xa2\x14\xce\x2b\xcb\x30\x3f\xdf\x91\x08\x8f\x70\x13\x6b\x71\xc3\x84\xce\xd3\x9d\x3a\x04\xb7\xe4\x52\x54\xd2\x5f\x01\x18\x57\x05\x8c\xde\xf5\x01\x10\xd6\xb2\x89\x6d\x24\xc6\x55\x1d\xc8\xf0\x99\xb2\x4d\xed\x21\x22\x02\x02\x9e\xfd\x02\xda\x72\xe4\xd8\x56\xd7\x9e\x7e\xe6\x04\xcb\x91\xa9\xad\xae\x21\xeb\x35\xea\x69\xca\x67\x9a\x76\x5d\x37\xe1\x72\x09\x6c\xca\x74\x92\x61\x18\xaf\x71\x94\x92\x54\x4d\x7b\x8d\xfe\x9c\xe4\x32\x42\x36\x9d\x15\xa3\x94\xb7\x69\x17\xf1\xf6\x35\xca\x50\x0c\x95\xa3\x72\xd1\x9f\x39\xf8\x33\x27\x78\x5b\x07\x25\xbe\x46\x3b\xe9\x44\x32\x4d\x31\x5a\x3c\x45\x05\xff\xb1\x89\x74\xdc\xfb\x9b\x0c\xfe\x52\xe1\x67\x3c\x2c\xdf\x47\xe9\x00\x80\x37\xdc\xcf\x1c\x8c\x3c\x7e\x42\xb6\xc7\xab\xff\x40\xb2\x93\x89\xac\x4f\x36\x9b\x48\x7b\x24\x53\x27\xa4\xfa\xdf\x0f\xe5\xa9\xb8\xff\xdf\x97\xc9\x56\x91\xa8\x0a\x9e\xfd\x60\x51\x9a\x7a\x8b\xe4\x83\xc2\x0a\x10\x3d\x87\xc2\x03\x51\xbe\x5c\xb8\x71\x53\x95\x15\xf2\x4c\xa5\x6f\xae\xd8\xdb\x4b\xfe\xae\x94\xdf\xe8\x43\xde\x95\x9e\xbf\x4f\x48\x40\x57\xb5\xdd\x33\x55\x38\xec\x72\x54\xdf\xc4\x4f\x54\x09\x23\x0b\x6b\xc0\x7a\xa2\x3a\x10\x69\xf8\x89\xea\x60\x04\x04\xfc\x44\xb5\x6d\x41\x15\x41\x58\x0f\x9f\xa8\xb6\xca\xc3\x40\xf7\x7b\x4d\xf0\x13\x55\x86\x2b\x30\xb5\xa9\x11\x40\x56\x58\x52\x54\x3d\x5b\x04\x02\x9d\x9a\x42\x13\x9c\xd6\x94\xb0\x6d\xaa\xd0\xa4\xba\xd0\x7d\xa2\x74\x8c\xb0\x65\x00\x01\x3e\x51\x16\x34\x55\xe9\x0b\xa4\x24\x82\x82\xb8\x03\x34\xfb\x84\x1d\xd8\x14\xe3\xbc\x09\xc1\xfa\x99\xf2\x3f\xe2\x40\xd3\xbe\xa2\x7d\xbf\xfd\x69\x45\xf6\x85\xfd\x4c\x36\x81\xa1\xfc\x21\x3d\x7b\x35\xad\x14\xa5\xc0\x40\x3a\xb2\xcc\xc9\x6e\x73\x6a\x36\x24\x4f\xca\x03\x32\xfe\x90\x22\xf6\x91\xbc\x81\x1a\xe0\x2d\xac\xd9\xe4\x88\x9a\x3f\x16\x73\x78\xf2\x76\xc7\x93\xc7\x0f\xf0\xbe\x16\xd1\x80\x2d\x1a\x06\x9e\x85\x13\xf7\xb6\x16\x0d\xec\xfe\x4f\x30\xa0\xa8\x7d\xdc\x37\xd8\x9f\xa9\x7c\x3e\x9f\xff\xe5\xfe\xda\x95\xfc\x3f\x9f\x1b\x5e\xa1\x9d\x16\xce\xc4\xe5\x0e\x71\x9b\xd2\x84\x61\x62\xd9\x84\x96\x75\xa9\x07\x02\x92\x80\x4d\xf0\x2f\x37\x15\xc4\x69\xcd\x61\x4f\xba\x26\x97\xbb\xd2\x23\x96\x82\xdd\xb8\x8e\x4d\x18\xe7\x6d\x42\x4e\xf4\xf4\x3d\xeb\xf3\x33\xc9\xfe\xf1\x7d\xe3\xee\x60\x11\x68\xf7\xb7\xf3\x1b\xd3\x72\xd8\xb7\x0d\xac\x9e\x9a\x6d\x14\xf5\x42\xfb\x86\xf6\xdb\x0f\x2f\x74\x70\x68\xfd\xe1\x85\xc7\xe2\xce\x37\xc1\x11\x70\x28\x41\x03\x96\xf5\x1a\x41\xc0\xe1\x81\x49\x05\x1f\x71\xb8\x35\x00\x12\xe3\xba\x78\x28\x10\x81\xb9\xa6\x78\xd9\xff\x0c\x8d\xf4\x17\x70\xde\x37\xce\x9b\x00\x89\x87\x53\xc9\x8f\x91\xb7\xc2\x60\x52\x18\xf7\xba\x95\x17\x1a\x84\x3d\x42\x46\x9d\x77\x23\x58\x96\x35\x68\x46\xc2\xa3\x40\xd0\x26\x42\x79\xdb\x5a\x58\xf7\x1a\x11\xb0\xa6\x01\xc3\x82\x87\x62\x60\xca\xde\x71\xfb\xc7\x00\x44\x07\x22\x3b\x12\xf2\x01\x98\x2a\x38\xec\xa1\xd6\x79\x8b\xa0\x2e\x20\x0d\x8a\xaf\x11\x09\x68\x1e\x44\xbf\x54\x03\xbc\x77\xba\x1a\xfb\xe3\x79\x44\xab\xb2\xaf\x8b\x43\x5a\xbd\xe3\x8a\x01\xee\x60\xee\xef\xd2\x91\xb7\x17\xda\x6b\x12\x52\x4a\x07\x64\xbc\x05\x33\xfb\x22\xaa\x47\x46\x1f\x48\x39\x70\xf6\x9d\x34\x55\x3c\x40\xf6\xd1\x3d\x8e\x6c\x6b\x17\xe3\x7a\xd3\xa6\x9b\x71\x4f\x70\x8f\xad\xfc\x43\xe2\x49\xbb\xc0\x42\x17\x4d\x6c\x88\xd8\x45\x27\xcd\x2e\x26\x2e\xee\x1f\x2d\x0f\xed\x42\x92\xde\x27\xd1\x47\xca\x13\x43\xab\x7c\x00\x45\x99\x58\xbb\x37\x4f\xc7\xf1\x4e\x86\x0b\xe7\x44\x01\x96\x81\x0d\xdb\x78\x8d\x10\xd3\x86\x77\x26\xe3\xed\xac\x5f\xdf\x1b\xf7\x14\xf1\x83\x20\x85\x8f\x27\x5c\x3d\x12\xa0\xbf\xcf\xb4\x3f\xa7\x1a\x14\xf9\xdd\x25\x09\xe7\xc3\xbc\xf3\xe3\x08\xc5\x63\xde\x91\x09\xb4\xdf\x99\x0e\xb6\xba\xc8\xdb\xc8\xff\x0c\x90\xbb\xc0\xe8\xcb\xb0\xf8\x5d\xdc\x52\x75\x55\x03\xde\x79\x3b\xf2\x56\xdc\x51\xa3\xe3\xe3\x5f\x80\xa9\x60\x8b\x58\x3e\xb8\xba\xf7\xed\x92\x5f\xb4\xa8\x3a\x27\xf2\x42\x6b\xea\x87\xd2\xf3\x89\xd0\x5c\x8e\xef\xab\xe5\xc8\x5b\xcd\xfb\x38\x1b\xf9\x74\xa0\x17\xda\xd6\x0e\x4b\x24\xc4\xe6\x85\x46\xc0\xf1\x17\xca\x8b\x0e\x54\x14\x8a\x97\xf7\x35\xf2\xbe\x66\xc2\xcd\x3e\x90\x47\x60\x18\x07\x1d\x64\x62\x9b\x78\x76\x8b\x0a\xdd\xb7\x17\xfa\xf4\xc9\x87\xec\x41\x09\x40\x87\x27\x72\xaf\x7b\xf0\xf5\x00\xc1\x38\x0c\xe2\x6f\x47\xba\x4d\xa0\xf8\xae\xba\xce\x3d\x57\xd4\x7f\xeb\xaa\x28\x62\xf2\x0b\xa5\x03\x11\x52\xae\x4a\x94\x40\x2f\x1c\x49\xf5\x55\xad\xbf\xc6\xb1\xf9\x6c\x42\xf1\x17\xdf\x34\x74\x83\x3d\x84\xc7\x9a\x18\x79\xfb\x6f\x05\x02\x93\x58\xbf\x84\xea\x82\xe2\x77\x1e\x6f\xcf\x5d\x39\xa7\xae\xb6\x84\x80\xf5\x08\x75\xd0\x78\xbf\xf3\x1a\x40\xeb\xc8\x5b\xe8\xb2\x3b\x0e\x7c\x74\xdd\x79\x9c\xa7\x00\x12\xaf\x81\x12\x57\x25\x07\x5f\x9e\xa5\x40\x4d\xb3\x38\xe1\xf7\x6b\xc8\x7d\x05\xe8\xd4\x68\x47\x75\x54\xe4\x4f\xe3\x0b\x6d\x1c\x38\xf5\x76\x05\xd3\x3b\x53\xf0\xf6\x4e\x87\x40\xc0\x92\x04\xe1\x95\xa3\xf0\x1a\xfe\x8b\xaa\xcb\x27\x72\x65\x99\xc2\xeb\xe9\x11\xc6\x40\xf2\x2f\x3c\xb0\x60\x26\xf5\xa4\x4e\x8b\xbd\xa1\xcb\xb4\x6a\x32\x2e\x14\x0a\x85\xee\x68\xa2\x54\x26\x72\xa1\x50\x68\xf9\xcf\x5a\xa9\xb0\x28\x14\x0a\xe5\xd1\xba\xde\xea\x7b\x05\xb5\xf9\xb0\x3a\xab\x0f\xc7\x7c\x72\xc9\x88\xc9\xea\x6e\x39\x28\x16\x97\xb5\xbc\xba\x1c\x15\x9b\xfc\xac\x8a\x96\xd3\xa6\xb6\x98\x0d\xd3\x82\xa0\x69\x5e\x87\x52\xaf\xd8\x1c\x56\xaa\x13\xd8\x35\xad\x79\x27\xdf\x9f\x56\x04\x01\xb1\xcc\xb4\x59\x4b\x4e\xb7\xe5\x31\x19\x8d\xa5\x8a\xd1\x10\x6b\x33\x98\xae\xa5\xc4\x16\xd3\xa4\x2b\xd2\xa6\x5b\x5e\x74\x62\x2d\x16\x08\x25\xba\x50\xd9\x39\xcd\x4d\xa9\x9e\xd7\x1b\x25\x44\x8c\xf2\x3a\x37\x75\x01\x32\xe4\x15\xc3\x76\x0a\x99\x45\xb2\xbf\xd0\x1b\x86\x65\xb5\x3a\x06\xd7\x77\x7b\xd2\x96\x9b\xd5\x61\x92\x86\x49\x3b\x47\x4c\x7d\x92\xdb\xcd\xe6\x3c\xa4\xfb\xab\x9e\x98\xcd\xee\xe9\xf1\xac\xdf\x1e\xc9\x7d\xd2\x05\xab\xf4\xa6\x67\x15\xe4\x56\xaf\x48\xa6\x25\xcc\x17\x70\xcb\xdd\xf4\xe4\x42\x86\x5f\xed\xb5\xf1\x08\x57\xe7\x85\x09\xec\x74\xa7\xfd\xda\x4a\x28\xd8\xdd\x81\xba\xa9\x88\xad\xad\x34\xaa\x74\x4b\x1d\x79\xdc\x68\xed\xf7\x45\x50\x6d\xb6\x52\x15\x54\x18\xa3\x6a\xa9\x30\x65\xbb\xcb\x55\x56\x2e\xef\xb2\x05\x61\x9e\x77\x4b\xeb\x06\x98\x94\xe0\x64\x6c\x2e\x77\x70\x15\x4b\xf2\x5d\x44\x36\xe3\xa2\x32\xb0\xe6\x7c\x61\xdd\xc8\xf5\xaa\xeb\xa6\x0b\x69\x11\xda\xb3\x24\x59\x2d\x26\x7d\x2e\x4f\x0b\x5a\x46\x9a\xb1\xdd\x39\x4f\x92\x63\x31\x49\x4b\xde\xbc\x67\x92\x9a\x23\xd0\x63\x37\x59\xe3\x56\xab\x5e\x27\xb3\xa4\x67\xf5\x49\x89\x9d\x91\x19\x1a\x1b\xdc\x68\x28\xab\x3c\x59\x4f\x78\x3e\xef\x90\x29\xe0\xe8\x56\xd1\xea\xdb\x1a\x6d\xc6\x30\xee\xf5\xda\x69\x6c\x33\x4b\x71\xa6\x19\xa3\x71\x3a\x95\x9b\x08\x4e\x7b\x97\x07\x93\x3e\xb7\x4f\x75\xaa\x13\x1a\x74\x99\xac\x18\xcb\xe0\x5d\x5a\x70\x66\x31\x26\xd3\xaf\xb9\x4c\xa6\xdf\x51\x8c\xf9\x82\xcb\x2b\xa6\x9c\x75\x2b\x62\xb7\x62\xb9\x34\x64\x8a\x4a\x7d\x18\x93\xb4\x54\xb7\x5c\xd8\xe1\x5c\x4c\xea\xcf\x72\xd5\xae\xcc\xd8\xf3\xb6\xb6\xe6\x0a\x73\xa6\xd8\xca\xc8\xd2\x5e\x45\xec\x42\x6b\x19\x68\x3c\xd3\xf6\x56\xb2\xc2\x0d\x36\xa5\xa4\xbd\x18\x98\xd3\xe1\x68\x9a\xc9\x43\x1e\x20\x27\x6b\x67\x6d\x77\x29\x71\x43\x39\xc7\x64\x64\x71\x65\x49\x29\xa2\x2a\x73\x4b\x6e\x2f\x4a\xaa\xd5\x4b\x09\x0d\x31\x55\xe2\xd2\x7b\xc4\x75\x9c\x4d\x95\xf0\xb3\xa4\x91\x85\xac\x35\x2d\xc9\xf3\x29\x9b\x87\x68\x6c\xb8\xa9\x05\x24\x0a\xd9\x54\xa6\x9b\x6c\xce\xde\x38\xed\x2a\x70\x70\x91\xde\x2f\xed\x41\x6e\xe2\x2e\x80\xb8\xde\xa6\xe4\x41\x23\x53\xae\xc4\xfa\x6a\x8a\x15\x37\x2b\x9c\xe9\xcd\x2c\x61\xdc\xd5\xf7\xd2\x34\xd9\x55\x16\xeb\xf6\x92\x96\x05\xd4\x1c\xf1\xf6\x5c\xe0\xba\xfb\x32\xef\x0a\x35\x65\xb3\x73\xca\xc0\x5e\x64\x53\x55\x32\xcd\x38\x1b\x76\x43\x0c\x6c\x56\x31\x99\x15\x7a\x7b\x2b\x3b\x99\x8d\xfa\x0c\x2b\xd8\x1a\x3b\x4f\x33\x5c\x8a\xcd\x4f\x27\xb5\xc1\x3c\x19\x9b\xe6\x17\xb1\x9a\x95\x59\xd7\x47\xba\xa0\xa6\xec\xb6\xc2\x6d\xb5\x7e\x9b\xe4\x63\x1c\x18\xd8\xc5\x65\x71\x3f\x5a\x17\xcb\x23\x6b\x3a\x30\xc5\x01\xdf\x9a\x8f\x93\x59\xd1\xc9\x42\xb8\xec\x24\xc5\x09\x9f\x8c\x39\xfd\x29\x72\x38\x33\xd9\x46\xeb\xee\x80\xa5\xb3\x9d\x5e\x6b\x35\xdc\x74\xe7\x28\x29\x30\xcd\x5a\x41\xec\x8c\x99\x98\x39\xda\xcc\xd4\xa9\x26\xce\x71\xbe\x4b\x67\xf3\x99\x7c\xa3\xc6\x92\x4a\x75\x94\x6e\x6e\xc7\x23\xde\x30\xf3\x9a\x3c\x63\x8d\x8c\x54\x97\xcc\x74\x8c\x16\x71\xab\x2d\xb8\xf4\x78\x9c\x73\x7b\x65\x35\x45\x72\x6a\xac\x5c\xcf\xae\x0c\xbd\xde\xb1\x75\xcc\xc4\xb6\x6b\xb7\x3b\x9e\x6a\xdd\x71\x65\xd1\x2b\x57\xb6\x8c\x50\x9e\xf0\x7a\xca\xea\xf2\xba\xc9\xcd\x39\xa0\x0a\xb4\xcd\x99\x0c\x5f\x5c\xd6\xc4\x5c\xb9\x8b\x96\x49\x89\xd4\x2b\x28\xe7\x96\x3b\x5c\xae\x3f\x1f\xa2\xde\x48\xea\x28\xab\xda\xbc\x3a\x90\x8b\x25\x17\x66\x34\xae\xad\x6d\x37\x24\x5d\xad\x75\x6d\x51\x74\x38\x73\x3f\xcc\xc4\x1c\x33\xa9\x94\xd0\x8a\x2f\xd6\xf6\x6c\x26\x26\xb5\x34\xb4\xd4\x79\xd9\xe9\xad\x5a\x38\xdb\xb2\xa5\x16\x3d\xd2\x66\xb1\x49\x76\xd6\xcf\x35\xc6\xa4\x56\xdb\x14\xc4\x98\xa2\xea\x5d\x71\xc0\x0b\x49\xda\x5c\x89\xf9\x8d\xb3\x25\x5d\x90\x8d\xad\xd0\xaa\x08\xb8\xfc\x62\x59\x9e\xed\xeb\xee\x5c\x98\x54\x33\x45\xb4\x98\xd5\x8b\xbd\x3d\x9d\x59\xe8\x99\xd5\x7e\xc6\x64\x57\x0d\x51\xe5\x4a\xa5\xbc\x65\x36\x46\xfd\x99\x90\x8f\xf5\x5a\xbd\xfd\x4c\xc0\xb5\x92\x68\x98\x70\x21\x0f\xf5\xe4\xb6\x6b\x8e\xeb\xfd\x8a\x96\xb7\x2b\xd9\x5d\x69\x3c\x18\xa6\x1a\xf6\xba\xec\xce\xc9\x6e\x4e\xcf\x76\x12\x57\x40\x2d\xb9\xdc\x9e\x68\x7b\x79\x00\x85\x1d\xab\xa6\x94\x15\x52\x63\x4d\xbd\x42\x54\x29\xe7\x8e\x95\xe6\xb4\x64\x69\x26\x28\x8e\x0a\x9d\x8a\x4c\x17\x18\x7d\xa4\x03\x65\xbc\x6a\xcd\x65\xd9\xaa\x59\x32\x87\xd3\x42\x75\x57\x9c\x66\xec\xe6\x4c\x8b\xf1\x8d\x4d\xb6\x88\x5d\xad\xb8\xb0\xab\x7a\x4a\x60\x2d\x25\x56\xdd\x8a\x6c\xae\x24\xe6\x17\xc2\x9a\x89\x4d\x2a\xc5\x5c\xbf\x54\x27\x8e\xdc\x8c\xed\x7a\xc2\x28\xdd\x9a\xe4\xf2\x85\x62\x5a\x2d\x4f\xb7\xf3\xb1\xda\x10\x94\x9d\x5d\xe1\x86\xda\x90\xaf\x8b\x86\xcc\xc7\x5a\xb3\x42\x72\x06\x19\x49\xe9\x0e\xaa\x7d\x75\xd9\x19\x99\x1d\x73\x9a\x8e\x49\xbd\x55\x63\xb7\x70\xd8\x09\x98\x37\x60\xbf\x2e\x0f\xf4\xa9\xa8\x37\x7b\x43\x6e\x5f\xe8\x66\xd6\x92\x55\x5d\x97\xf5\x01\x6e\xd0\xed\x2e\xaf\xc9\x4c\x05\x8e\x55\x27\xbd\x28\xe6\x97\x85\xae\x5b\xdc\xd7\x5a\xb5\xce\x76\x53\x36\x94\x82\x56\xe9\x67\x07\x6c\x4d\x5d\x6e\xa5\x71\x09\x19\xc5\xf5\xb0\x57\x57\xda\xcd\xb6\xd6\xea\xb6\xbb\x35\xb5\xbd\x5f\x56\x48\xb3\x93\xb4\x0a\x74\xaa\x5f\x5f\x6d\xd9\x4a\x56\xdc\xd1\x8d\x79\x16\x42\xa7\xb3\x14\xca\xb5\xf2\x50\xd1\x3b\x0a\x2f\x97\x89\x63\xa6\xc4\x1c\x5b\xe3\x0b\x43\x6b\x91\x4e\x77\xd8\x4a\x56\xb6\xc6\xe6\x46\x28\x70\xbd\x12\x33\x52\xe4\x6a\x53\x2d\x96\x17\x4b\x7a\x68\x2f\x77\x83\x9d\xba\xa0\x2b\x29\x45\xae\xe5\x08\x3d\x62\x6d\xb1\x8b\xad\x62\x61\x5a\x22\xaa\x40\xb2\x36\x18\x14\x75\x57\xee\xee\xfb\xf6\xa0\xb3\xea\x0e\x8d\x5a\x6c\xa9\x6c\x49\xbe\x39\xd9\xb6\x39\x96\xa3\x65\x36\x26\xd7\xa5\x54\xd9\xae\x28\xbc\x08\x9d\xf9\x3e\x37\xe9\xb6\xd7\xcc\x56\xd2\xd3\xe9\x72\xbd\x66\x64\x63\x5d\x67\xb3\xaf\x27\xcb\xfb\xd4\xda\xca\x89\xf9\x69\x8d\x2f\x00\x9c\xdf\x89\xb1\x56\x21\xe7\x36\x63\xf9\xb9\x29\xf2\xc9\xb4\x2d\x22\x99\xce\x6e\xe4\x9a\xd4\xee\x0e\xa5\x7c\x5f\x5f\x25\x4b\x4d\xbc\xca\xcf\xdb\x1d\xbc\x4d\xf3\x64\xd1\x4a\x8b\x28\x5f\x44\xb2\x3e\x95\xd8\x3c\xbd\xaa\x97\xc7\x1a\xb3\x19\x8f\xe7\xa9\xc5\x52\x83\xe9\x3e\x2a\x59\x2b\x36\x35\x88\x75\xda\xba\x3d\x8b\x35\xf7\xcd\xbc\x2a\x35\x0d\xd9\x96\xd1\xb0\x98\x42\xdb\x21\xa3\x92\x74\x53\x60\xb2\x31\x81\x8d\xf1\x2b\x16\x37\x8b\xb1\xed\x90\x11\xf5\x98\xb2\x1e\xda\x5a\x55\x9a\x61\xae\x35\xa5\x93\x83\x0d\x33\x8d\x55\x0d\xba\x2b\xf4\x79\x2b\x09\x78\xa3\x95\x34\x36\x40\xe9\x14\x84\xac\x06\xf4\x19\x8b\x8b\xba\x06\xf1\x44\x1f\x64\x2a\xfc\xb6\x31\x49\xf1\x83\xa9\xd3\xec\x01\x35\x9f\xac\x00\x20\x76\x4b\x8d\x5d\x51\x6d\x8a\x0a\x4d\x8f\xaa\x74\xb9\xcb\x77\x5c\x67\xa6\xef\xeb\xa5\x74\x5f\x2f\x4d\x14\x34\x5f\xf5\x7a\x60\x54\xb5\xb6\x42\xba\xac\x25\x17\xeb\x24\x90\x24\xbe\x6a\xb3\x69\xb6\xd8\x17\x17\xbd\xbc\x9b\x91\x66\x25\x49\x5c\xed\xfa\xe3\x4d\xc3\xd5\x3b\x8c\x98\x8c\xe5\x2a\xdd\x45\x63\x38\x61\x93\x98\x8d\x6d\xd7\x75\x50\xae\x73\x62\xb9\xd3\xc0\xeb\xbe\x83\x50\x61\x29\x8f\x1b\x85\x75\xbe\x82\xc7\xe6\x9a\xaf\x57\xaa\xbc\x30\xdc\x2d\x6b\xb3\xf2\x6c\x30\x58\x36\x27\x36\x19\x54\xb2\x76\x51\x95\x76\x3d\x4b\x5c\xcf\x51\x7a\xc5\xa7\x97\x49\x61\x90\x6f\xb7\xbb\xf3\x4a\xae\x06\x46\xee\x5e\x61\xdb\xa6\x96\xdf\x8c\xf6\xba\xad\xa7\xd6\x85\x79\x7e\x2b\xaf\xcc\xdd\x68\x36\xe8\xe7\xda\xa3\x6e\xa6\x07\xf8\x4e\xda\x28\x25\x8d\x4a\xc9\x4d\xb1\x35\x9a\xeb\x14\xac\x45\x69\x04\x8b\xb3\x01\xac\x62\xb7\x5b\x4c\x76\xb0\x53\x1c\x6c\x3a\x8d\x74\x67\x59\x1b\x6f\x86\x9b\x5a\xcc\x45\xa3\xa9\x59\xeb\x83\xdd\x4c\xda\x49\xf5\xe1\x96\x49\x0e\xb2\xf9\xa6\xb4\xb7\x64\x6e\xd3\x5b\xe6\xcd\x8a\xdd\xc7\x46\xad\xec\x2e\xda\x9a\x5d\x82\xc4\xd8\xad\xf4\x5e\xbd\x10\x2b\x8d\xb2\xb0\xc8\x4f\x6a\x8e\x4d\x83\x54\xb6\xb1\x10\xc6\xdb\x54\x4b\xcb\x0b\xb9\x55\x51\xe5\x53\x59\xb9\x65\xd8\x76\x69\xa4\xf2\xc3\x29\xc3\x8e\x99\x2e\x98\x6f\x19\x77\xb5\x69\x67\x4a\xb9\x79\x51\x36\xba\x60\xbc\x67\x77\xdd\xd1\x0c\x94\x79\x67\xd5\xea\x6f\xaa\xc9\xe2\xa2\x56\x77\xfb\xf3\x95\x55\xcc\x4e\x46\x23\xce\xe4\x57\x2d\x3a\xc5\xf6\x6c\x37\x26\x8e\xed\x95\x06\x50\x7e\xd9\xcf\x91\x6e\x5e\xea\x57\xf2\xeb\xbd\x36\xd1\xb2\xe2\x42\xda\xba\x4e\x5a\x32\x07\x7b\x32\xdb\x19\x55\xab\xe5\xa4\x1d\xd8\x5b\x35\x8b\xc5\x51\x35\x59\xc9\x64\x26\xf9\xfe\xa8\xa2\xaa\x79\x49\xcf\x25\xd3\xb0\x54\x90\x67\x53\xa6\x53\x2a\x0e\xf7\x58\x94\x2d\xb6\xad\xa5\x67\x35\xb7\x55\xab\xd0\xdd\x81\xcc\xd8\xfb\x59\x76\x54\x44\xdd\xbd\x34\x05\x05\x55\x12\xf5\x54\x53\xce\xb9\xbd\x95\xd9\xb4\xd4\x2d\x6d\xca\x42\x87\x98\x6d\x32\xab\x77\xf5\x22\x31\x05\x35\x37\x9a\x97\x85\x46\xbe\x8f\x66\x23\x02\xeb\x69\x92\x44\xc5\x7e\xa9\x33\x50\x95\x6e\x6f\x94\x9f\x6e\x2a\x33\x6d\x69\x48\x80\x33\x27\x32\xe8\x76\x5b\xb8\xcb\xc4\x06\x12\x4b\x66\xd0\x96\x1c\xd2\xcf\x98\x19\xd8\x65\xa4\x18\x37\x74\x94\xd8\x94\xae\x6b\xcb\x5c\xaf\xd0\xce\xb6\x24\xab\x92\x2d\x8a\xc9\xda\xb0\x39\x36\xc8\x92\x4f\x59\x4d\xb3\xc8\xaf\xbb\xb5\xfc\xbe\x50\x6c\xf4\xd3\x4c\xa9\x55\xca\x6d\x99\x6e\x9a\x8b\x55\x6b\x92\xd8\x70\x66\xce\x58\xca\x49\x9c\xb6\x76\xd7\x8b\x71\x65\x99\x8e\xcd\x33\x7a\xbf\xbd\x5f\xd6\xe8\xdc\x3c\x26\xd3\x62\x6b\x3e\xdb\xf1\xbb\x3e\x34\xd4\x25\xa6\x77\x39\x81\xce\xab\x75\x55\x53\x2a\x2c\x76\x9a\x3d\x07\x17\x86\xda\xde\xe9\x56\xf2\xdb\x76\x71\xb6\xb0\x61\xbb\x56\x6c\x38\x3d\x66\xb4\x14\x56\xf3\x39\x63\x6c\x17\x4e\x71\xef\x72\x9a\x62\xeb\xd2\xbc\xa6\x2d\x70\x85\x4d\xe7\x4b\x4b\x6b\x8b\xed\xbc\xc6\xd6\x77\x56\xad\x96\x1b\xcf\x5a\x19\xb5\xa7\x83\xa9\x9e\x1e\xd1\xeb\x5c\x4a\x25\x52\xa6\xa7\xda\x78\x9e\x4b\xd7\x92\xe6\xb0\x88\xe9\xc5\xba\x54\xab\x90\x7e\xaa\xdd\xd2\x77\xab\x81\x6c\x71\x4a\x56\x60\xe9\x01\xb4\xd9\xda\x7e\x27\xd8\x95\x6a\x79\x4f\xfa\xdd\x4e\xaa\x3b\xef\x77\xc7\x62\xaa\x92\xaf\xd3\x6c\x12\x34\x51\x3f\xa6\x64\xf0\x06\x2d\x48\xb3\xef\xc4\xb0\xb0\xe9\xb1\x73\x93\xcd\x54\xc5\x8a\x9a\xcd\xb5\xfa\x0d\xae\x54\x2c\xcc\x6a\x93\xea\x96\x4e\x99\xee\xba\xd1\xcc\x6d\xba\xb5\xbd\xa0\xa6\x20\x57\xe3\x94\xc9\x60\xdc\x44\xfd\xcd\x24\xdd\x95\x0b\xac\x23\xda\xb1\x7e\x25\xa6\x65\x05\xd0\xe6\xdd\x02\x2f\xa7\x87\xc0\x98\x4a\x85\xd2\xa8\x2d\x4a\x15\x2b\xd5\x76\x0b\x64\x33\xe6\xd3\x96\xab\xc0\x42\xac\x98\x2a\xf2\xc6\x26\x83\xa7\x95\x76\x6c\x4f\x1b\x56\xa6\x50\xc2\x3a\x29\xcd\x65\xb4\x5b\xc2\xfd\x6a\xd5\x96\xe7\xc6\xa8\x5e\xe0\xe0\xb0\x1b\x6b\xd6\x18\xb9\x4f\x57\xe0\xac\xe2\x76\x87\xe9\x54\x65\x59\x5c\xad\xaa\xa4\xc8\x49\xf9\x29\xb7\x2b\x59\x05\x7e\x3d\x99\x58\x0a\x8a\xd5\x10\x23\x77\x77\x00\xee\xa6\xb1\x9a\xc3\x48\x85\xc1\xa2\xb0\x92\xeb\xbc\x35\x49\x8e\x14\x76\xe0\x1d\x0b\x0a\xa3\xc9\xb4\x37\x6c\xa5\x4b\x8b\x46\xe3\xf5\xd4\x31\x01\x34\xf2\x1a\x29\xda\x3b\xaa\x03\xa9\x02\x55\xf2\x0f\x30\x91\xc3\x11\xee\xe0\xf7\x03\x36\xc1\xa7\xd7\xb5\xa1\xeb\xed\xb2\x38\xf2\x76\x72\x56\x7a\xa1\x83\x23\x66\x70\xf2\x0c\x42\x34\x82\x83\xce\xf1\xae\x1e\x8b\x30\xb1\xda\xd8\xd0\xdc\xf9\x47\xa6\xe0\x6b\x9c\x4b\x70\x09\x36\x61\x69\xaa\xee\x5f\xcd\xaf\xee\xde\xcc\x6f\x72\x2a\x3d\x8f\xe5\x33\xe9\xf2\xbe\xc7\x98\xe3\x2c\xe0\x5b\x29\xb6\x39\x22\x83\x46\x61\x33\x95\x87\xd3\xbd\xc1\xef\x71\xda\xd2\xe7\x2d\x23\xb5\x90\x86\x4e\x3d\x96\x03\x3c\x19\x57\xd8\xbe\x9a\x59\xa9\x7b\x1c\xc0\xbd\x77\x3b\xff\x42\x07\x38\xbf\xdd\x45\x5f\x44\x2b\x2b\x21\x68\xd8\x16\x25\x0d\x98\xc1\xb1\x0f\xac\xc0\x96\xd6\x54\xde\xa2\x0d\x6c\x18\xd0\x4c\xac\x2c\x9a\x4d\xb0\xa9\x44\x96\xb6\x75\xf1\x50\xf8\x31\x5d\x93\x5e\x12\x8e\x99\x92\x51\xdf\x88\xa3\xe6\x20\xa3\x34\xc9\x2e\xdd\x9a\x1a\x0a\xe9\x2b\xfb\xd9\x2a\x3f\xeb\xb1\x82\x56\x1f\x77\x6a\x80\x6b\x96\x97\xae\x89\x06\x9b\x94\x55\xcd\x65\xc4\x46\xbd\x5b\xde\x33\x33\xf6\x2f\xd2\xf5\x07\x82\x43\x56\x97\xb1\x21\xf7\x89\x6a\xae\x46\xfa\x54\xde\x89\x8c\xc1\x19\xf3\x22\x6b\x0e\x55\x7e\x39\x29\x2c\x70\xa3\xb1\xcb\xf4\xcc\x41\x66\x6a\xae\x1a\x15\x50\x95\x68\xd4\xac\xed\x1b\xdb\x6a\xd9\x92\x52\x5b\x66\xdb\xe8\xc4\x8a\x4c\x76\x35\xec\xfc\xf5\xc9\xba\x8e\x0b\xf1\xa3\x0b\x2c\x01\x9b\xf0\x5f\x6c\x22\x9f\x60\x4f\x0a\xe2\x1f\x53\x93\x2e\xcf\xf6\x66\x7e\x94\x02\xf2\x66\xc4\xcd\x5a\x4e\xdf\x54\xaa\xad\x26\x90\x8d\xc5\xae\xde\x2b\x5a\x12\x47\x97\xb7\x76\xb9\xd5\x1b\xee\x36\x25\x27\x69\x2d\xa0\x99\x17\xe8\xca\x56\x54\xfa\xbd\x76\xae\x54\x53\xfe\x00\x35\xff\x15\x8f\x53\x65\xe8\x40\x0d\x1b\x3a\x44\x84\x72\x02\x47\x0c\x85\x25\x6a\x6a\x87\xfe\x17\x05\x6a\x86\x64\x6b\x94\x10\xdc\xa3\x51\x1a\x96\x65\x15\xc9\x7f\x88\x19\x8e\x0d\xff\x95\x4c\x64\x12\x2c\x13\x86\xc6\xd8\xf0\x03\x06\xe4\xed\xbc\xb6\xe7\x69\xc5\xcc\x41\x36\x55\x6b\xd7\x61\x7a\x5c\xe9\x99\x63\xb5\xce\x0d\x88\x9b\x2e\xcf\x93\x4b\x37\x3f\xa7\xe5\xac\xb0\x59\xe5\xd8\x59\xb2\x23\x54\x3a\xdb\x74\xa9\xd5\xb3\xf6\x5b\x91\xcf\xad\xe4\x2f\x32\x80\x8a\xc7\xff\xd8\x94\xde\xa2\xe2\xe3\xa9\xcc\x91\x18\x68\x6b\xf6\x64\x8a\x50\x7a\xd4\xef\xd7\xe8\x2e\x0f\x97\xa5\x7a\x66\x3c\x6b\x38\x60\xde\xd0\x69\xb9\xcc\xdb\x64\xe8\x90\x0a\xac\x68\xfb\xed\x76\x06\x96\xdd\x58\x8d\x5e\x36\x2a\x62\x83\x96\x62\xbb\xbf\x6f\x2a\x87\xbe\xe3\xee\x6f\x9d\xd1\x78\xe0\x0c\xfc\x17\x97\x60\x12\x99\x23\x47\xc2\xd2\x0f\x98\x32\x1e\x16\x2b\x4e\x77\x31\x94\x90\xbb\x12\xdd\x1d\xad\x4c\xa6\x15\x75\x36\xe8\x69\x3c\x23\xf6\xbb\x3b\x35\x56\x62\xe8\x9e\xbd\xec\x2d\xf6\xed\xbe\x93\xef\x67\x3b\x49\xb2\x4c\xae\x36\x2d\xd8\x9b\xc7\xd6\xc6\x88\xfb\x07\xa7\xf7\x63\x92\x3e\x9e\x6b\xd8\x1d\xd5\x9c\x45\x81\xc7\x13\xda\x92\x7a\x29\xb1\xe6\xb0\x9b\x5c\x29\x9d\xd3\xcd\x6e\xd3\xca\x73\x76\x11\xef\x10\x3d\x1d\xa4\x47\xb9\x58\xab\x48\xcf\x37\xba\x8a\x85\x4a\xb9\xb0\x96\x45\x50\xaa\xf5\x3a\xe3\x7f\x42\x09\x7d\x1e\x9c\x76\x9f\x1e\x0c\xd6\xad\xea\x7c\x46\xec\x15\xdf\x9c\x67\xdd\xda\xb2\x9e\x6c\x70\x7b\xb6\x33\xdf\xe4\xd6\x02\x33\xdc\x48\x1d\xb4\xab\x16\x17\x02\x29\x16\x3b\x34\x5b\x4b\x9b\xf9\xa5\xd1\xae\x65\xa1\x05\x33\xd2\x58\xb4\x53\x5f\xa5\xe7\x84\xa0\x93\x50\xb5\x6d\x9c\x40\xdd\xd0\x00\x81\xef\x37\x24\xa5\x30\x94\x61\x7c\xa8\x39\xfa\xbc\x4f\xee\x29\x82\x1b\xbd\xa3\xaf\x3f\x2e\x68\xb6\xe5\x49\xfe\x31\xac\xcb\xd2\x54\x11\x46\xa8\x67\x0f\x6a\xf4\x50\xfa\x7b\x94\x8a\x51\xaa\x18\x5e\xb6\xf8\x17\x7c\x0e\xd0\xae\x2f\x4d\x5e\xf0\xf1\xaa\xe8\x46\x60\xc5\xb9\x3f\x5f\x53\xa9\xe7\xb3\xcb\xb4\xe8\x8f\x57\xc3\x39\x71\x09\x9b\xaf\x91\x07\x0f\xeb\x9a\x89\x6d\xe3\x89\xf2\x2f\x7a\x1f\x29\x15\x51\xbe\xd7\xbf\x81\xfc\x72\x2b\x12\x02\xf3\xd1\x8f\x13\xfc\x1a\xf1\x1b\x46\xa8\xe7\x10\x9f\x6f\x54\x14\x08\x44\x75\x60\xf4\x39\x80\x41\xbd\xbe\xbe\x52\x0c\xf5\xdd\x63\xf6\xd9\x45\x04\x8d\xb5\x93\xa7\xd3\x9b\xb3\x77\x92\xd0\xd1\x7f\xff\x51\x33\xff\x92\xe4\x0f\xd1\xf0\x39\xb2\xe7\x37\x33\xef\x01\x70\xe1\x30\x5e\xc1\x01\xb0\x0f\xd5\x43\x80\x57\x91\xf8\xec\x95\x04\xf5\xc7\xa2\x35\x0c\x6f\xa6\x12\xb6\xad\x8a\x1e\x23\x8e\xf0\x6e\xdc\xdb\xdc\xbc\x8c\xb9\x19\x2d\x15\xa1\x9e\x03\x37\xfd\x8d\x29\xbd\x71\x79\xe7\xcf\xd9\x6b\xc4\xef\x79\x41\xdf\xe9\xa5\xe7\xfd\xc0\xac\xf0\xbe\x2d\x08\x62\x0b\xef\xf7\xce\xae\x43\x6f\xc2\xb3\xcc\x38\x46\xda\x2e\xf2\xd6\x37\xa1\xa3\x62\xdb\xba\xee\x71\x79\x81\x75\x9f\x6c\x04\xb7\xe4\xcf\x91\xed\xf7\xfc\x23\x64\x1f\x03\xb3\xfe\x22\xd9\x5d\xb8\x25\x9f\x90\x7c\x79\x63\xa7\x98\x14\x7d\x75\x7b\xf6\xc7\x34\x55\x3f\xd0\x54\xe2\x85\x96\xba\x58\x40\x22\x75\x94\xc4\x9b\x6a\xcc\xab\x08\x83\x88\x82\x30\x0e\x62\xda\x48\xf0\x07\x79\xf6\xe3\xb1\x0f\x72\x6d\x6a\x27\xbc\xfd\xe9\x1b\x75\x28\xf5\x43\x13\xae\x48\xbc\xd6\x94\x37\x02\x2b\xbd\xe5\x83\xd1\xb3\xa7\xa8\x21\x76\xa0\xf9\x1a\xd9\x63\xac\x8f\x8e\x2d\xcf\xea\x6d\xf2\x1a\xb1\xd1\xfd\x06\x3a\x76\xe0\x6b\xc4\x0f\x42\x59\x62\xac\xcf\x54\xa2\x94\xfc\x48\x8a\x53\xad\xaa\xea\x32\xe5\xc4\x55\x29\x24\x4a\x01\xd6\x29\xb0\x67\x7f\xa3\xf3\x6b\xde\xd1\xed\x03\xa2\x44\xce\xb8\xe5\x01\xb9\xa0\x29\x42\x3d\xfb\x67\xd0\x23\xab\x02\xc4\x04\x4d\x15\xd6\xaf\x11\x6c\x40\x34\x3a\x8f\x08\x89\x1c\xa6\xff\x04\x2d\xa8\x59\xf0\x4f\xdd\xa2\x41\xef\xb1\x62\x15\x0b\x9d\x42\xa1\x50\x36\x98\x3a\x6b\xf8\xb7\x68\x6c\xb1\x33\xad\xcc\xd5\x54\x6c\x92\xea\x4f\x6a\x9c\xcd\xef\xba\xeb\x66\xbf\xb3\x27\x25\xd5\x68\x89\x1c\xe4\xd2\xdd\xc9\x74\xaa\x2e\xf5\x0d\x97\x9b\xb7\x36\x5e\x9f\xd2\xbc\xd8\x98\xcd\x3d\x38\xd9\x4a\xa1\x50\xe8\x6d\x0b\xb5\x69\xcb\x4d\xf1\x85\x42\xa1\xca\x33\x5a\x65\x30\x1d\xa6\x50\x8f\x5b\x8c\xa7\x12\x3f\x54\x46\xf5\x9c\x50\x71\xdc\x62\x63\x5c\x2e\xb9\x55\x20\x36\x6c\x61\xa6\xa8\x1a\x6a\x62\x7d\x97\x25\x68\x33\x5e\xa6\x36\x8b\x6a\xdb\xad\x48\x15\x83\x1f\x74\x7b\xa5\x3e\x37\x77\x9c\x7d\x45\xde\xbb\xb3\x6a\x11\x95\xd2\x19\x44\x72\x69\x6b\xc4\x19\x7b\xcb\x92\x56\xb3\x41\x7a\x2f\x57\x0a\x7f\xed\x4f\x39\xe5\x70\x9a\x90\xd1\xed\xec\xba\x29\xcd\xb2\x39\xa9\x9f\xa1\x93\x63\x31\x43\xb3\x8e\x34\x57\xd3\xa6\x3e\xe9\x77\xd3\x74\x2e\x4d\x66\x5d\x87\x9f\x22\x3b\x3d\x00\x92\x5d\x33\xb9\xad\xba\x1f\xe4\x45\xc6\xae\x29\x2c\x4c\xf5\x17\xf9\xbc\xb3\x51\x6b\x5a\x7a\x2d\xf1\xb9\x0e\x5c\xf3\xa0\xb7\x29\xa1\x49\x52\x2c\x2b\x78\xa3\xae\x73\xe3\x5e\xbe\x31\x67\xa5\x35\x19\x4f\x63\xce\x3e\x16\x2b\xb5\xed\x39\xc9\xa7\x44\xd4\xd7\xc5\x36\x93\xc9\x4c\x56\x80\x47\x33\xae\x39\x6f\x9a\x7c\x87\xab\x6a\x3d\x66\x0c\xe6\x86\x29\xf1\x2b\x73\x4e\xe8\xc5\x4a\xe3\xc6\xa9\x4c\x72\x9b\x94\x66\x3a\x91\x3a\xa0\xb7\xd4\x38\x56\xcf\x31\xac\x34\x4c\x5a\xc9\xdc\x72\x41\xd6\x31\x73\x23\xad\x33\x35\x6e\xb3\x5f\x15\x19\x34\xe1\x14\x39\xd5\x9f\xa4\x52\x53\x09\x4d\xe7\xa9\xe5\xcc\x5a\x6e\xb6\x4d\x86\x8e\x89\x95\x5e\x3b\xdd\x4f\xe7\xcb\x79\xc7\xc9\xb8\x12\xda\x80\x22\xe3\xa6\xe7\xeb\x55\x7f\x24\x6d\xe8\x6c\x52\xb1\x93\xd6\xcc\xac\x73\xdb\x6c\xbf\x04\xf7\xa6\xd9\xe9\x48\xac\xd1\x2f\x88\xc2\xb4\x9c\xaf\xd0\x25\xa5\xcb\x76\xfa\xfb\x01\x8c\x89\x9c\xb2\x9f\x33\x78\x90\xd6\x63\x4e\x79\x93\xa9\x65\x95\x8d\x93\x1d\xcd\xeb\xa4\x5c\x00\x0b\xd1\x48\x75\xa7\x08\xd0\x93\x81\xcc\x34\xa5\x7e\x2c\xbb\x18\x2a\xa9\x14\x5b\xd5\xeb\x24\x65\xb5\xe9\x9a\xd9\x1f\x67\x57\x06\x1d\x6b\xe5\x99\x0d\x48\xd7\x57\xa6\xa4\xd6\x66\x49\x32\x5e\x20\xa1\xb6\xa3\x27\x99\x41\x7d\xa8\x66\x9d\x4e\x81\xc9\xb5\x7a\x5c\x49\x17\xc7\x9a\xb9\x60\xa6\x36\x37\xde\xbb\xad\x7a\xaf\x85\xf8\x96\x32\x98\x25\x8d\xd1\x64\x5c\xd6\xfa\x3b\x3e\xc3\x0c\x66\x9d\x7c\xae\x0f\xe8\xa4\xd3\x29\x6d\x69\x50\x6c\x94\x53\x5b\x81\xd3\x2b\x20\xd6\x29\x22\x6d\xb0\x55\x81\xa2\xdb\xda\x86\x66\xfa\x83\x9c\x90\xd9\x6c\xcb\x99\x39\x3b\x94\xc5\x64\x77\x94\xcb\x0f\x32\xa5\x94\x95\xe1\xcb\x7b\xc7\x2a\x6d\xe9\x25\xa3\xa1\xf9\x6c\x51\x34\xb3\xee\x6c\x96\x9c\xcf\x19\x6c\xba\xa9\x05\x51\xf6\x5b\x77\xd3\xef\x22\x58\xaf\xb6\x93\xea\x42\xaf\xc4\xb2\xe9\xec\x04\x64\x2a\xbd\x7e\xaf\xd3\xdc\x08\xca\x4a\x2f\x0e\x68\x3b\x15\xdb\x38\x85\xd9\x42\x6c\x2e\xba\x9a\x32\xcb\xd9\x88\x85\xae\xa6\x37\x39\xa3\x5d\x2f\x59\x96\x9b\x76\xaa\x8a\xb2\x28\xa6\x17\xcd\x18\x63\x6d\xda\xf6\x72\x4a\xd3\x0c\xb3\x11\x6c\x01\xf1\x9d\xb4\x3c\xe9\x66\xc5\xbd\xd3\x29\x24\x05\xb1\x89\xeb\x2b\x94\x63\x7b\x26\xc9\xd1\x25\x21\xb9\x73\xdb\xf5\x5e\x96\x34\xeb\x25\x77\x2f\xe8\x64\x53\xe1\x73\xad\x9e\x89\x68\x73\x3c\xb1\xe6\xbc\x39\xd8\x6e\x37\x35\x2b\x17\xe3\x75\x6b\x59\xc4\xfd\x39\x47\xb7\x92\xc8\xd1\x35\x27\x59\xae\x55\xea\xab\x4d\x5e\xe4\xf4\xca\x68\xd6\x4b\xf7\xe9\xcd\xde\x1c\x49\x93\x79\x6e\x3d\x4f\xad\x0b\xb3\x9e\xc8\x73\xab\x9d\x34\x91\xda\xf2\x5a\x30\xe8\xf2\xc0\xad\xa5\x27\x7b\x19\x09\x19\xdb\x9e\x4b\xe2\xce\xe8\xcc\x32\x5c\x69\xab\x91\x0d\xce\xa5\x73\x9b\x9a\x93\xcd\xc5\x46\x79\xa7\x51\xef\x49\xce\x58\x19\xf4\xb3\x79\x77\x3c\x03\xdd\x8e\x4b\xaa\xb9\x9a\x6e\x59\x2d\xcb\x2a\x6d\xc7\xab\x8d\x90\x29\x77\xfb\xd5\xb1\xd2\x4b\x09\xb5\x62\x9a\x77\x68\x5e\x2f\x2e\x87\x38\x17\x2b\xd1\xbb\xbe\x4e\xf7\xe5\x09\x3f\x9f\xab\x53\xda\x69\x4e\x9c\xcc\x28\x55\x41\x96\x34\x93\xad\x7a\xd7\x54\xf3\x22\x87\x3c\xbc\xa4\x8d\x23\xf0\x7a\xca\xdc\xcd\xb2\x3b\x7d\x5c\x12\xa4\xe9\x4c\x9e\xb2\x8e\x5e\xa2\x0d\x7d\x69\x49\xc9\x36\xe4\xec\xf9\x68\xec\x56\xf5\xfa\x68\x56\x16\xeb\xca\xb8\x47\x6b\x85\x2e\xcc\x0e\x17\x35\xbc\x6c\xf7\x07\x96\x90\xc9\x6c\xcb\xb5\x59\x71\x2b\x8b\xc9\x66\x1e\x49\x2a\x89\x75\x38\xab\xdd\xe7\x33\x15\x0d\x74\x95\x55\xaf\x1c\xdb\xf3\x7a\xba\xb3\x16\xba\x4b\xa5\xce\xab\x44\x8b\x15\x17\x99\xbc\x8d\x78\x82\xc0\x4a\x1a\xa9\x5a\x47\x72\xdb\xf5\xe2\x34\x9d\xcd\x0d\xbb\xdb\xc5\x12\xd6\xa6\xfd\xe6\xca\x6d\xa5\x32\xdb\xa9\x92\x1c\x6d\x04\x84\x66\x4b\x71\xde\x52\xf7\xf6\x2e\xaf\x2f\x07\x6c\xa3\xb6\x2f\xdb\x4e\x61\xb3\xa5\xb5\xd2\x6a\xbb\xc8\xd1\x8c\x53\xe5\x0d\xb3\xba\xc9\x66\x3c\x38\xac\x9b\xdf\xcf\x66\x65\x39\x8f\x17\xb1\x96\x84\xb2\x73\x47\x1e\x2e\xb2\xc6\xd6\xd8\xd1\x63\x61\x3f\xe1\xac\xf6\x84\xb3\x56\xaa\xe9\xd1\x24\xc2\x52\x71\xa9\xef\x97\x3d\x33\xbf\xe5\x99\xce\x22\x9d\x73\xc6\x6e\x75\x2e\x76\xdd\x95\xb5\x5c\xb5\x95\x75\x7b\xd4\xca\x94\xc7\x2e\x30\x96\x4e\x1e\xcf\x0b\x2c\xc9\xac\x65\xbe\xd3\xcb\xe4\xca\xb1\x58\xc7\x9d\x73\xe2\xa0\x49\xea\xdb\xdc\x32\x55\x5e\x76\x59\x34\xe2\x9d\x52\x9e\x2b\xd3\x39\x0e\x6e\x92\x7d\x75\xd8\x2f\x6e\xd8\x3a\x58\xae\xad\x5c\x5f\x2f\x12\x9e\x5b\x8e\x96\x4b\x86\xd5\x2b\x62\xac\xcd\xb4\xe7\x82\x2e\xa5\xb9\x39\x9b\xcc\x8f\xe9\x79\xc5\x2d\x4f\xb9\xf9\x0c\x4b\x6e\xba\xaa\xe8\xa9\x18\xac\x37\x78\xcb\xec\xd1\x19\x3c\x55\x06\xe9\x5d\x0d\xf1\xb5\x8e\x81\x58\xba\x53\x06\x8e\x52\x1f\xb1\xe3\x5c\x9f\x71\x33\xa6\xdb\xab\xe9\x76\x6d\x5c\xef\x6b\x9a\x23\xe7\x9a\x49\x91\xef\x17\xc4\x25\x2b\x8e\x61\xa7\x4a\x23\x65\x10\x33\x72\xfc\x5e\xe0\x4a\xb4\xb4\x2f\x96\x63\x99\xe4\x3c\x67\x73\x60\x53\xa7\x9d\x69\x29\xa5\xd1\x4e\x73\x9f\xeb\xef\xe7\xa3\x4a\x3d\xe6\x6c\x62\x7a\x76\x28\xc5\xb4\x81\xee\xe4\x3b\xac\xd0\x35\x94\xea\x58\xe9\xb0\x5c\x4a\xec\xf2\x7c\x32\xa3\x22\x9c\xcf\xa4\x6a\x44\xae\xc5\x46\x31\x63\x6d\x94\xa4\x55\x6e\xaf\xa8\xb3\x09\xad\x00\xb7\xd5\x6f\xb6\x8b\xd9\xa4\x8d\x52\x06\xd3\x43\x63\x26\x29\xae\x56\x69\x6c\x57\x73\x19\x24\x64\xa5\x9c\x90\x1d\x8a\x42\xb2\xb7\x46\x04\xed\xf7\xa9\x75\x76\xea\xe4\xc7\x3a\xcc\x8e\x0b\x3d\x54\x9f\x82\xa2\xeb\x4a\x34\xbd\x65\x91\xc1\xa7\x7b\xf4\xb0\xba\x74\x86\xe6\x22\x66\x33\xba\x38\x6e\x8f\x8c\xf1\xbe\xac\x28\xb5\x7a\x7e\x38\x8a\xcd\x75\x9b\x1b\x97\x53\x73\x91\x93\x60\x36\x36\xb7\xa5\x21\x53\xfa\x8b\x7b\x52\xae\x4b\xa7\xaa\x1c\x97\x53\xf7\x62\x6d\x3b\x9b\xe5\xae\xbd\xd9\x9f\x59\x18\xc1\x33\xc2\x67\x46\x07\xfd\xf6\x99\xed\xe5\x83\xe3\xb1\xb8\x3b\xb5\x82\x94\xf4\x59\xb5\x6f\xe6\x45\x4e\xed\x22\xef\x9f\xb1\x5f\xfa\x76\xb0\xf4\x8e\x45\xd4\xf7\x17\x5a\x49\x7f\x01\x9a\x67\xce\xbc\xbd\x40\xfd\xad\x8b\x29\xbf\xf0\x85\x86\xfa\xdb\x45\x67\xe3\xbc\xef\xa5\x05\x1f\xd8\xdb\x87\xc3\x5c\x34\xc8\x0e\xf0\xcd\x54\x3f\x8a\x3d\xf8\xea\x9a\xc0\xa0\xbc\xe3\x81\x5f\x5d\xf2\xda\x56\xb1\x39\x22\x80\xd8\xd6\xc3\xe3\x3b\x09\x96\x5f\xe2\xe1\x7f\x65\xaa\x83\xc3\xa9\x8f\x00\xf9\x70\xe8\x4b\x10\x20\x5b\xc7\x93\x08\x01\x72\x22\x88\x95\xbb\x08\x83\x3a\x10\x10\xe0\xe6\xff\x1b\x37\x54\x4d\x3b\x41\x33\x72\x41\x41\xdc\x43\xd6\x03\xe8\x59\xf7\x3e\x7e\xfe\x03\xdc\x12\x0f\xb9\xb3\x53\x83\xf1\xb5\x19\x3e\x0b\x84\x0b\x29\x3a\x06\x7e\x1e\x10\x24\x88\xe2\x09\x8a\x63\x9b\xf8\x19\x58\x86\xa9\xea\xc0\xdc\xf9\x65\x96\x4e\xf9\x70\x02\x0a\x2f\x6d\xd7\x32\x24\x40\xd5\xac\xc0\x70\x7d\x9b\xaa\xd0\xa5\xc2\x22\x3f\x46\xed\xfd\x30\x77\x39\x84\x05\x05\x8c\xc4\x5b\x83\x50\x92\x86\x01\x09\x02\xb7\x8f\x3c\x7e\xb7\x9e\x2f\x79\xec\x27\x4c\x22\x6c\x42\x09\x9a\xa6\x47\xe8\x54\xb5\x54\xe2\x47\x6e\x9e\x30\xec\x84\x47\x7f\xfa\x54\xe5\xe1\x50\x0f\x72\x2a\xc6\x80\xd7\xe0\xe5\xe9\x2a\xc8\xb3\x38\x04\x1a\x06\x49\x17\xde\xbf\x71\x8b\x98\xaa\x01\xc5\xf0\x49\xf1\xce\x33\x87\x1a\x9d\xba\x4e\xd5\x78\x3f\x8c\x11\xaf\xfc\x08\xd1\x7b\x88\x6b\x3e\x5b\x4e\x66\x93\x98\x67\xe2\x4a\x14\xca\x12\xb0\x11\xc4\x27\x46\xde\x02\x7c\x5f\x68\xa2\x7c\xd4\x6a\x0a\x34\x1b\x9e\x37\x7a\xa1\xdf\x01\x7b\x35\x61\x2a\x74\xd0\xfb\x10\x5b\x7e\x44\xe1\xb0\x46\xc2\xd3\xa2\x8a\xa8\x90\xa2\x77\xf9\x16\xc2\xc5\x17\x60\xf4\x10\xd4\x3f\x9e\x2f\x69\x72\x24\x36\x4c\x55\x41\x40\x0f\x56\x41\xf0\x9c\xf0\x9e\xbd\x85\x40\xc4\x8f\xfb\xf9\x29\x2e\xa7\x1d\x83\x9c\x97\x8b\x9e\x17\x34\xbe\x53\xf5\x42\xfb\x13\xf1\x67\x84\x24\x08\x20\xf6\xa4\xef\x83\xc3\xb7\x89\x5d\xea\x66\x52\x4d\xe4\x8e\x53\x0c\x6b\xf1\xd4\x39\xab\x4e\x9d\x52\x97\xae\xa7\xdb\x3e\xa6\x4b\x3f\xc3\x05\xfc\xdc\x0d\xf8\xe7\x19\x44\xe1\x40\x61\xe1\xe1\x9c\x1c\xce\xf3\x61\xcc\xb3\x2e\x37\x07\xff\x4b\xeb\xcf\x2a\xee\xde\x83\xa8\xef\x70\xf9\x38\xa5\x4a\xf2\x18\x53\x1d\xa4\x98\xc6\x53\x81\xea\x0d\x12\x51\xce\x33\x97\x28\x83\x8f\x73\x91\x37\x3f\xe2\x9b\xe2\xcf\x63\xb5\x95\xe4\x99\x7a\x0d\xb6\xc3\xd0\xab\xdb\xf0\x5d\x87\x71\x8a\xa5\x5e\xfc\xb5\xfc\xde\xaf\x14\x34\xb0\x12\x1a\x44\x32\x51\x8e\x5e\xca\xb3\x8e\x2a\xa2\xc2\x67\x6b\x8c\x47\x4a\x98\x06\x7f\x31\xc9\x81\xd7\x38\xe4\xff\x81\x15\xd7\x03\xfd\x7a\x89\xd2\x6f\x81\xcf\xf1\x54\x44\xac\x3f\xd0\xd9\x6f\x7f\x7a\x99\x7e\xe9\xd2\xfc\x3a\x0a\x67\x1b\xd7\x29\x55\xb7\x37\xb1\x30\x87\xe4\x5f\xe1\x4e\x73\xce\x21\x2a\xf6\x4a\xb1\xe9\x08\xf5\x2c\xaa\x96\x27\x65\xe2\x55\x83\xb7\xd7\xcf\xa6\xe2\x62\x57\x3a\xdd\xf0\x34\xd9\xff\xf0\xb3\x90\xa9\xcb\xfc\x9f\xc8\x9b\x3f\x40\x07\x9b\xf0\x3d\xfd\xe3\xef\x90\x6a\x3f\x96\xff\x1f\x15\xe8\x30\x5b\xe0\x8f\xc8\xf2\x01\xaf\x7f\x48\x82\x0f\xe0\x6f\x08\xcd\x6d\xa9\xfd\xa0\xc3\xa7\xb2\xfa\xf1\x60\xff\x9f\xc8\xe7\x15\x7b\xff\x73\xa4\xf2\x7d\x1b\xfb\xe7\x84\xf2\x8e\x2c\x7a\x9c\xb9\x12\xc4\x4b\x09\x7c\x6f\x74\xb8\xe0\xb9\x96\xbd\x93\x1d\xf6\x4a\xf2\x7e\x3d\x1b\xe5\x86\x9e\xbc\xdd\xee\xfa\x56\xe7\x36\xa4\xb7\x17\xfa\x64\xf4\x2f\xc9\xd0\x09\x11\x37\x04\xe8\xb4\xf6\x20\x3d\xff\x81\x62\xe3\xa7\x07\x7d\x62\xfc\x5c\xa4\xf6\xde\xbc\x7a\x08\xd2\x8c\xde\x41\x7a\x0c\xbd\x73\xc8\xb9\x99\x28\x7a\xd2\xb5\x1d\xd4\xf4\xc2\x8a\xd3\xd3\x29\xf7\x16\x56\x52\x7e\xcb\x44\x22\xf1\x42\x2b\xdc\x6d\x13\xe9\x90\x78\x7a\xf7\x46\xf2\xd0\x20\xce\x03\x93\xe2\xe5\xb8\x8a\x24\x7c\xca\x94\x43\xff\xf0\x96\xea\xd0\x9c\x07\x66\x78\xc5\xe4\x9b\xa8\x08\xbb\xaf\x11\xe6\xb4\x44\x57\xd1\x65\x09\xd8\xbe\x46\x92\x69\x86\xb9\xe0\xca\xa5\x80\xfd\x09\x93\x6b\x05\x1c\x10\x94\x1e\x5e\xd2\x62\x23\xc1\x4f\x57\x37\x80\x69\xc1\x11\xb4\x2c\x15\xa3\x07\x2b\xf8\x7c\x3c\xe6\xaa\x6a\x90\xf8\xf7\x6d\xd4\xeb\xb1\x88\x3a\x84\x7f\x3c\x53\x61\xf3\x44\x58\xf0\x74\x92\x49\x05\x88\xf5\x5e\xef\x3f\xbe\xd7\xfa\x42\xfe\x4c\xfd\xfa\xdb\x79\xd1\xf5\xae\xee\xb5\x09\x9b\x1c\x6e\x9c\x24\x6c\x52\x0f\x1e\x56\x5e\x8f\x89\xa9\x79\x6a\xe2\x30\x8c\x0f\xf7\xf1\x04\x51\x0f\xf3\xa0\x34\x61\xd8\x96\xf2\x70\xd6\xf0\xd7\x10\xc2\x6f\xc7\xe4\xf5\xab\x31\xbc\x25\x7f\x39\xc0\x35\x96\xa7\x23\x7a\xbd\x0e\x51\x01\xa7\x2c\xa3\x7c\x58\xcf\xfe\xbf\x4f\x27\xa5\x47\x56\x1c\xcb\xbe\x1f\xbf\x5d\x91\x8a\xa5\x4f\x30\xf9\xd5\x03\xff\xdb\xe3\xd9\xb8\x21\x36\x5f\x60\xc3\x0d\x14\x8e\x0c\xbc\x61\x71\xf9\xa0\x42\xe8\x57\x2c\xfc\xa8\xa3\x85\x4d\xf2\xf0\x00\x9e\x28\xfe\x91\x7a\x7d\x3b\x41\xd6\x84\xc4\x36\x11\x75\x98\xb2\x40\x0b\x52\x71\x8a\x3f\x2b\x38\x0e\x75\x1c\x34\xec\xe7\x8d\x79\x96\x92\x3d\xb5\xfd\xd8\x46\x03\x23\x88\xc8\x43\xb4\x7f\xeb\x98\x11\x7d\x7a\x7f\xcd\x4a\xa8\xda\x9e\xa9\xe8\x8f\x1f\x1e\x49\xa2\x87\x19\x14\xa1\xa6\xea\x6a\x28\xa9\xd1\x9f\xbe\x45\x9f\xa8\xe8\xf7\xe8\x51\xac\x3d\x84\x1e\x1e\xaf\x09\xbc\x31\x3d\xe1\x16\xf0\x4c\xb1\xe9\xab\x69\xf8\x7e\x80\x67\x98\xd8\xb0\x9e\x4f\xba\xdf\x5b\x35\x05\xd3\x04\xbb\xb3\x19\xf1\x98\xf5\x01\x4f\x8e\x46\xea\xc7\xec\xb8\xb2\x65\xff\xa3\x38\x71\x49\xf8\xa1\xb1\x47\xae\x4d\xa0\x78\xd5\x3e\x24\xe8\xe1\x7c\xc1\x98\xd0\xb2\x35\xe2\xad\xde\xef\x27\xa5\x67\x8b\xd1\x5b\x89\x44\x51\xad\x6b\x8d\xe3\xfd\x51\x25\xea\x21\x38\x42\x63\x8b\xf8\x8e\x0d\x15\x85\x50\x2f\x9b\x1e\x46\xfb\xf5\xac\xfd\x6f\xa7\x8b\xd5\xfb\x7a\x94\xf4\x90\x32\xca\xbf\x36\xfe\x12\xa8\x0b\x2d\x14\x62\x28\x3e\x53\xbf\x27\x6c\xa4\x6e\x6c\xd8\x10\x1f\xa2\x5e\xeb\x43\x30\xd3\xef\xd1\xc7\xa7\xab\x0e\x07\x35\xe5\x7d\xfe\x76\x51\xfb\xfd\x87\x7b\x4f\xdf\xcf\xb8\xea\x4f\xf8\xef\x81\xc3\xc6\x7a\x08\xf9\xf1\xcb\xf5\x1c\x7f\x28\xaf\xa3\x73\xf3\xf5\x8e\xb8\xde\x31\x72\xff\x4e\x69\x3d\xb1\xdb\xfe\x06\x51\xfd\x90\xe6\xda\xc1\xf6\xba\x43\xed\x95\x6d\xf6\x55\x3a\x3f\x44\xed\xf3\xbd\xf9\xcb\x8b\x4d\x07\x6b\x58\x06\x04\x58\xf0\x6a\xb1\x79\x2b\x0a\x61\x11\x5a\xfe\x7a\xfb\xe5\xa2\x06\x8a\xb2\x5f\xf3\xeb\x6f\xbf\xfc\xf0\xe7\xd6\xa2\x6f\xc3\x8b\xd4\x2b\xf5\x6f\xef\xdb\xef\x3f\x7d\x3b\x06\x6c\x7d\xff\xf7\xf9\xa2\xf2\xb1\x08\x6c\x7e\xf1\xd6\xaa\xf1\xd6\x4c\x50\x7b\xb9\x3c\xfc\x37\x15\x3c\x1f\x83\x63\x2e\xab\x65\x13\xdb\xc6\x33\x15\x35\xfc\x19\xbc\xa8\xf4\x57\xc3\x33\xc5\x9e\xaf\xa1\x33\x6a\x4f\x14\x0a\x01\xf2\x15\x85\x27\xec\x20\x40\xf6\xb8\xf1\x41\xd3\x80\xad\x04\xc8\x01\x4f\x08\x90\x7f\xff\xe9\x1b\x01\x72\x42\x01\x96\x72\xc9\x91\xc3\xd0\xff\xf5\x10\x74\x50\x51\xc0\xa4\xc7\x5b\x70\x0f\x0c\xf4\x9b\xde\xd6\x3a\x07\x2e\xfa\x4d\xae\x75\xcc\x09\x2b\x0f\x57\x23\xb7\x1b\x1d\x18\x4a\x80\x7c\xc5\xcf\xe0\xcf\x81\xab\xb7\x6a\xbf\x5f\x13\x79\x47\x9f\x5e\x12\x15\xfa\x9a\x63\xaf\x14\x77\x03\xc6\x55\x89\x2f\xbc\x81\x0e\xbf\x05\x59\x32\xb1\x7e\x94\x28\x8a\xe0\x90\x2f\xd7\x80\x1f\x2f\x07\xbb\x54\xb8\xb7\x65\x05\x88\xa2\xf9\x91\xb0\x78\xf5\x47\x69\xb9\xd3\x38\x10\x17\xaf\x32\x90\x17\xef\xdb\xef\x3f\x7d\xf3\x3e\xee\x0b\x4b\xd8\xfc\x4b\xd2\x12\xb4\xfd\x58\x5c\x82\x36\x1f\xca\x8b\xd7\xe4\x63\x59\xf1\x5a\x7c\x22\x2c\x7f\x93\xac\x84\x24\x9d\x08\xcb\x3f\x21\x2b\xc1\x28\x7f\x42\x58\x4e\xbf\xdf\x52\xa9\x87\xc3\xcb\xa9\x56\xfd\xf8\xc8\x43\x85\x33\x7f\x7e\xd0\x08\x8d\xf7\x97\x57\x8a\xbd\x16\x00\x01\x23\xa2\x22\x1b\xfe\xf2\x91\x24\x1f\xdc\x79\xbe\xe4\x1d\x8c\x93\x9f\xbe\x1d\x86\xb9\xaf\xc3\x8f\x1d\xef\xa9\xf1\x63\x83\x3b\x9a\x3c\x1a\x12\x7c\x25\x30\x07\x69\x7a\x0f\x01\xbf\xab\xd0\xa9\xd8\x1d\x8e\xfc\x0f\xc5\x3d\x7e\xa8\xed\xfd\xa9\x38\xec\x6c\x67\x20\xae\x19\xf9\xa1\xdc\x04\x52\x73\x63\xe3\x0b\x44\xe8\xc8\x85\x4b\x89\xb9\xb4\x36\xcf\x65\xe6\xda\xa6\xfb\x15\x41\x97\x72\x54\x2b\xe1\xed\xf1\x23\x48\x1e\x8e\x46\x5e\xa8\x00\x9e\xa8\xcb\x16\x3e\xde\x8f\xd7\x87\xde\xa3\x19\xa1\x63\x1b\xf9\x56\xc4\xd1\x4f\x71\x66\x38\xf8\xa2\xf9\x13\x82\x5b\x32\x56\x85\xf5\xc3\xc3\xc5\x41\x92\xa2\x7e\x7a\x88\xfe\x18\x5c\x90\x47\x1f\x13\x8a\x2a\xc2\x87\x33\xaa\xbc\xea\x1b\x4e\xa4\xe8\xa3\xff\x5e\xb0\xf3\xb6\x07\x17\x88\x67\xbd\x50\xaf\xc1\xd0\xa7\x16\xcd\xad\xb6\x57\x82\xe7\x73\xe2\xf9\x08\xe7\x57\xe6\xb7\x73\xc1\xf1\x19\x72\x52\xcf\xfe\x76\xc7\x8e\xf6\xcd\x9e\xc3\xbb\xd2\x5e\xdf\x09\x39\xb8\xa1\xa2\x8f\x67\xe2\xe4\xdb\x57\x41\x8a\x06\xf5\x7a\x9c\x86\x6e\x50\xf2\x70\xec\x1d\x7d\xf4\x30\xf2\x87\x7f\xba\xc0\x5c\x03\x3b\x6c\x93\xe7\xeb\x85\xa4\x1b\x26\x76\xa0\xd8\x0e\xeb\xfd\x6c\x86\x73\xa2\xbe\x3f\xdd\xe2\xc1\x25\x20\x4b\x01\x86\x67\xc7\x8a\x98\x44\x3f\xec\x1f\xf2\xe8\x5a\x99\xf8\xaf\xa7\xfb\x76\x78\x3d\xaf\x67\x19\xe0\xe8\x65\x67\x8a\xb2\x74\x8c\x89\xf2\x15\x44\x0d\x65\x67\xa9\xc2\x8d\xa1\x20\xf2\xbd\xb6\x37\x61\xf8\x0b\x57\x80\x05\xa2\x01\x2b\x59\x04\xd6\xb9\x09\x7c\x44\xc2\x30\x55\x24\xb7\x7d\x55\xf0\x4c\x25\x39\xe6\x7a\x4f\x0a\x9a\x94\x30\xb2\x08\x40\xe4\x99\x62\x12\x6c\xee\x72\x89\x5e\xf6\xd2\xc1\x76\x0a\x35\x2c\xa8\x64\xf7\x4c\xb1\xa9\xcc\x15\xed\x58\x73\xa0\xf9\x4c\x45\x2f\x71\xbc\xd2\x5f\x44\xd5\xa1\x45\xa0\xe1\x8d\xcb\xa5\xaf\xe0\x10\xc0\xab\x9a\xba\x0f\xdf\x72\x7c\x4d\xdf\x91\x43\xc4\xb4\xaf\x18\x44\x51\xde\x59\xc4\xef\x6b\x3d\x53\xc9\x34\x73\x83\x7a\xdb\x10\x01\x81\x8d\x30\x49\xc6\x6b\xf5\x31\xed\x17\x8f\xbe\x86\xbe\x31\x73\x81\xf5\x7d\x0b\xe3\x50\x7c\xa2\x3f\x26\x73\x20\x9b\x4a\x47\x3f\x63\xb5\x6f\x76\x7e\x08\x88\x61\xb2\xbc\x24\x7d\x0e\xc8\xb7\x49\x3e\x84\xc4\x66\x41\x92\xcf\x7d\x0e\xe9\x64\x3f\xfa\x10\x9e\x24\x09\x2c\x93\xbd\x82\x77\x5f\xdd\x9f\x2b\x93\x50\x91\x24\x30\x7a\x88\x9e\x49\xc2\x51\xf9\x3c\x79\x3b\x97\x09\x74\xeb\x4a\x21\x87\x9a\x0b\x9a\x02\x44\xc4\xdb\xdc\x5e\x0f\x4d\x13\xef\x42\x41\xd1\x54\x58\x46\x30\x01\xda\x23\xf5\x3f\x14\xcb\x30\xe7\xdb\xd1\x41\xf9\x25\x00\x21\xe6\x43\xf4\xcc\xc3\x1e\x7d\xa2\xae\x60\x3e\x26\x04\xcb\x7a\x88\xfa\x99\xdf\xd1\x27\xea\xdf\x3f\x7d\x7b\x47\xe2\xfb\xcf\xff\x3e\x53\xf5\x77\xe9\x15\xe0\x05\xc5\x8d\x23\xfc\x32\x46\xde\xc1\xfc\x7a\x0b\xfa\x14\x55\x6f\x01\x5c\x60\x17\x65\x19\xe6\xe7\xe8\xc5\x06\x7c\x7f\xb3\xba\xde\xd8\xee\x50\x70\xc0\x1d\x3e\xf8\x83\x9e\x78\x5d\xde\x3d\xb7\xef\x4e\x03\x8b\x98\x78\xf7\x77\x6d\xbe\x97\x1b\xea\xf7\x0b\x5f\xf1\x3d\xaf\x47\x17\x93\x2a\xb6\x91\x78\xd7\xf1\x11\x79\x51\xd8\xb7\x1e\xc6\x86\x95\xa0\xca\x18\x45\x09\xb5\x46\xd8\xa5\x5c\x05\x9a\x90\x22\x0a\x20\x94\x6a\x25\x5e\x68\x85\x7d\x8b\x7c\x38\xd0\xd9\xad\xf0\x07\xfe\xcf\xcb\x0c\xc1\x3f\xed\x65\xf1\x4c\xd0\x11\xf1\x94\xfc\xd5\x95\xc8\x99\xe7\xe5\x73\x07\xe6\x21\xf7\xed\xca\x83\x19\xfa\xda\x04\xc5\x46\xeb\x87\x77\xef\xc8\x13\x95\xfc\xc3\x1e\xb7\x63\xc0\xd1\x1d\xd6\x5c\xa6\x24\xfd\x25\xe7\xd3\x33\xd5\xe3\x57\x50\x20\x57\xe6\x20\x24\x0a\x16\xcf\x9a\xdf\x0c\xfc\xbc\xf2\x2d\x05\xf1\x9f\x25\x2c\x7a\x0a\xc7\xbf\xea\x6a\x20\xf2\x40\xff\x3f\x0f\xff\x2b\xc6\x1e\xff\xd7\xa2\x13\x70\x0b\x85\x77\x0e\x85\xf1\xa2\x9e\x35\x74\xb6\xac\xbc\xf3\xcd\x09\xa8\x37\x2a\x95\xcf\x5f\x5a\xe3\x21\xd7\xc3\x70\x4f\x11\x20\x19\x9a\xd1\xb3\xb5\x19\x1c\x1d\xaf\x60\x71\x9f\xc1\x72\x81\x89\x54\x24\x7f\x09\x58\xf2\x33\x60\x2a\x92\xf0\x97\x20\xb1\x9f\x41\xb2\x6c\x41\xf0\x94\xfe\x0d\x60\x1f\x76\x3b\x04\x88\x9e\x77\x7c\x17\xcb\xf7\x55\x71\x9e\xf9\xf5\x00\x1d\x88\x2e\x5c\xe8\x3f\x05\x85\x89\x20\x78\x34\xd0\xa6\xdf\xa8\xe8\xf1\x2d\xf9\x51\xef\xb4\x26\x00\x0d\x3e\x24\x1f\xa3\x67\x47\x9b\x93\x61\x2e\x53\xcc\xfe\xda\x40\xec\xfd\x81\x6e\x64\xaa\xdd\x1a\xcb\x3f\x87\x1f\xdf\x90\xfd\x7a\x3d\xb6\x86\x2d\x68\x91\x87\xe8\xfd\xdf\x2f\x88\x5e\x1c\x77\x3e\x46\x3e\x1e\x24\x51\x47\x9f\xa9\x87\xb0\xa5\x07\x78\x4e\xc5\xdf\xd1\x48\x60\x49\xb2\x20\x79\x78\x4c\x68\x50\x22\x8f\x14\x7d\x52\xe5\xef\x5e\x0f\x8f\xe1\x76\x4d\xc5\xa8\xe8\xcf\x7e\x6c\xf6\x29\xb0\xc5\x6d\x60\x04\x1b\xe7\xb0\x82\x37\xb7\x9c\x03\xbb\xcb\xcf\x1b\x49\x76\xb7\xf8\x19\x62\x61\xfa\x9f\x65\x28\x01\x5b\x23\xd7\x67\x3c\xdd\xeb\x7e\xd0\x62\x3e\xd7\x23\x97\xef\x74\x8e\x9c\x75\x3a\xeb\x90\x90\x54\x24\x3e\x44\x13\x7e\x61\x10\x10\x1f\x7d\xf4\x9d\x98\x27\xda\xc5\x36\xb5\xcf\x21\x9c\x4c\xa7\xa6\xa2\x75\xf4\x31\x34\x1f\x14\x13\x4a\xd1\x27\xea\x44\x57\x9d\xe5\x2b\x7e\x0e\xf8\x42\x58\x8e\x80\x2d\x53\xf8\x08\xee\xc1\x7a\xd1\xc8\x59\xab\x8f\x69\xf1\x9f\x1e\xa2\xde\xe6\x1f\xbd\x3f\x77\xa7\x41\xe6\x7f\xef\xc4\x89\xa7\xe1\xeb\x57\x3d\x4c\xff\x56\xe1\xb0\xd1\xa9\x1a\x7c\x88\x7e\x25\x2e\xf6\xe3\x90\xd8\xf3\x25\xe7\x1d\xb5\xa7\x36\xbc\x70\xcb\xf8\x49\x9e\x57\x16\x7a\x08\xe7\xf9\x84\xbb\x61\xd1\x47\x47\x1d\x13\x22\xff\xbd\xf6\x26\xb4\x12\xc1\xf7\xf3\x7a\x4f\x99\xab\xc2\xd0\xaf\xa9\x7a\x07\x2e\xaf\xe1\x45\xe1\x99\xe5\x98\xf8\xc9\xf7\xba\x3c\x44\xcf\xb8\x77\xeb\x37\x07\xa2\xff\x17\xeb\xc0\x51\x2d\x95\x04\x61\xd4\x41\x08\xd3\xfd\x95\xf0\x45\x78\xd0\x8d\x9b\xc0\x3d\x92\xf2\x19\xd4\xb0\xdd\xd7\x16\xd7\x11\xba\x09\x2d\x03\x23\xeb\x73\xa4\x79\x2c\xee\x3e\x81\x7d\x6f\x15\x7d\xdd\x70\x3b\x9f\xb6\xfb\xc6\xed\xad\xf4\x87\x3f\x6d\xc9\x1d\xe5\xf9\xe6\x0d\xe1\x0d\x5b\xee\x76\x0a\xc1\xd9\x3a\xf1\xcc\x93\x30\xe4\x5f\x45\x82\x09\x81\x05\xad\x11\x14\x6c\xef\xd0\x7b\xcf\x4c\x09\x53\x31\xee\x9b\x29\x27\x40\x45\xf8\x87\x80\x7e\x62\x92\x9d\x66\x35\xbc\xbe\x52\x91\x36\x16\x82\x17\xd2\x7f\x0c\xf5\xda\x36\xbb\xb6\xea\xa3\xd1\x3f\x2a\x08\x27\x01\x8b\x9f\xde\x97\xff\x23\x56\x7c\x88\x5d\x80\x9c\x80\x91\x45\x0e\x71\x4c\xd4\x2b\xf5\xed\x5b\xe2\x7b\x78\xcf\x12\x54\x85\xfe\xd3\xdf\x13\x70\x4b\x20\x12\x1f\x6e\x06\xa8\x3d\x51\xdf\x28\xc1\x36\x4d\x88\x88\xff\xf6\xa1\x67\xca\x55\x91\x88\xdd\x84\x16\x72\xda\xbf\xd1\x3c\xda\x0d\x01\xe4\xe0\x55\x3b\xa1\x1f\x74\x6a\xc3\xe0\xbd\x45\x47\x15\xed\x57\x7b\x64\x1e\x89\xf9\x46\x19\x80\x28\xcf\x54\x94\x8e\x3e\x79\xc6\x1b\xb0\xbc\xef\xc6\xc9\x0b\xe5\xa3\x4f\xd4\x91\xd3\xcf\x9f\x05\x2b\x3c\x3e\x1d\xf9\x75\x38\xf0\xbd\x47\xa8\x51\xdf\x4f\x15\xfc\xfb\xc8\xc6\xf5\x2b\xe7\x3f\x1c\xf4\x76\x94\xd3\xe5\xd0\xb7\xee\xf3\x3f\x88\xd9\xfa\x14\x39\xff\xdd\xf5\x5f\xc1\xeb\x3d\xd2\xe8\x2f\x70\x23\xf0\x59\x7f\x34\xda\x7b\xcc\xc4\x87\xc3\x3c\xfd\xfd\xcc\xf0\x63\x1d\x3f\x16\x0b\xaf\xc5\x3f\x84\xdb\xd3\x21\xf4\xd2\x6f\xe3\x7f\xbf\x83\xee\xff\x7c\x88\xe3\x99\xf7\xe5\xf1\xa8\x68\x7e\x3b\x5b\xca\x0e\x30\x29\x60\x18\xef\x0b\xea\xb8\x94\xfc\x5b\xb4\x1f\x81\x61\x44\x4f\x63\x6a\x02\xac\xbe\xa8\x59\x82\xc5\xfa\x1c\x7e\x1e\x15\xdc\x65\xa8\xeb\x49\xa0\xae\xbf\x5b\x52\x12\x10\x61\x84\x22\x80\xf7\x5f\xfd\xf3\x1a\x89\xb3\x87\xc8\x5c\x51\x05\x1a\x96\x6f\xbd\xf7\x25\x88\x8c\xbf\xb0\xf5\xaf\x03\x9c\x03\x9b\x26\x00\x13\xec\xd4\xf1\xad\x76\x33\xcc\x39\xa8\x0c\x7f\x12\xf3\x4e\xee\x57\xd0\x26\xd8\x29\xce\x83\x8f\xdf\xb3\x6b\x4f\xac\xa8\xc8\x45\x1a\xed\x7b\xa0\xf9\xf9\x2f\xaf\x1c\xb3\xf2\xf0\xf1\x07\x57\x44\xd5\xd2\xd5\x23\xb8\xf3\xdf\x4c\x29\xf9\xed\x6e\xbd\xf1\xe6\xc6\xeb\x71\xfe\xdb\xbf\x1d\xf8\xe5\xd6\x7b\x6f\x4e\xa3\xcc\xa9\x8f\x93\xd2\x02\xa2\x2e\x12\x94\x4f\xd2\x57\xef\xa6\xdb\x5e\x9c\x8c\x82\x5f\x23\xb8\xf3\xc6\x99\x48\xf0\x56\x95\x48\xf0\x9e\xd0\x08\x45\xbf\x7d\xf8\x6e\x9e\x2b\xf4\xae\xb2\x6b\x3f\xe1\xf7\x21\x46\xff\xe8\xdd\xb8\xcd\xfb\x37\x9f\xdf\x9f\xb0\xeb\x76\x80\xf7\xe1\x35\x52\x7f\xa3\xc8\x9f\x9d\x92\xfe\x7f\x79\xff\x3f\x96\x77\x85\x7b\x1b\x86\x87\x05\x2a\xb4\xbf\x9f\xcf\xf3\x14\x2e\x73\x8f\xaf\x4d\x7a\x8f\x4b\xf7\x33\x32\xbf\x2e\xda\x9f\xae\xbd\xcb\x1c\x94\xab\xd3\xd9\x9d\xfc\xec\x3f\x0b\xfd\xe6\x59\x2d\x4c\x44\x1f\x02\xf7\xc0\xb0\xbf\x6f\xa4\x8b\x73\xdb\xc9\x50\x87\x49\xba\x1c\xeb\x3f\x40\x1d\xbc\xd0\x41\x6e\xf3\x0f\x2f\x74\xf0\x63\xd7\xff\x6f\x00\x00\x00\xff\xff\xaa\xad\x30\x1f\xfd\x7a\x00\x00")
+var _staticPage_classesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x51\x6f\xdb\x38\x12\x7e\xef\xaf\x98\x1a\x01\x22\xc5\xb5\x9d\xde\xa1\x0f\x15\x54\x0b\xb9\xa6\xbd\x06\xe8\x15\x01\xe2\x22\xb8\x9a\x72\x41\x89\x63\x89\x09\x45\x6a\x49\x2a\xae\x5b\xf6\xbf\x2f\x28\xc9\x8d\x6b\x37\xd8\xd4\xbb\x2f\x36\x35\x9a\xf9\xe6\x9b\x8f\xc3\xa1\xe6\x4f\x00\xbe\x0d\x24\xad\x70\x10\xc1\xe0\xbd\x2a\xb8\x84\xb7\x4a\x57\x83\x67\x30\xb0\xeb\xba\xb5\xd6\x9a\x57\x54\xaf\xbd\xc9\xa0\xc0\xdc\x2a\xed\xcd\x4b\xa5\x2b\xe0\xb2\x6e\xec\xdc\x7b\xbe\xaa\xa9\x31\x2b\xa5\x19\xf0\x74\xf0\xfd\xd9\xef\xe1\x5a\x6e\x45\x6b\x0b\x12\x1e\x12\x92\x05\x49\x24\x54\x01\x09\x97\xce\xf0\x42\xb6\x8b\xd6\xa0\xa4\xa3\x8d\x2d\x51\x5a\x9e\x53\xcb\x95\x04\x8d\x7f\x34\x5c\x23\xf3\x61\xbf\xa2\xb8\xcb\xe5\x2d\x17\x08\x1f\x6b\xa1\x28\x3b\xb4\xd2\xa5\x87\xd8\xaf\xf2\x1c\x97\xb4\x11\x16\x2e\x2e\xae\xe0\x92\x16\xb8\x8d\xcc\xa8\xbe\xdd\x2b\x74\x11\x24\x91\x77\xbe\xe6\x92\xa9\x95\x09\x92\x08\xae\x50\xdf\xa1\x0e\x13\x77\x71\x71\x35\x3f\x1d\xbd\x4c\xdd\x85\xb4\xa8\x25\x5a\xb8\x90\x9e\x47\x57\xb4\x77\xe3\x39\x9a\xf0\xe8\x10\x12\x99\x62\xeb\x0d\x87\x98\x57\xc5\x7c\x31\x4d\x87\x46\xe7\xaf\x08\x21\x03\xce\x8d\xb1\x54\x5b\x42\xc6\xb5\x2c\xbc\xe5\xa1\x14\x67\x35\xcd\x4b\x7c\x5c\xa9\x9d\xef\xbf\x20\x48\xa2\x8f\x59\x23\x6d\xe3\xce\x31\xe3\x54\xba\x1e\x2c\x84\x0d\xaa\x87\x73\x3d\xf4\xbb\xd9\xec\xb2\x97\x04\x66\x68\xfa\x97\x3f\x56\xb0\x54\xda\x23\xda\x12\x21\x4c\xba\x98\x03\xc9\x96\x48\x19\xea\x9e\x6d\x15\x2e\xba\xa4\x51\x1f\xb6\x2d\xda\x82\x10\x73\x12\x97\xb6\x12\xd3\xd8\xdb\xa6\x71\xf9\x7c\x7a\x61\x61\xa5\xf4\xad\x79\x1a\x4f\xca\xe7\xd3\x87\x38\xc8\x82\xcb\x2f\x8f\xd3\x6b\x71\x8d\x22\x57\x15\x82\x55\x5d\xd8\x3f\x81\xf9\xb3\x70\x5e\xb5\x20\x89\x5a\x00\xf7\xc1\xff\x86\xdb\x82\x3f\x94\x70\xa6\xaa\x9c\xda\xbf\xce\xb8\xe8\x15\xef\xfc\x83\x24\x9a\xf8\x7e\x1e\xa7\xc3\x30\x39\xda\x6d\x42\xd3\xe4\x39\x1a\xb3\x6c\x84\x58\x03\x97\xc6\x52\x21\x90\xf5\xa9\x1e\x22\x22\x78\x51\x5a\x5b\xb3\xc7\x15\xbf\xf1\x1e\x9f\x04\x49\x54\x0b\x9a\x63\xa9\x04\x43\xed\xd8\xa6\x01\x6b\x0f\xf3\x40\xae\xd7\x94\xb1\xf5\x23\x6a\xee\xfc\xba\x56\xd8\x3b\x9a\x97\x54\xdf\x22\x83\x73\x55\x51\x2e\xb7\x71\x56\x54\x4b\x2e\x8b\x5d\x55\x6c\xc9\x0d\xb0\xd6\xdb\x77\xb9\xaf\x1f\xc2\x24\x48\x22\x6e\x5c\x45\xd7\x90\x61\xd8\x9e\x00\x43\x05\xba\xac\x59\xc3\x56\x80\xbb\x8f\xe3\xc6\x95\xd4\x40\x86\x28\x7d\x91\x9e\x83\x33\xc8\x94\x5f\x72\x7f\xc2\xc7\xb9\xaa\x5c\xff\x94\x6b\x5c\x11\x32\x96\x68\x5d\xa6\x18\x37\xfd\x5b\x9a\xa9\x3b\xec\xd6\x93\xca\x97\x61\x5b\x09\x1d\xa3\xb2\xb7\x66\xcd\x7a\xd4\xa7\xa6\x4b\x3f\xb1\x78\xde\xc7\x96\x4d\x81\xdd\x9b\x0d\x9a\xcf\x25\x54\xb1\xf1\xd8\x13\x9d\xeb\xf6\x92\x59\xc3\x7b\x6e\x6c\x2f\xcc\x2f\xb4\xba\x97\xdd\xcf\x51\xc9\xf0\x0b\xa8\x25\x4c\xdc\x7d\xbc\xe8\xe2\x5b\x95\x26\x6e\x0f\xd7\xcf\x7f\x98\x84\x87\xe6\xdf\xde\xab\x29\x21\xf3\x99\x82\x4b\xaa\x51\x5a\xf8\x81\x40\x48\x1a\x4f\xe8\xde\x40\xb8\xb2\x34\xbf\x85\x99\xa6\xf9\x4e\x3f\xc9\x02\xf5\x36\x74\xeb\x92\x79\x67\x42\x82\x4a\x19\x0b\x1a\x73\x9f\x21\xa7\x42\x80\xa0\xc6\x12\x12\x46\x07\xc3\x13\x92\x51\x0b\x73\x3a\xfa\x7a\x36\xfa\xf4\xf9\x28\xed\x57\xa7\xa3\x97\x9f\x8f\x52\x7f\x50\x08\x19\x6f\xdb\xe2\x69\x3a\x0c\x87\x84\x04\xf3\xb3\xd1\x27\x3a\xfa\xda\x39\x0e\x09\x19\xdf\xd0\x3b\x1a\xf9\x03\x9e\x0e\x09\x09\x0f\x26\xd4\xcf\xfa\x37\x5a\x2b\x0d\x5c\xc2\xf1\x7c\x71\x9c\x9e\x1c\xc3\x59\x5d\x8b\xfe\xc2\x77\x71\x36\xf5\x23\x78\x0b\x33\xf2\xcf\xf1\x24\x3b\x5c\xe7\x38\x9b\x06\x49\xf4\x96\x5a\x2a\x00\x7d\x72\x77\x49\xb5\xc1\x7e\x7d\xdd\xed\xb9\xfb\xa0\x2c\xcf\x31\xf4\x99\x7c\xca\xe1\xf8\xdb\xf3\x67\x2f\x4e\x4f\xbf\x27\x9e\x6a\x9c\x4d\xe7\x8b\x38\x1d\xfa\xb7\xa0\x24\x08\x2e\xb1\x35\xb6\xa2\xfc\x2d\x76\x84\x48\x9f\x8e\x5a\x7f\x9c\xe7\xfe\x02\x0a\xc2\x74\x08\x61\x42\x48\xe0\xe7\xc1\xa4\xb7\x45\xe9\xd0\xf9\x9d\x49\x23\x42\x08\xd9\x32\x4a\xc5\x70\x13\x18\xa5\xc3\x90\x90\x71\x90\x44\x37\xc6\x55\x37\xc6\xe5\x37\xc6\x59\x13\xf6\xbb\x77\xbf\x89\xc9\x2e\xdf\x73\xcc\x9a\xe2\x17\x43\x70\x97\xee\xff\x55\x73\xac\x11\x0c\xa2\x3f\x63\xed\x50\x6a\x75\x84\x0c\x73\xda\x18\x84\xb5\x6a\xa0\xa4\x77\x08\x71\xae\x18\x4e\xcf\xdf\xfc\xe7\xe3\x7f\xe1\x15\xcc\x74\x83\xf1\xa4\x35\xfd\x6e\xea\x1f\xa3\xe0\x1a\xf5\xed\x57\x6c\x0a\x68\x23\x0a\xd4\x6e\x71\x96\xb7\x9f\x4c\xaf\x95\xb4\x5a\x09\xe1\x6f\xf5\x37\x5f\x72\xac\x5b\x6b\x4e\x9b\xa2\xb4\x47\xbf\x9b\x6f\x53\xea\x75\xa9\x54\x6d\x9e\xc2\xac\x44\x8d\xb0\xa2\x06\xa8\xec\x9a\x86\x90\xb1\xbb\x5a\x57\x4b\x25\xd7\x7e\x33\x5e\xab\xaa\x56\x12\xa5\xf5\x0f\x6d\x7b\xbf\xa3\x92\x09\xd4\x2e\x6e\xb9\xb7\xbd\x73\x42\x48\xf0\xe2\xf4\x14\xba\x4f\x3e\x2a\x36\x9f\x3e\xad\x3f\x21\x61\x3c\xe9\x7d\x09\x31\x84\x5c\xa5\x27\x66\x39\x62\x4d\x55\xef\xb2\xff\x1f\xe5\xd2\xa2\xa4\x32\xdf\xff\xe0\xe1\x72\xa9\x7e\x52\xcc\x4f\xaf\xea\x3e\xc0\x65\x08\xda\xdf\x95\xe0\xc7\x8e\x7f\xf2\xff\x60\x94\x92\xce\x62\x55\x2b\x4d\x35\x17\x6b\xdf\x89\x4c\xad\xa4\x6b\x24\xbd\xa3\x5c\xd0\x4c\x60\x78\x08\x0f\x63\xa9\x6d\xcc\x20\x82\xf9\x8b\xd3\x7f\xa7\x5b\xd2\x7a\x5e\x41\x12\x35\xb2\xbd\xa4\xd5\x4a\xfa\x29\xee\x4c\x5e\x22\x6b\x04\x32\x57\x0b\x2a\x25\xb2\x10\xb6\xc9\x6f\xad\xa1\x52\x0c\x7f\xe2\x3f\xf8\xfe\x24\x7d\xf2\xe7\x00\xa9\xa6\x8c\x6d\xf1\x0c\x00\x00")
+
+func staticPage_classesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticPage_classesJson,
+		"static/page_classes.json",
+	)
+}
+
+func staticPage_classesJson() (*asset, error) {
+	bytes, err := staticPage_classesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/page_classes.json", size: 3313, mode: os.FileMode(436), modTime: time.Unix(1792395975, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x77\x7b\xdb\xb8\x96\x38\xfc\x7f\x3e\x05\xae\x66\xee\xc8\x5e\x59\xa2\x7a\x71\x6c\xdf\xab\xde\x7b\xd7\xec\xbc\xb3\x2c\xa0\x48\x89\x4d\x04\xa9\x96\xf5\x77\x7f\x1f\xb0\x89\xa4\x8a\x15\x27\xb3\x3b\xcf\x3e\xbf\x38\x89\x49\xe0\xe0\x34\x1c\x1c\x00\x07\x85\x2f\xff\x60\x64\x5a\x3b\x28\x10\x70\x9a\x28\xbc\x7d\x79\xc1\xbf\x80\x40\x4a\xcb\xd7\x00\x94\x02\x6f\x5f\xbe\xbc\x70\x90\x64\xde\xbe\x00\xf0\x22\x42\x8d\x04\x34\x47\xaa\x08\x6a\xaf\x01\x5d\x63\xc3\xd9\xc0\x29\x43\x22\x45\xf8\x1a\xd8\xf2\x70\xa7\xc8\xaa\x16\x00\xb4\x2c\x69\x50\xd2\x5e\x03\x3b\x9e\xd1\xb8\x57\x06\x6e\x79\x1a\x86\x8d\x97\x27\xc0\x4b\xbc\xc6\x93\x42\x18\xd1\xa4\x00\x5f\x63\x4f\x00\x71\x2a\x2f\xad\xc3\x9a\x1c\x66\x79\xed\x55\x92\xcf\x10\x33\x10\xd1\x2a\xaf\x68\xbc\x2c\xb9\x70\xe7\x37\x3a\xa9\xc9\x12\x04\x03\x68\x50\xf5\x97\x22\x75\x8d\x93\x55\x57\x81\x36\x4f\x73\x24\x14\x40\x0d\x4a\x2a\xbf\x46\x50\x02\x0f\x9c\xa6\x29\xe8\x99\x20\xb4\x1d\xaf\x41\x35\x42\xcb\x22\x21\xf2\x34\x67\x03\x3c\x9e\xb1\xb2\x84\x12\x54\x49\x4d\x56\x2f\x31\xb2\xfd\xf6\x2d\x32\x81\x2a\xe2\x65\xe9\xfd\xfd\xac\xa8\x2a\x53\xb2\x86\x5c\xe5\x24\x99\x97\x18\xb8\x7f\x02\x92\xcc\xca\x82\x20\xef\xcc\x22\x1a\xaf\x09\xf0\xcd\x27\xdd\x0b\x61\x26\x63\x00\x81\x97\xd6\x40\x85\xc2\x6b\x00\x69\x07\x01\x22\x0e\x42\x2d\x00\x38\x15\xb2\xaf\x01\x5b\x20\xa4\x91\xf4\x5a\x21\x35\x2e\x42\xc9\xb2\x86\x34\x95\x54\x68\x46\x32\x04\x74\x12\x88\x64\x24\x11\x89\x11\x34\x42\xa7\xb4\x88\xc8\x4b\x11\x1a\xa1\xc0\x17\x00\x00\xe0\x25\x0d\x2e\x55\x5e\x3b\xbc\x06\x10\x47\x26\xb2\xc9\xf0\x72\xd9\x3d\x0c\xa2\xfc\xac\x48\xb5\xfb\xdb\xc4\x8c\x57\x44\x32\x91\x6c\x97\x42\x4c\x8d\x88\xb1\xfd\x4c\x36\x49\xac\xd2\xf4\x9c\xe0\x1b\xa3\xfe\xb8\xcb\xd1\x53\x35\xb3\xcf\x35\xb6\xf2\x60\x3f\x8a\xb7\x17\xbb\xd8\x28\x00\x68\x55\x46\x48\x56\xf9\x25\x2f\xbd\x06\x48\x49\x96\x0e\xa2\xac\xa3\xc0\xdd\x92\x61\x31\x56\x88\x81\x02\xbf\x55\x23\x12\xd4\x08\x49\x11\x89\x2d\x8f\x56\x28\x2c\x41\x6d\x27\xab\xeb\x7f\x27\x23\xf1\x64\x24\x43\x30\x3c\xd2\x70\xce\x47\x32\x71\xdb\xf4\x70\x94\xaf\xea\xeb\xe4\x66\xb4\x13\xd5\x43\x85\x5a\x2c\x46\x52\xa2\xaf\x56\x07\x87\xc5\x34\x86\xe4\x62\xae\x49\x94\x0e\xe9\xec\x11\x65\x91\x4e\x15\x2a\xdd\x71\x3a\xa7\x2d\x89\x6a\x75\xc1\xae\xeb\x05\xea\xb6\x4c\x86\x24\x00\x37\xb3\xd7\x80\x06\xf7\x1a\xd6\xb7\x91\x03\x00\x2b\xcb\x1a\x54\xc1\x37\xe3\x05\x00\x4a\x56\x19\xa8\x86\x35\x59\x79\x06\x31\x65\x0f\x90\x2c\xf0\x0c\x50\x97\x14\xf9\x10\x7d\x02\xe6\xdf\x48\x2c\x9e\x7a\xfc\x6a\x15\x10\x49\x75\xc9\x4b\x66\x81\x54\x54\xd9\xdb\xe9\x0a\xc9\x30\xbc\xb4\xf4\x26\x62\xda\x61\x52\xe0\x97\xd2\x33\xa0\xa1\xa4\x41\xd5\xce\x61\x65\x49\x0b\x23\xfe\x08\x9f\x41\x2c\x7e\x2a\x40\xcb\x82\xac\x3e\x63\xfa\x0f\xe9\xec\x13\x30\xff\x59\xb4\xdf\xbf\xb8\x05\x20\xc1\x37\x6f\x19\x5e\xe2\xa0\xca\x6b\xe0\x1f\xbc\x88\x9b\x26\x29\x69\x36\x52\x83\x0b\x06\xd2\xb2\x4a\xe2\xe6\xfc\x0c\x74\x89\x81\xaa\xc0\x4b\xd0\x83\x38\x42\x93\xaa\xac\x23\x28\x80\x6f\x5e\x59\x29\x59\xd3\x64\xd1\x2d\x99\xbf\x44\x98\xd7\xa0\xe8\x67\xe8\x97\x44\x36\xc1\x24\x63\x1f\xe9\xe2\x32\xae\x88\x42\x2e\x61\x98\x26\x55\xc6\x41\x6b\xb8\xb2\x67\x90\xbc\xa6\x60\x01\xb2\x8e\xc8\x66\x2d\x3d\x83\x78\x4a\xd9\x83\x58\x54\xd9\x83\x94\xfd\x64\x83\x30\x3c\x52\x04\xf2\x80\x15\x87\x55\x11\xa6\x04\x99\x5e\x7b\x59\x42\xbc\xb4\x14\x60\xd8\x64\x45\x96\x34\x92\x97\xa0\xea\x62\xed\xe9\x63\x30\xec\xcc\xa1\x8a\xc2\x1a\x49\x09\x10\x7c\xf3\xb1\x87\x19\xc3\xff\x52\xd6\x83\x97\x3c\x4b\x6e\x79\x5a\x96\xfc\x0a\x88\xa5\x4f\x42\x70\x90\x5f\x72\x9a\x37\x6d\x0b\x55\x8d\xa7\x49\xc1\xd6\x8b\xa1\x23\xb3\x0e\xbd\xf8\x0d\x39\x10\xad\x42\x28\x21\x4e\xd6\x5c\xbc\xdb\x14\x15\x19\xf1\xa6\xc9\xa8\x50\x20\x35\x7e\x6b\x59\x0c\x00\xf2\x16\xaa\xac\x20\xef\x9e\x01\xc7\x33\x0c\x94\xbe\x7a\xdb\x93\x6d\x32\x77\x34\xa9\x2b\xdc\x38\x52\x6b\x2a\x29\xd9\x5c\x18\xcf\xac\xac\x8a\x20\x92\x42\x00\x92\x08\x86\x65\xdd\xa9\x74\x5a\x57\x11\x36\xbc\xa3\x2c\x8b\x61\x5e\xfa\xea\x53\x5b\x34\xfa\xcf\x2b\x16\x87\x05\x57\x65\x21\xac\xa8\x70\xfb\x74\x25\x4f\x82\x7b\xcd\x5f\x13\xa9\x7b\x10\x86\x3d\x75\x48\x91\xf4\x7a\xa9\xca\xba\xc4\x84\x79\x91\x5c\xc2\x67\xa0\xab\xc2\x43\x80\x21\x35\xf2\xd9\x48\x20\xd0\x76\x19\xda\x8b\xc2\xd3\x3f\x13\x34\xda\x2e\xc1\x5e\x14\x24\xf4\x1a\xc4\x9e\xf8\x99\x20\x76\xbb\x5d\x64\x97\x88\xc8\xea\x92\x88\x47\xa3\x51\x0c\x1c\x04\x2c\x2f\x08\xaf\xc1\x7f\xc6\x13\x69\x3a\x93\xca\x30\x41\x80\x07\x05\x05\x79\xff\x1a\x8c\x82\x28\xc8\x82\x6c\xf0\x9f\x09\xf8\xcf\x04\x8d\xbb\x26\xc0\xbc\x06\xdb\xa9\x48\x3c\x05\xa2\x42\x38\x09\xcc\x9f\x58\x24\x15\xc6\xff\xe2\xe6\x3f\x60\xfd\x0e\x5b\xe9\xc7\x20\x61\x22\xc0\xe4\xfe\x99\x80\x81\xc7\x0f\xc4\xc6\xba\xfa\x1b\x8a\x1d\x8f\x64\x0c\xb1\x63\x91\x14\xc0\xff\x5c\xa2\x62\x91\x81\x9d\x9e\x0c\x1b\x3f\x77\x8b\xcd\x4b\x0c\x4f\xe3\xf1\x09\x02\x02\x7f\x49\x64\xdb\x21\x9a\xf5\xe3\xc5\x42\x91\xcc\xd2\xef\x18\xc2\xaa\xd9\xaa\x53\xca\xde\x0b\x7c\xc3\xa5\x5c\xb5\xf2\x0b\x65\xb4\x93\x53\x35\xfa\x21\x96\x14\x79\xe1\xf0\x0c\xf2\x76\x2f\x0a\x7a\xaa\xfc\x04\x8a\xb2\x84\x64\x81\x44\x4f\xa0\x0d\x25\x41\x7e\x02\x6d\x59\x22\x69\xf9\x09\xb4\x74\x9a\x67\x48\x2b\x1f\x3e\x81\x16\x4f\xe1\x01\x1a\x2f\x4b\x18\x44\x7e\x02\x25\xb8\x22\x27\x3a\x18\x92\x12\xb2\x52\x0a\xbc\x86\x34\x15\x92\x22\x98\x40\x95\x74\xe7\x14\x65\x5d\xe5\xa1\x0a\x3a\x70\xf7\x04\x44\x59\x92\x91\x42\xd2\xf0\x09\x20\xa8\xf2\xec\x1d\xa2\x44\x4c\x17\x1b\xde\x92\x82\xee\x52\x87\xac\x32\x61\x4a\x85\xe4\xfa\x19\x18\xbf\xc2\xa4\x20\xdc\xe3\xdd\xbf\x7d\xda\x91\x39\xb5\x67\x97\x49\x9d\x79\xf4\xa5\x4a\x2a\xdc\x77\xf9\xd9\xb3\x6a\x3d\xf9\xfc\x4c\xd4\xc1\xef\x90\x36\x86\x25\x71\x57\xba\x29\xc6\x77\x39\x62\x83\xc9\x0b\xac\x91\x14\x92\x05\x5d\x73\x58\x33\x68\x45\xed\x37\xdc\xfb\xba\x5e\x6f\xf0\x7d\x4a\xf3\xaa\x45\x90\x49\x3c\x82\x0a\xe3\xae\x45\x20\x0f\xff\x23\x1c\x00\x70\x0c\x1b\x13\x82\x67\x90\xcb\xe5\x72\x5f\xaf\xb7\x5d\xd6\xf8\x73\x69\xdc\xe1\x1d\xd8\x59\xe3\x40\x73\x80\x18\x4f\xdd\x25\x69\x44\x51\xe5\xa5\x0a\x11\x02\xdf\xbc\xd5\x69\x2a\x95\xd4\x35\xf9\xab\x37\xc3\x72\x10\xee\x1c\x4b\xde\xd4\xb9\xb8\x89\x33\x3f\x82\x38\x79\x17\x16\x65\x15\x86\x29\x5d\xd3\x64\xc9\x4f\xf7\x6c\x74\xfb\x91\x65\xff\x72\xea\xb8\xdb\x32\x43\x0a\xd7\xbb\xf3\x0b\xd5\x62\xf7\xdb\x8a\xcc\x9f\x0f\x0b\x31\x1e\xa7\xb1\x73\x11\x24\xab\x5e\xbf\x77\xb1\x30\x00\x3b\x8e\xd7\x60\xd8\x70\x25\xcf\x40\x92\x77\x2a\xa9\xd8\x78\x01\x78\x21\x8c\x09\xc2\xdb\x97\x17\x02\x3b\x0f\x3c\xe9\xa6\x64\xe6\x80\x27\x08\x2f\x12\xb9\x05\xb4\x40\x22\xf4\x1a\x90\xc8\x2d\x45\xaa\xc0\xfc\x15\x86\x7b\x85\x94\x98\xb0\xc8\xd8\x09\x0c\xa9\xae\x01\xb5\x34\x7e\x5b\x93\x8b\x17\xd2\x5b\x36\x4c\xa9\xa4\xc4\xd8\xb3\xa9\x5f\x02\x6f\xf9\xfe\x38\x3f\xea\x76\xca\x2f\x04\x69\x95\xb0\x2a\xc0\x5b\x4c\x93\x97\x4b\x01\xaa\x01\x6b\x0a\x63\xc2\x04\x00\x1e\x25\x58\x79\xaf\x01\x5a\x16\x04\x52\x41\xd0\x4e\x26\xd5\x25\x0e\x13\xfc\x62\x52\x6e\x43\x49\x0f\x58\xba\x20\x55\x9e\xb4\xfb\x66\xe4\x85\x30\xf3\x4c\xd1\x20\xf3\x1a\x60\x49\x01\x63\x34\x52\x05\x92\xc2\xb3\xc2\x91\x41\x0f\x0b\xcd\x2f\x0d\x1f\x6f\xc9\x0a\xc0\x0b\x52\xc8\x2b\x9c\x1b\xbd\x7f\xe0\xed\x85\xc0\x20\x96\xa4\x84\x29\xc6\x9b\x59\xb1\x2f\x0c\xef\x28\xda\x16\xc5\xd6\xec\x49\x34\x9e\xb1\x31\x1b\x02\x39\x94\x75\xc1\x47\x17\x57\x9b\xa8\x86\x71\x83\x70\xf8\x33\xa6\xed\x2e\x38\x73\x66\xc1\xa8\xb2\xc2\xc8\x3b\xc9\x05\xe6\xab\xb8\xb0\x31\xd9\xb7\xe1\x2c\x91\x4e\x95\x68\x30\x65\x98\x65\xc9\x46\x05\x54\x59\xb8\x56\x4f\x0e\x3d\x17\x39\xab\x4e\x38\x12\x29\xb2\xa2\x2b\xaf\x01\x4d\xd5\xe1\x95\xca\x70\xb3\x09\x40\x0f\xd3\x75\xa5\x38\x86\x04\x80\x5f\xab\x8e\x00\xe2\xa9\xa6\x8d\x3a\x15\x20\x43\x1d\xfc\x22\x78\xc9\xbc\x90\x67\x58\xb0\xf2\x1c\x25\x10\x46\x61\xc2\xec\x42\x03\x6f\x43\xe3\xb7\xc9\x9c\x8f\xa3\xbb\x71\x51\x87\x30\xe2\x45\x5e\x20\x71\xec\x23\xf0\x56\x38\x80\xa1\xf3\xfa\x03\x38\x39\x19\x69\xc8\x40\x57\xc3\x4f\x3f\x80\xc9\x9a\x8e\x19\xb8\x2a\xe6\xf3\x67\xb1\x19\x2e\x2c\xf0\x36\xc2\xbf\x7c\x38\x5e\x08\x86\xdf\x9e\x12\x5e\x08\x81\xbf\x69\xcf\x9e\x8a\x3b\x37\x63\x3f\x65\xa3\x03\x0a\xbc\x55\xf1\x2f\x0f\x65\x37\xa1\x17\x42\x17\xde\xbe\x78\xb8\x79\x21\x24\x72\x6b\x34\xdd\x17\x91\xe4\x25\xcb\xe0\xf1\x63\xc0\x26\xe9\x0c\x6b\xcc\x66\x4b\x2a\x8a\xc5\xdb\x8b\x2a\xeb\x1a\x1e\xa1\xf1\x70\xf7\xf6\x42\xb8\xdf\x30\x3e\x02\x63\x31\x51\x5b\xb1\x0d\x5c\xdc\x7c\xb4\x31\x28\x36\x11\xa3\xe3\x15\x75\x0d\x32\x27\x67\xea\x8d\x01\x82\xdf\x44\x9e\x61\x64\xed\x2b\x10\x49\x06\x82\x1d\xaf\x71\xa6\xa7\x72\x44\x35\x9c\x3f\xe6\x17\x8f\xca\x55\xc8\x7c\x35\x06\xc1\x3b\x73\x70\x40\xc9\x02\x13\x78\xfb\x8d\x83\xa4\xaa\xa1\xaf\x96\x03\x03\xd4\x01\x57\xad\x37\x28\xe6\x0e\x5a\xe2\x20\x5f\x00\xd8\x3e\xf8\x4f\x4a\x20\xa5\x75\xe0\xcd\x0a\x7e\x3a\x84\x9d\x20\x28\xd6\x3c\x20\x25\xe6\x1c\x29\x0e\x8a\xda\x51\x51\xc4\x41\x41\x40\x09\xfa\xcf\x73\xcc\x3d\x8e\x14\xc1\xf0\x00\xda\xbc\xc4\x61\x64\x2f\x84\x62\x6b\xea\xed\x0c\x27\x9e\x34\x52\xfa\x41\x84\x24\x2d\xb3\x2c\x84\x67\x21\xd7\x73\xfc\x2f\xbc\xb8\x74\xd8\x06\x00\xa9\xf4\xab\x7b\xb2\xa6\x48\xcb\xaf\x14\x89\x60\x3a\xf9\xc4\x4f\x0a\xdd\xc1\x2e\xda\xac\x2e\xe5\x7c\x3e\x9f\xef\x0c\xc7\x5c\x79\xbc\xcc\xe7\xf3\x4d\xe3\x5d\x28\xe6\xe7\xf9\x7c\xbe\x34\x5c\xd7\x9a\x3d\x9c\x50\x9d\x0d\x2a\xd3\xda\x60\x44\xc5\x17\x51\x26\x5e\x39\x2c\xfa\x85\xc2\xa2\x9a\xe3\x17\xc3\x42\x83\x9a\x56\xa4\xc5\xa4\x21\xcc\xa7\x83\x14\x4d\x0b\x02\x2e\x50\xec\x16\x1a\x83\x72\x65\x0c\x3b\x2a\x9a\xb5\x73\xbd\x49\x99\xa6\xa5\x58\x74\xd2\xa8\xc6\x27\xfb\xd2\x48\x1b\x8e\xd8\xb2\x52\x67\xaa\x53\x98\xaa\x26\x99\x66\xb4\x41\x94\xd9\x4d\xa7\x34\x6f\x87\x9a\x31\x92\x2e\x12\xf9\xf2\x61\xdb\xd8\x14\x6b\x39\xb1\x5e\x94\x34\xa5\xb4\xce\x4e\x76\xa4\xa4\x2c\x57\xd1\x58\x3b\x9f\x9e\xc7\x7b\x73\xb1\xae\x20\xd4\x6c\x2b\x89\xde\xae\xcb\xee\x13\xd3\x1a\x8c\x13\x30\xae\x67\x35\x55\x1c\x67\x0f\xd3\x19\x05\x89\xde\xaa\xcb\x64\x32\x47\x62\x34\xed\xb5\x86\xcb\x9e\xd6\x21\x57\xa9\x4d\x17\xe5\x97\xcd\x6e\x41\x9b\x14\x65\x2a\x2f\x37\x77\x9b\xee\x32\x9f\xa6\x56\x47\x61\x34\x94\x2b\xb3\xfc\x18\xb6\x3b\x93\x5e\x75\x45\xe7\xf5\x4e\x9f\xdf\x94\x99\xe6\x9e\x1d\x96\x3b\xc5\xf6\x72\x54\x6f\x1e\x8f\x05\xb2\xd2\x68\x26\xcb\x52\x7e\x24\x55\x8a\xf9\x49\xac\xb3\x58\x65\x96\xa5\x43\x26\x4f\xcf\x72\xbb\xe2\xba\x4e\x8e\x8b\x70\x3c\x52\x17\x07\xb8\x0a\xc5\xa9\x8e\xa4\x6d\x46\x05\xae\x8f\x66\x54\x7e\x5d\xcf\x76\x2b\xeb\xc6\x0e\x12\x0c\xd4\xa7\x71\x6d\x35\x1f\xf7\x12\x39\x82\x16\xd2\xec\x34\xd6\x99\x51\x5a\x7c\xc4\xc4\x09\x16\x07\x0b\xd2\x71\x61\x4b\x13\xa3\x5d\xbc\x9a\x58\xad\xba\xed\xf4\x82\x98\xd6\xc6\xc5\xd8\x54\x9b\x4a\x23\x25\x31\x1c\x2c\x79\x4a\x5b\x8f\x29\x2a\xb7\xd5\x26\x64\x82\x68\x16\x50\x4f\x17\x08\x35\x24\xcb\xdd\x6e\x2b\x25\xeb\xd1\x05\x33\x15\x94\xe1\x28\x95\xcc\x8e\xe9\x6d\xeb\x90\x23\xc7\xbd\xc4\x31\xd9\xae\x8c\x09\xb2\x13\xcd\x30\xa1\xb4\x7c\x48\xd1\xdb\x69\x28\x9a\xee\x55\x77\xd1\x74\xaf\xcd\x29\xb3\x79\x22\xc7\xa9\xcb\xcc\xae\xcc\x74\xca\x68\x47\xc0\x68\x81\xab\x0d\x42\xac\x90\xec\x94\xf2\x07\x39\x1b\x62\x7b\xd3\x6c\xa5\xb3\x8c\xea\xb3\x96\xb0\x4e\xe4\x67\xd1\x42\x33\xbd\x64\x8f\xbc\x14\x9b\x0b\x4d\x45\x1a\x4d\x85\x23\x8a\x97\x13\xfd\x4d\x31\xae\xcf\xfb\xea\x64\x30\x9c\xa4\x73\x90\x22\xa5\x6d\x46\xcf\xe8\xbb\x05\x9b\x18\x2c\xb3\xd1\xf4\x92\x59\x21\x36\xa9\xf1\xdc\x0c\x2d\x5b\xf3\x22\x8f\xba\x49\xba\xce\x24\x8b\x89\xd4\x51\x4a\xb4\xb7\x9b\x8a\x46\x4d\xe3\x4a\x06\xc6\xd0\xa4\xb8\x9c\x4d\x62\x39\x28\x8d\x94\x5d\x72\x0e\x35\x4e\xdb\x94\x27\x9b\x4c\x56\xdf\x6c\x5b\x15\x72\x2b\x17\x88\xe3\x42\xef\x67\xc7\xbb\x39\xc9\xac\xf7\xc9\x65\xbf\x9e\x2e\x95\x43\x3d\x3e\x19\x63\x36\x2b\x39\xdd\x9d\x22\x7a\xd4\x11\x8f\xec\x24\xde\xe1\xe6\xeb\xd6\x82\x58\xd2\x52\x63\x48\xe9\x33\x3a\xd1\x39\x96\xa8\x1d\x5d\xe5\x36\x87\x6d\x89\xd4\xe7\x99\x64\x45\x9b\xa4\xb7\x9b\xd8\x46\x53\x64\xb5\x22\x6b\xd3\x7c\xf7\x88\x32\xe3\xe9\xb0\x17\x8d\xd1\xba\x10\x9b\xa5\xa2\x89\x64\x2c\x37\x19\x57\xfb\xb3\x78\x68\x92\x9b\x87\xaa\x28\xbd\xae\x0d\x45\x9a\x4f\xea\x2d\x2e\xb1\x17\x7a\x2d\x2d\x17\x4a\x90\x7d\xbd\xb0\x28\x1c\x87\xeb\x42\x69\x88\x26\x7d\x95\xe9\x53\xcd\xd9\x28\x9e\x61\xb6\x19\x08\x17\xed\x38\x33\xa6\xe2\xa1\x6d\x6f\x22\x6d\x13\x6a\xbc\x25\xad\x3b\xfd\x18\x91\x69\x77\x9b\xab\xc1\xa6\x33\x93\xe2\x74\xb4\x51\xcd\x33\xed\x51\x34\xa4\x0e\x37\x53\x7e\x22\x30\x33\x39\xd7\x21\x32\xb9\x74\xae\x5e\x8d\x69\xe5\xca\x30\xd5\xd8\x8f\x86\x94\xa2\xe6\x84\xe5\x34\xa6\xa4\xd9\x1a\xab\xa6\x42\x04\x23\x37\x5b\xf4\x8e\x18\x8d\xb2\xbb\x6e\x89\x4f\x6a\x59\x3e\x54\xaa\x65\x56\x8a\x58\x6b\xeb\xa2\x1c\x0d\xed\xd7\xbb\xce\x68\x22\x74\x46\xe5\x79\xb7\x54\xde\x47\xe9\xd2\x98\x12\x93\xa8\x43\x89\x6a\x62\x96\x20\x79\x9a\xd0\x13\x6a\x94\x2a\x2c\xaa\x4c\xb6\xd4\x91\x16\x71\x56\xab\x95\xa5\xec\xae\xd4\x4e\x64\x7b\xb3\x81\xd4\x1d\xb2\x6d\x6e\x55\x9d\x55\xfa\xcb\x42\x71\x07\xd3\x42\xa2\x25\xec\x37\x5a\xaa\x52\xed\xe8\x0c\xb3\x4d\xa8\xc7\x41\x3a\xb4\x55\xe3\x5c\x51\x5a\x51\x85\xea\x31\x96\x0e\xb1\x4d\x41\x5a\x88\xd4\x72\xdb\x5d\x35\xe5\x4c\x53\x67\x9b\xc4\x50\x98\x86\xc6\x99\x69\x2f\x5b\x1f\x69\xd5\xea\x26\xcf\x84\x38\x5e\xec\x30\x7d\x8a\x8e\x13\xea\x8a\xc9\x6d\xb6\x7b\xad\x43\x66\x42\x2b\x69\x55\x20\x13\xb9\xf9\xa2\x34\x3d\xd6\x76\x33\x7a\x5c\x49\x17\xa4\xf9\xb4\x56\xe8\x1e\x89\xf4\x5c\x4c\xaf\x8e\xd3\x68\x66\x55\x67\xf8\x44\xb1\x98\x43\x6a\x7d\xd8\x9b\xd2\xb9\x50\xb7\xd9\x3d\x4e\x69\xb9\x5a\x64\x14\x15\xce\x97\x03\x31\xbe\xef\xa8\xa3\x5a\xaf\x2c\xe4\xf4\x72\xe6\x50\x1c\xf5\x07\xc9\xba\xbe\x2e\xed\x66\xda\x61\x46\x4c\x0f\x6c\x22\x2f\x35\x97\xa5\xd6\x58\x38\x2e\xfb\x90\x3e\xc4\xf8\x24\xb7\x92\xf8\x50\x43\x2c\x6b\x3c\x9b\xdd\x8d\xb8\xc6\xa4\x88\x04\x95\x2c\x0c\xf3\xed\xf2\x92\xc8\x47\xc5\xa1\x48\x72\xa3\x55\x73\xb6\x5c\xa2\x2a\x5a\x26\xe4\x14\x5d\x39\x14\x26\x69\xbd\x31\x15\x42\x54\x7d\x93\x29\xc8\x3b\xa1\x30\xd7\x2b\x62\x92\x8e\x21\x2e\x54\xd9\x33\xb1\x6c\x91\xc9\xcd\xe9\x75\x34\x34\x2e\x17\xb2\xbd\x62\x4d\xdb\x2e\x1b\xa1\x43\x97\x1e\xa6\x9a\xe3\x6c\x2e\x5f\x48\xf1\xa5\xc9\x7e\x36\xe2\xeb\x34\x77\xd0\xcb\x89\x81\x30\xa0\x6a\x8c\xb2\xa4\x42\xcd\x69\x3e\x3e\x85\x51\x96\xeb\xf4\x2b\x3d\x7e\xd1\x1e\xaa\x6d\x75\x92\x0a\xb1\xdd\x55\xfd\x30\xdf\xc6\xc6\xe4\xac\x0e\x7b\xb5\x65\x5f\x9c\x30\x62\xa3\x3b\x48\x1c\xf3\x9d\xf4\x9a\x45\x95\x75\x49\xec\xcb\x75\xa2\xd5\xa1\x84\x65\xb4\x0c\x47\xfc\x36\x35\x2f\xe4\x16\xf9\xce\xae\x70\xac\x36\xab\xed\xfd\xa6\xa4\x70\x79\xa1\xdc\xcb\xf4\x63\x55\x7e\xb1\x67\x47\x45\x49\x29\xac\x07\xdd\x1a\xd7\x6a\xb4\x84\x66\xa7\xd5\xa9\xf2\xad\xe3\xa2\xac\x35\xda\x71\x94\x27\x92\xbd\xda\x6a\x1f\x2b\x67\x98\x03\x51\x9f\x65\x20\xdc\xb6\x17\x74\xa9\x5a\x1a\x70\x62\x9b\xa3\x96\x25\x6d\xab\x26\x99\x6c\xac\x4a\xe5\x07\x68\x9e\x4a\xb5\x63\xe5\xcc\x12\x8d\xd4\x0d\x9d\x4f\x74\x8b\xd1\x21\xb7\xac\x34\xf8\x42\x69\xbe\x20\x06\xfa\xe2\xd0\x3f\xf0\x73\xa2\x9c\xe4\x96\xd5\xac\x46\x0c\x63\x3a\xd3\x91\x51\x21\x3f\x29\x6a\x3c\xad\x65\x74\xb2\x5f\x10\x77\xcb\xce\xb1\xa7\xf7\xdb\xab\xce\x40\xa9\x86\x16\xdc\x5e\xcb\x35\xc6\xfb\x56\x22\x96\x20\x96\xb1\xd0\xb2\xc6\x26\x4b\x7a\x99\xa3\x18\xb8\x9d\x1d\xb3\xe3\x4e\x6b\x1d\xdd\xb3\x62\x2a\x55\xaa\x55\x95\x4c\xa8\xb3\xdd\x1c\x6b\xf1\xd2\x31\xb9\x46\x59\x26\x37\xa9\x52\x79\x52\xce\x1d\x98\x50\x33\x9f\xdd\x35\x42\xb9\x99\xca\x50\xf1\x94\xce\x48\x4b\x22\xb3\x59\x56\xd9\x56\x67\xc0\xe6\x7a\xe2\x2a\x5e\x6c\xc8\xab\xdc\xac\xd5\x96\xf7\x29\x4a\x9b\x37\x53\x8c\x94\x2b\x48\x4b\x71\xc2\xc6\x72\xc4\xaa\x56\x1a\x09\xd1\xcd\x68\x34\x4b\xce\x17\x02\x4c\xf5\xa4\x22\x5a\xc5\x92\xfd\x50\xbb\x25\xea\xd3\x50\xe3\xd8\xc8\xf1\x6c\x43\x59\xea\x4b\x69\x50\x48\x4a\xfb\x41\x94\xd7\x52\x0d\x3a\x9a\x09\xd1\xb1\x10\xb5\x8a\xc9\x8d\x42\x68\x3f\x88\x32\x62\x88\x5b\x0f\x74\xa1\xc2\x4e\xe5\x44\x73\x42\xc4\xfb\x9b\xe8\x24\x54\x51\x88\x0e\xdd\xa3\x50\x9c\xa4\x94\x66\x5c\xd9\x90\x5c\x3b\x4f\x67\x04\x52\x9c\xc6\xe4\x82\x28\x40\x79\x2c\xf6\xd3\x65\x6a\x5f\x1f\x27\xa9\xfe\x64\xdb\xe8\x92\x7c\x2e\x5e\x26\x49\xa6\x53\xac\x1f\x0a\x7c\x83\xe1\x08\x62\x58\x21\x4a\x1d\xaa\xbd\xdb\x4e\xc5\x63\xad\x98\xea\x89\xc5\x31\x27\xcd\x56\xdd\x2e\x39\xac\xa0\x3d\x9d\x2a\x09\xf1\xf9\x3a\x4e\xb2\x2c\x55\xd1\x63\xa9\x58\xa1\xc7\xcc\xbb\xb9\x5d\x9a\x9d\x16\x59\x66\x75\xe8\x8d\x36\xf5\x9d\xd8\x8e\x32\xf1\x50\xb6\xdc\x99\xd7\x07\xe3\x58\x5c\x8e\x85\xf6\xeb\x1a\x59\xaa\x25\x98\x52\xbb\x2e\xaf\x7b\x5b\x49\xca\x2f\x96\xa3\x7a\x7e\x9d\x2b\xcb\x23\x75\x4d\xd5\xca\x15\x8a\x1e\x1c\x16\xd5\x69\x69\xda\xef\x2f\x1a\x63\x5d\xeb\x97\x33\x7a\x81\x67\x0f\x5d\xc4\xac\x67\x52\x6a\x45\xa5\x16\x71\xba\x9f\x6b\xb5\x3a\xb3\x72\xb6\x4a\x0e\x77\x47\x2e\xd6\x52\x85\xdc\x66\x78\x14\x75\x31\xb9\xce\xcf\x72\xfb\xe5\x4a\x3d\x0c\xa7\xfd\x5e\xb6\x35\xec\xa4\xbb\x24\xd5\x4e\x29\xc5\xb8\x52\x2e\xee\x92\xb1\x2a\x91\x68\xe7\xd1\xbc\x38\x84\x85\x69\x1f\x56\xe4\x5d\xa7\x10\x6f\xcb\xdb\x42\x7f\xd3\xae\xa7\xda\x8b\xea\x68\x33\xd8\x54\x43\x3b\x69\x38\x51\xab\x3d\xf2\x30\x65\x0f\x6c\x6d\xb0\x8f\xc6\xfb\x99\x5c\x83\x3d\xa2\x65\x62\xd3\x5d\xe4\xd4\xb2\xde\x93\x95\x6a\x69\x37\x6f\x09\x7a\x11\x6a\xca\x61\x25\x76\x6b\xf9\x50\x71\x98\x81\x05\x6a\x5c\xdd\xea\x04\x99\xcc\xd4\xe7\xf4\x68\x9f\x6c\x0a\x39\x3a\xbb\x2a\xf0\x54\x32\xb3\x6c\x2a\xba\x5e\x1c\xf2\xd4\x60\x12\x8d\x8d\xa2\x1d\x72\xb6\x8f\xee\x56\x9b\x56\xba\x98\x9d\x15\x96\x4a\x87\x1c\x1d\x63\x87\xce\x70\x4a\x96\xa8\xed\xaa\xd9\xdb\x54\xe2\x85\x79\xb5\xb6\xeb\xcd\x56\xa8\x90\x19\x0f\x87\x09\x95\x5a\x35\x89\x64\xac\xab\xef\x42\xcc\x48\x5f\x09\xa4\x94\x5b\xf4\xb2\x5a\x27\xc7\xf6\xca\xb9\xf5\x51\x18\x0b\x19\x66\xce\xee\x77\xdb\x14\xab\xf6\x8f\xda\xf4\xa0\x54\x50\x73\x9b\xda\xc2\xee\xaa\x51\x28\x0c\x2b\xf1\x72\x3a\x3d\xce\xf5\x86\x65\x9e\xcf\xb1\x62\x36\x9e\x82\xc5\xfc\x72\x3a\x89\xb6\x8b\x85\xc1\x51\x66\x96\x28\xd6\x12\x52\xd3\xea\xae\x59\x2d\x13\x9d\xfe\x32\xaa\x1f\xa7\x99\x61\x41\xea\x1c\xd9\x09\x99\xe7\x59\x46\x4c\x36\x96\xd9\x5d\x77\xa5\x36\x10\xbf\x27\xd4\x25\xdd\xd6\xd4\x96\x36\xad\x75\xc4\x82\xa6\xd2\x7c\x76\x38\x2b\xd1\xf5\x5c\x4f\x9a\x0e\x35\x58\x4b\x69\x71\xa9\xd0\x2b\xb6\xfb\x3c\xd7\xe9\x0e\x73\x93\x4d\x79\x2a\x2c\x14\x96\x4c\xa8\xe3\x25\xd9\xe9\x34\xe5\x4e\x34\xd4\x67\x63\xda\x14\xea\xec\x56\xeb\xa5\xd5\x34\xec\x44\xd9\x50\x62\xb0\xe5\x42\x13\xa2\x26\x2c\xb2\xdd\x7c\x2b\xd3\x64\x51\x39\x53\x60\xe2\xd5\x41\x63\xa4\x68\x0b\x2a\x89\x1a\x6a\x81\x5a\x77\xaa\xb9\x63\xbe\x50\xef\xa5\xa2\xc5\x66\x31\xbb\x8f\x76\x52\x89\x50\xa5\xca\x32\xf5\xed\x74\x3b\x62\xb3\x6c\x42\x58\xef\xd6\xf3\x51\x79\x91\x0a\xcd\xd2\x62\xaf\x75\x5c\x54\x89\xec\x2c\xb4\x24\x98\xe6\x6c\x7a\xa0\x0e\x3d\xa8\xf0\x0b\x99\x38\x64\x69\x22\xc7\xd7\x78\x81\x2b\xc7\xe4\x6d\xa3\xbb\x95\xf3\x03\xe1\xb8\xed\x94\x73\xfb\x56\x61\x3a\xd7\x61\xab\x5a\xa8\x6f\xbb\xd1\xe1\x82\x5e\xcd\x66\x51\x65\x3f\xdf\x16\x8e\xbb\x84\xc0\xe9\x22\x3b\xab\x0a\x73\xb9\x1c\x4b\xe5\x8a\x0b\xb4\x97\xf5\x9c\x10\xab\x1d\x50\xb5\x9a\x1d\x4d\x9b\x69\xbe\x2b\x92\x13\x31\x35\x24\xd6\xd9\x24\xaf\xb1\xe9\x2e\xaf\xcb\xb3\x6c\xaa\x1a\x57\x07\x05\x99\x98\xaf\x8b\xd5\xb2\xd6\x4b\xb6\x9a\xe2\x61\xd5\x5f\xa2\x04\x97\xa1\x63\x44\x1f\xea\xb1\xea\xf1\x40\xeb\xe5\x4a\xe9\xa8\xf5\x3a\xed\x64\x67\xd6\xeb\x8c\x98\x64\x39\x57\x23\x62\x71\xb2\x21\xf5\x42\x5c\x5a\xde\x48\x73\xad\xd1\xdb\x86\x64\x7a\xd3\x8d\xcd\xd4\x58\xba\xc2\x94\xf9\x4c\xb6\xd9\xab\x27\x8a\x85\xfc\xb4\x3a\xae\xec\x89\xa4\xba\x5b\xd7\x1b\xd9\x4d\xa7\x7a\xa4\xf9\x24\x4c\x54\x13\xdc\xb8\x3f\x6a\x48\xbd\xcd\x38\xd5\x59\xe6\x63\x5b\x46\x0f\xf5\xca\x21\x21\x43\x93\x2d\x6a\x97\xa7\x96\xa9\x01\xa9\x4c\xd8\x7c\x71\xd8\x62\xd8\x32\x4a\xb6\x76\x79\x6d\x33\xa2\x52\x68\xc7\xc1\x7c\xa8\x90\x2c\x50\xca\x26\x2d\x4f\xca\xad\xd0\x91\x50\x50\x3a\x5f\x94\x45\xad\x38\x5b\x4a\x87\x05\x3c\xae\x56\xad\xe5\x4c\x19\xd6\xf2\x09\x38\xe8\x84\x1a\xd5\xe8\xb2\x47\x94\xe1\xb4\xbc\xeb\x0c\x52\xc9\xf2\xa2\xb0\x5a\x55\xb4\x42\x82\xcd\x4d\x12\x87\x22\xca\x53\xeb\xf1\x18\x71\x52\xa8\x2a\x45\x97\x9d\x03\x09\x0f\x93\x50\x75\x1b\x65\xf3\xfd\x79\x7e\xb5\xac\x51\x68\x1c\x1f\x72\xb1\x7e\x3e\x9f\xcf\xe7\x87\xe3\x49\x77\xd0\x4c\x15\xe7\xf5\xfa\x6b\xc0\x35\xf5\x20\x05\xed\x35\x50\xd0\x0f\xa0\x0d\x41\x1e\x14\x8d\x09\x4c\xc0\x9e\xc2\xd9\x11\x4e\x1c\xf6\x71\x2f\x7c\x5b\x41\x46\x7f\x72\xe0\xcd\x35\x57\x7a\x21\xcc\x29\xa6\x39\xf3\x34\x37\xbb\x98\x13\x1d\x7b\xde\x44\xcb\x0c\x8c\xac\x36\x3a\x54\x0f\xc6\x94\xc9\x7c\x0c\x27\xf0\x0e\x8e\x08\x12\x78\xd1\xd8\xe4\xb0\xba\xba\xc7\x61\x93\xe5\x89\x59\x28\x97\x4e\x95\x8e\xdd\xa8\x3a\xca\x90\x54\x33\x19\x6b\x0c\xb5\x7e\x3d\xbf\x99\x2c\x07\x93\xa3\x42\x1d\xe5\x14\x12\x67\x4d\x25\x39\x67\x07\xdb\x5a\x28\x4b\x52\xda\xa8\x1c\xeb\xf1\xe9\x15\x7f\x94\x4d\xbc\xd7\xf6\x39\xbc\x10\x26\xcf\x6f\x57\xd9\x67\xa4\x15\x8a\xd0\x82\xac\x33\xac\x40\xaa\xe6\xb4\x8f\x5c\x91\x7b\x42\xe0\x29\x44\x28\xb2\xa2\x40\x35\xb2\x42\x44\x2c\x12\xc3\x5b\x37\x74\x91\xb1\x13\x6f\xcb\x35\xee\xc6\xe1\x28\x5a\x54\x6a\x1b\x66\xd8\xe8\xa7\xb9\x86\x76\x48\x35\x27\x0a\xa7\xf5\xb8\xe3\x74\x95\x9b\x76\x63\xb4\x50\x1b\xb5\xab\x64\xa2\x51\x5a\xec\x54\xa9\xbf\x49\xa2\x4a\x36\xcd\xd4\x6b\x9d\xd2\x31\x3a\x8d\xfd\xa0\x5c\xdf\xb1\xcd\x66\xe5\xdf\x65\x73\x5d\xa8\xc6\x6a\x28\x4e\x96\x07\x26\xaa\x24\x94\x59\x21\xa6\x0e\x78\x6a\x31\xce\xcf\xe5\x7a\xfd\x90\xee\xaa\xfd\xf4\x44\x5d\xd5\xcb\x64\x85\x25\xa4\x46\xf5\x58\xdf\x57\x4a\x88\x4d\xee\xa3\xfb\x7a\x3b\x54\x88\x66\x56\x83\xf6\x8f\x57\xd6\xf9\x0e\x1b\x63\x9f\x06\xa2\x65\x15\xfe\x3b\x16\xc9\x45\x62\xae\x84\xf0\x6d\x69\x52\xa5\xe9\x51\xcd\x0d\x93\xe4\x72\x33\x4c\x4c\x9b\xdb\x9e\xca\x55\x9a\x0d\x72\xa9\xcc\x0f\xb5\x6e\x01\xb1\x09\xa2\xb4\xd7\x4b\xcd\xee\xe0\xb0\x29\x6e\xe3\x68\x0e\xd5\x1c\x4d\x94\xf7\x0c\xd7\xeb\xb6\xb2\xc5\x2a\xf7\x1d\xd2\xfc\x23\x1c\x06\x25\xb8\x85\x82\xac\x88\x50\xd2\xc0\xd6\x0c\xc4\x00\x99\x05\x13\xdd\x8a\xbf\x70\x50\x50\x58\x5d\xc0\xdb\xb0\xf0\x8a\x21\x10\xe4\xe5\x92\x97\x96\xdf\xa5\x8c\xad\x0e\xff\x1d\x8f\xa4\x23\xb1\xa8\xb5\xc9\x48\x87\x37\x14\x90\xd3\x73\xc2\x91\x22\x38\x35\x0b\x63\xc9\x6a\xab\x06\x53\xa3\x72\x57\x1d\xf1\xb5\x44\x5f\xdb\xa5\x4a\xb3\xf8\x62\x97\x9b\x11\xcb\x0c\xbd\x59\x65\x63\xd3\x78\x9b\x2e\xb7\xf7\xa9\x62\xb3\x8b\x8e\x7b\x86\xca\xae\x96\x77\x2a\x00\x84\xc3\x6f\x3f\x2c\xc5\xed\xaa\xcc\x6a\x21\xb2\x25\xe8\xe3\x89\x24\xa5\x86\xbd\x5e\x95\xe8\x50\x70\x51\xac\xa5\x47\xd3\xfa\x96\x9c\xd5\x45\x62\x59\xa2\x74\x6d\xb0\xd5\xca\xb0\x2c\x1c\xf7\xfb\x29\xb9\xe8\x84\xaa\xc4\xa2\x5e\x66\xea\x04\x1b\x3a\xfc\xbc\xaa\x1c\x18\x81\xbb\x9f\x5a\xa3\x61\x33\x18\xf8\xef\x44\x24\x1a\x49\x3b\x1a\xb1\x52\x6f\x28\x65\x34\x28\x94\xb7\x9d\xf9\x80\x95\x76\x2b\x66\x77\x20\xb8\xf1\xa4\xcc\x4f\xfb\x5d\x81\x8a\x32\xbd\xce\x81\x0f\x15\xa3\x44\x57\x5f\x74\xe7\xc7\x56\x6f\x9b\xeb\x65\xda\x71\x6d\x11\x5f\x6d\x9a\xb0\x3b\x0b\xad\x95\x61\xe2\x2f\xac\xde\xdb\x22\xdd\xae\x6b\xd8\x19\x56\xb7\xf3\x3c\x25\x8f\x09\xc4\x76\x93\x4c\x75\x1b\xdb\x64\x8b\xa9\xac\xa8\x76\x1a\x28\x97\xd0\x0b\xf2\x41\x22\x26\xfd\xd4\x30\x1b\x6a\x16\x88\xd9\x46\xe4\x65\xba\x5c\xca\xaf\x97\x0c\x59\xac\x76\xdb\xa3\xef\xa8\xeb\xfb\x45\xfa\x70\x9b\xdf\x75\x79\x64\x72\xdd\xac\xcc\xa6\x9a\xbe\xa2\x1a\xb3\xcc\xae\xba\xa8\xc5\xeb\x89\x63\xac\x3d\xdb\x64\xd7\x74\x74\xb0\x61\xdb\xd2\xa1\x52\x98\xd3\x5a\xa1\xd0\x26\x62\xd5\x94\x9a\x5b\x28\xad\x6a\x06\x22\x98\x66\x47\x8c\x9e\xbc\x57\x1e\x97\x40\xae\x4d\x7f\xfb\xb0\x06\x45\x45\x20\x35\x6b\x21\x09\x47\xc0\x8b\xd6\xa6\x8d\x91\x9d\xf3\xf6\xe5\x7c\xe5\x04\x03\xba\x16\x23\xc2\xb4\xa0\x23\x0d\xaa\xc0\xde\xf1\x01\x90\xc0\x33\x30\x00\x9e\x71\xa0\x3a\x68\xa7\xfe\x19\x04\x21\xc0\x33\xd6\xf2\x0f\x56\x86\xba\x25\x85\xf3\x65\x9c\x17\xd9\x59\xbc\xb2\x8b\xba\xb6\x90\xb8\x00\xcd\x78\xff\xb3\x67\x79\x2f\xf8\xcb\x19\xb9\x6d\x98\x95\xd5\xd7\xc0\x03\xe6\xba\xaa\xca\xba\x82\xb7\xfb\x32\x70\xff\x08\x78\x09\xe0\x44\x54\x97\x8c\x74\x14\xb0\x90\x19\xec\x87\x35\xf9\x35\x60\x00\x06\xc0\xb3\xc5\xcf\x37\x10\x24\x69\xbc\xcd\x2b\x88\xb7\xc5\x31\x70\x0f\x5e\x5f\x5f\x41\x14\xbc\x07\xde\xdc\xeb\x03\x38\x68\x2f\x5b\x2b\x04\x7e\xdd\xb9\x44\x92\x9c\xf8\xfd\x2d\x30\xbc\x86\xf1\x7d\x32\x7c\xcc\xac\x8b\x28\x0e\x89\x3b\x5b\x09\x2d\x32\x98\x8a\x8d\xd8\xc0\x1a\x00\xdb\x30\xc5\x4b\xcc\x33\x4e\x31\xeb\xdf\x49\x5a\x43\x6b\xad\x2c\xa2\xeb\x3c\x83\x15\xe1\xe0\xf3\x08\x67\xae\xdb\x5c\x5c\x8c\x71\x84\xb5\x16\x61\x8d\x8d\x66\x01\xf0\x6c\x86\xfe\x2f\x54\xe9\x85\xe5\x44\xa3\xce\x5e\x03\x46\x49\x9f\x7c\xee\x65\xd8\x8b\xa4\xc2\x78\xad\xca\x5a\x01\x34\xb7\xeb\x59\x2b\x8e\x9e\x05\x5a\x00\x2e\x2c\xeb\x22\x35\x2c\x4b\xc2\x21\xf0\xd6\x53\xe1\x96\x97\x75\x74\x5e\xc2\xbf\x80\x75\x5d\x6c\x09\xee\xb5\xcf\x89\x6d\x94\xbc\xc1\xe6\x45\x52\x3f\x43\xec\x0e\xdc\x6b\x1f\x88\xec\x5f\xb1\xe3\x54\x40\xbc\x7d\xf1\xe4\x7c\xaf\xa7\xea\x99\x9e\x8a\xf1\x79\x29\x5f\x03\x62\x80\x63\x89\x8e\xc9\xfb\x41\xac\xed\x52\x00\x3b\xc4\xb0\xa6\xea\x12\x8d\x9d\x1e\x78\x36\x76\xb6\xdb\x76\xad\x0a\x4e\x79\x00\xf0\xd2\x0f\xd8\x86\x79\xd6\xca\xb5\x77\xa1\xfe\xf6\x1b\x70\xbf\x47\xf0\xb6\xba\x00\x78\x36\xfa\xc4\x0b\x19\x16\x0f\x56\x62\x00\x90\x82\xf6\x1a\x08\xd8\x9a\xc1\x3f\xbf\x7e\x03\x36\x79\xf0\xfe\xe5\x82\x2e\xdd\xb2\xf8\xb6\x93\x9c\xf6\x50\xe1\x76\x2a\x4b\xcf\xb8\x47\x80\x78\x3f\xcd\x6b\x00\x6f\xff\x1c\x3a\x90\x9e\x7c\x1d\x9f\xa3\x90\xae\x03\x88\xf2\x16\xbe\x06\x8c\x7d\xb3\x0b\x59\x16\xa7\xbc\xc6\x15\x8d\xfd\x25\x37\xf4\xc3\x91\xc8\x8d\xcc\xa5\x90\x13\xbb\x3d\xb7\x4a\x8c\x6a\xc1\x48\x7c\x32\x05\xc0\xb3\xa1\x24\xa7\x4e\x4c\xce\x69\x81\xa7\xd7\xaf\x01\x59\x81\xd2\x89\x8e\xb1\xc9\xc6\xa3\x4d\x8b\x2d\x28\x20\xf8\xa9\xe5\x3a\x88\x17\xe7\xca\xa8\x90\x6f\xe3\xe5\x3a\x25\x5a\x8b\x29\x38\xa5\x1a\x2b\xb4\x27\xe5\x19\x9f\x0c\x8d\x93\xbd\x71\x35\xa1\x53\x87\xce\xba\xd1\x6b\x1f\xb5\x22\xaf\x34\x99\x04\x4c\xa4\x3a\xe3\xc9\x84\x5f\x88\x9b\x44\x76\xd6\xdc\xe0\x32\xc5\x59\xa1\x3e\x9d\x61\x3c\x99\x72\x3e\x9f\xef\xee\xf3\xd5\x49\x73\x97\xa4\xf2\xf9\x7c\x85\x8a\x0a\xe5\xfe\x64\x90\x94\xba\x89\xf9\x68\xc2\x52\x03\x6e\x58\xcb\xd2\xe5\xed\xae\x50\x1f\x95\x8a\xbb\x0a\xc9\xd4\x75\x7a\xca\xf1\x82\xd4\x90\xc5\x43\x46\x93\x36\xa3\x45\x72\x33\xaf\xb4\x76\x65\xb6\xac\x50\xfd\x4e\xb7\xd8\x4b\xcc\xb6\xdb\x63\x79\x79\xdc\x4d\x2b\x05\xa9\x98\x4a\x4b\x5a\x36\x85\x86\x09\xe5\x88\x10\xbb\x9a\xf6\x53\xc7\x25\x26\xfb\x23\x7f\x4a\xc9\x6d\x42\xa0\xd3\xa2\x9e\x59\x37\xd8\x69\x26\xcb\xf6\xd2\x44\x7c\xc4\xa4\x89\xd8\x96\x9d\xf1\x29\x55\x1c\xf7\x3a\x29\x22\x9b\xd2\xa6\x9d\x2d\x35\x91\xf4\x54\x9f\x64\xf5\xaa\x9a\xd8\xf3\xc7\x7e\x8e\x89\xea\x55\x2e\x06\x93\xbd\x79\x2e\xb7\xdd\xf0\x55\x21\xb5\x66\xa9\x6c\x1b\xae\x29\xb2\xbb\x29\x4a\xe3\x38\x53\xe2\xe4\x0d\xbf\xce\x8e\xba\xb9\xfa\x2c\xc6\xae\xb5\xd1\x24\xb4\x3d\x86\x42\xc5\x96\x3e\xd3\x72\x49\x46\xea\x89\x4c\x2b\x9a\x4e\x8f\x57\x24\x25\x4d\x13\x8d\x59\x43\xa5\xda\x89\x8a\xd0\x8d\x8e\xc8\x99\xa2\xb2\xd4\x4a\x9d\x69\xc4\x7c\x25\x24\x46\xc9\x74\x7c\x1f\x67\xa7\xa2\xc6\xb6\xc9\xee\x42\x48\xc4\xc4\x6c\x34\xc6\x0e\xe2\x28\x9e\x5d\xcc\xb5\x75\x48\xdd\xb0\xeb\x74\x35\xb1\x39\xae\x0a\x51\x69\x9c\xe0\x96\xc9\xde\x38\x99\x9c\xb0\xd2\x64\x96\x5c\x4c\xd1\x62\xb3\x6f\x44\x89\x10\x53\xee\xb6\x52\xbd\x54\xae\x94\xdb\x6e\xd3\x3b\x56\xda\x90\x85\xe8\x2e\x35\x5b\xaf\x7a\x43\x76\x43\x64\xe2\x9c\x1e\x47\x53\xb5\x96\xd8\x67\x7a\x45\x78\x54\xd5\x76\x9b\x8d\x29\xbd\x3c\x43\x4f\x4a\xb9\x32\x51\xe4\x3a\xb1\x76\xef\xd8\x87\x21\x26\xc1\x1d\x67\x51\xb9\x9f\x12\x43\xdb\xd2\x26\x5d\xcd\x70\x9b\x6d\x66\x38\xab\x69\xa5\x3c\x39\x67\x94\x64\x67\x22\x91\xc4\xb8\xbf\x8c\x36\xd8\x5e\x28\x33\x1f\x70\xc9\x64\xac\x22\xd6\xb4\x24\x6a\x11\x55\xb5\x37\xca\xac\x14\x22\xd4\xcc\x45\x37\x64\xaa\xb6\x52\x59\xbe\x3a\x8d\x6b\xa3\xb9\x44\x57\x0f\xc4\x38\xdd\xaf\x0d\xf8\xcc\xb6\x9d\x8f\x66\x9b\xdd\x44\x51\x64\x46\x82\x3a\x8f\x4e\xf4\xc4\xe8\xb8\x6b\xd6\xba\x4d\x89\x6a\x72\xfd\x69\x5c\x19\x8e\x47\x25\xa1\x77\xa0\xd2\xd1\xfe\xb4\x9d\xcb\xf6\x48\x22\xbe\x6d\x17\xf7\x04\x59\xa8\x97\x92\x7b\x3a\x21\x96\xc9\x50\xbb\x20\x09\xfd\x3d\x4f\x72\xa2\x2e\x6c\x88\x68\xaf\x9f\xa5\xd3\x9b\x7d\x29\x3d\x8b\x0d\x96\x4c\xbc\x33\xcc\xe6\xfa\xe9\x62\x12\xa5\xa9\xd2\x71\x8b\x8a\x7b\x62\x11\x15\xa4\xd9\x74\x5e\x50\x33\xbb\xe9\x34\x3e\x9b\x45\x65\x75\x97\x9c\x6b\xdc\x71\xbf\xdb\xf4\x3a\x12\xac\x55\x5a\x71\x7e\x2e\x96\x43\x99\x54\x66\x4c\xa6\xcb\xdd\x5e\xb7\xdd\xd8\xd0\xdc\x4a\x2c\xf4\x09\x3d\x19\xda\x6c\xf3\xd3\x39\xd3\x98\x77\x04\x6e\x9a\xd5\xa5\x18\xdc\x09\x62\x23\xa1\xb4\x6a\x45\x84\x76\xa9\x6d\x85\xe3\xe6\x85\xd4\xbc\x11\x8a\xa2\x4d\x4b\x5f\x4c\x08\x22\x1a\xdd\xd0\x3a\x2d\x51\xed\xd4\x72\xdc\xc9\x30\xc7\x6d\x3b\x1f\xa7\x99\x86\x5c\x5b\x49\xd9\x58\x57\xd5\xb2\x44\x91\x8e\x1f\x76\xad\x5a\x37\xa3\x35\x6a\xc5\xdd\x91\x16\xb5\x4d\x99\xca\x36\xbb\xaa\x44\xa8\xa3\x31\x9a\x51\x6a\x7f\xbf\xdf\x54\x51\x36\x44\x89\x68\x51\x90\x7b\xb3\x04\xd1\x8c\x4b\x5b\x51\xd8\xc6\x4b\xd5\x72\x6d\xb5\xc9\x31\x09\xb1\x3c\x9c\x76\x53\x3d\x62\x73\x54\x87\xec\x78\x96\x5d\xcf\x92\xeb\xfc\xb4\xcb\x50\x89\xd5\x81\x1d\xb3\xad\xe5\x9a\x56\x88\x52\x7f\x57\x4d\x8d\x8f\x4b\x89\x4e\xeb\xfa\x8c\x65\x0e\x4a\x7b\x9a\x4e\x14\xf7\x82\xb6\x91\xb3\xa9\xec\xa6\xba\xcd\x64\x43\xc3\xdc\xb6\x5e\xeb\xb2\xdb\x11\xd7\xef\x65\x72\xbb\xd1\x94\xec\xb4\x77\x5a\x25\x5b\x15\x11\x6a\x22\x54\xdc\x8f\x56\x1b\x3a\x5d\xea\xf4\x2a\x23\xae\x9b\xa4\xab\x85\x14\xb5\x25\x28\xb1\xb0\x18\xc8\xd9\x50\x91\x38\xf4\x44\xa2\xb7\x1c\x53\xb3\x19\x3f\x21\xb6\x8d\xf1\x36\x3d\x4c\x96\x25\xc4\x4e\x97\xa8\xd6\x51\xf9\x1c\x93\x90\xf2\xd3\x2e\xc3\x6e\xb6\x34\x25\x26\xd5\xc3\x34\x73\x10\x47\x45\x9a\x9d\x4c\x97\x93\xd8\x56\x2c\x12\x8a\xb8\x40\x6c\xbc\x05\x13\xfa\x6c\x38\xda\x55\xc4\xda\x70\x5a\x62\x6a\xdc\xa8\x4b\x08\xf9\x0e\xcc\x0c\xe6\x55\x79\xd1\xea\xf5\x11\x9d\x4e\xef\x4b\xd5\x69\x61\xbf\x64\xe2\x8d\x9c\xc4\xf2\x5a\xa8\x9d\x40\xad\x1e\x95\x2e\x0b\x64\x87\x5b\x75\x4b\xa1\x23\x25\xa6\xda\x6b\xba\xb3\xe0\x6a\x14\xaf\x09\xa1\xc2\x3c\x9d\xd3\x25\x4a\x93\xc8\x15\x3b\xe4\x85\x36\xbb\x6b\xd5\x0a\x93\x54\x26\x3b\xe8\xec\xe7\x0b\x58\x9d\xf4\x1a\xab\x5d\x33\x99\xde\x4f\xb8\xf8\x70\x43\x4b\xd2\x74\xc1\xcc\x9a\xfc\x51\x3f\xe4\xc4\x45\x3f\x56\xaf\x1e\x4b\xfa\x36\xbf\xd9\x13\x42\x71\xb5\x9f\x67\x89\xe8\xb6\x42\x29\x6a\x65\x93\x49\xb7\x6a\x85\x49\x6c\x97\x3b\x4e\xa7\xa5\x65\x4e\x9e\x87\x9a\xac\x94\x99\x6d\x97\x83\x79\x46\xd9\x2b\x07\x62\x44\x1f\xc7\x09\xd4\x1a\x27\xd0\x8a\x57\x77\x15\xb1\xc6\xc0\x62\x61\x21\x1e\x17\x5d\x35\xb7\xa7\xa2\xed\x79\x2a\xbb\x1d\xed\x2a\x33\xa6\xb3\x5b\xa1\xc5\xaa\xc5\xad\x5b\xc3\x66\xba\x34\xda\x91\xca\x62\x9b\x93\x67\xf9\x98\x96\x5e\x2f\xa9\x76\x37\x9d\x2d\x85\x42\xed\xdd\x2c\xc1\xf4\x1b\x5a\x6d\x9f\x5d\x24\x4b\x8b\x4e\x4c\x1a\x52\xdb\x62\x2e\x51\x22\xb2\x09\xb8\x89\xf7\xf8\x41\xaf\xb0\x89\xd5\xc8\xc5\x1a\x65\x7b\x62\x41\xa3\x12\x8b\xe1\x62\x11\x8d\x89\x65\x26\xd4\x8a\xb6\x66\xb4\xc8\xa6\x12\xb3\x58\x3c\x37\x22\x66\xe5\x5d\x69\x92\x98\x4d\x65\x76\x97\xaa\x70\x62\x32\x04\x6b\x75\x0a\xa9\x5d\x22\x2d\x4f\xb8\x7e\xea\x50\x95\xa8\x6a\x5b\x91\x62\x44\xbb\x44\x6e\xb9\xda\x30\x36\xca\xf6\xa2\xbb\xb4\xba\xeb\x56\x45\xbd\x3a\xaa\xf5\x04\x61\xbb\xcc\x36\xe2\x0c\xd5\xcb\x33\x8b\x18\x33\x82\xed\x0a\x21\x71\xfd\x90\x92\xa5\x8e\x74\xa2\x48\xb0\xc7\x42\x29\x94\x8e\xcf\xb2\x7a\x82\xdc\xd4\x88\xed\xa4\x98\x14\x88\x6d\xe3\x98\xed\x1d\x67\xc3\x72\x2d\xb4\xdd\x84\xc4\xcc\x80\x0d\x09\x7d\x71\x9b\x6b\xc7\xe8\x8e\xc2\x55\x46\x5c\x3b\x96\x48\x32\x1d\x8a\x8a\xa7\x79\x49\xce\xa5\x93\x55\x6d\x59\x0d\x0d\x43\xca\x5a\x29\xb2\xab\xec\x91\xe3\xa7\x63\x82\x23\x77\xcd\x5e\xa3\x55\xc8\xc4\x75\x29\xa9\x44\xbb\xd2\x28\x1a\x67\x56\xab\x94\xac\x57\xb2\x69\x89\xce\xb0\x59\x3a\x33\x60\xe8\x78\x77\x2d\x69\xd2\xf1\x98\x5c\x67\x26\xdb\xdc\x48\x84\x99\x51\xbe\x2b\xd5\x26\x64\x61\xb7\x63\x09\x62\x1f\x93\x14\x2a\xd5\x25\x06\x95\xc5\x76\xa0\xce\x43\x7a\x54\x64\x46\xad\xa1\x32\x3a\x96\x38\xae\x5a\xcb\x0d\x86\xa1\x99\xa8\x27\x46\xa5\xe4\x8c\x49\xb0\x30\x13\x9a\xe9\xec\x20\x5a\xcc\xe7\xf3\xf9\x7c\x3e\x9f\xff\xdc\xef\x52\xb6\x43\x24\x2b\x89\x44\x96\x3f\x32\xd5\xfd\x74\x9a\x35\x52\x87\xe3\x49\x77\xd0\x4c\x15\xe7\xf5\xfa\xeb\x87\x23\x0c\x63\xbc\x15\x96\x64\xcf\xa0\x83\x78\xfb\x68\xec\x65\x0c\x58\xf0\x06\x59\xf7\x28\x88\x4b\x79\xb2\x8d\xf1\x64\xc0\x3d\x2e\xc2\xff\x8d\x8c\xd4\x37\x7b\xa4\xe7\x24\x81\xf7\x17\x82\x4b\xdd\x81\x0d\x0f\x67\xde\x5e\xa0\xf8\xd6\x91\x81\x91\xf8\x42\x40\xf1\xcd\x57\xd8\xd9\x1c\x66\x72\xe2\x9f\x2a\x98\x03\x7b\x7b\x8a\x1b\x34\x0f\x5c\x18\xe3\x61\xe3\x60\x80\x39\x34\xde\xa9\xa4\x02\xf0\x3c\xc4\xc8\x2e\x62\xd8\x8a\xac\x0e\x35\x52\xd3\xd1\xc3\xe3\x49\x04\x64\xa4\x80\xf7\x0b\x73\x02\x9c\xe0\x19\x18\x1a\x23\xef\xbc\xce\xf0\x5a\xc0\x4f\xfe\x8c\x52\x55\x25\x19\xf8\xe0\x2f\x17\x59\xe2\xe4\xc7\xd3\x78\xdd\x95\x37\xd4\x45\x91\x54\x0f\x67\x65\x1e\x03\x6f\x35\x03\x0a\x3d\x3b\x43\x6c\x57\xb6\x89\xf2\xa2\x00\xa4\x3d\x0d\xd7\xc8\xa5\x3d\x3d\x8e\x68\xe4\x12\x39\x73\x36\x8d\x5c\x46\x04\x5e\x5a\x9f\x6d\x18\xb3\x6b\xc0\x90\x09\x18\xff\x87\x15\x5e\x10\x5c\x7a\xf6\xeb\x20\x8c\x75\x80\x11\xe2\x88\x8d\xa1\x60\xe3\x05\x1f\xb3\x7a\xf7\xcd\xaf\x94\xdb\x95\xed\x56\xba\xc6\x8b\xbc\xb4\xf4\xd5\xbf\x48\x0a\xc2\x85\x0d\x84\xc0\x52\xea\x88\x17\x21\xd0\x64\xc0\xf2\x2a\xd2\x00\x75\xd0\x20\x20\x80\x26\x6b\xa4\x00\x54\x88\x14\x59\x42\x10\x68\xbc\x08\x03\x6f\xa3\x51\xa5\x80\x95\xda\xc6\xab\x0f\xc6\xd9\x9e\x07\x17\xd5\x88\x81\xa0\x70\xd0\xe0\x23\x78\x07\x22\x3a\xed\x44\x1c\x19\xc8\xae\x17\x34\x88\x99\x85\x5e\x08\x83\x5d\x97\xc4\xdf\x23\x3e\x8b\x79\xea\xfa\xf6\x36\x5f\x91\xdf\x5d\x37\x6f\x15\x5c\x10\xc8\x12\x9e\xbe\x5b\x95\xed\x41\x78\x56\xe1\xc6\x59\x68\x49\x56\x21\x0b\x55\x15\x07\x7a\x6c\x5b\xb3\x4a\x60\x0b\x23\xdf\xc0\x03\x03\x15\x8d\x73\x0c\xd1\x7c\x7b\x7f\xbc\x25\xe5\x6d\x3f\xe4\xd9\x17\x6a\x99\xad\xb5\xc5\xd5\xf1\x7f\x94\x26\x01\x4a\x93\xf0\xd9\x40\xe3\x68\xa7\xa2\xf2\xb8\xa9\x18\x69\x48\xc4\x61\x3c\xc6\xda\x1c\xeb\x9f\x61\x95\xa0\x46\xf2\x02\x32\xa7\x57\x6f\x13\x1e\xee\x80\x95\x64\x48\xf3\x42\x5e\x23\x81\x20\x2d\x4b\xcc\x25\x22\x80\x15\x64\x52\x33\x8f\x74\x39\x0d\xe9\x34\xc7\xfb\x50\xaf\x13\x1e\xf1\x1a\xc0\x11\x01\x57\xab\x70\xe9\xe8\xd3\x41\x06\xcc\x43\x47\xd6\x20\xba\x11\x65\xb0\x3a\x0c\x0d\xa2\x80\xa7\x46\x2c\x47\x21\xc9\x1a\xc4\x9e\x02\xff\x76\x45\xe6\x82\xa4\x00\x55\x0d\x18\xff\x1b\xcd\x1c\xe7\x47\x30\x2b\x76\x8c\xc7\xc8\x32\x6c\xc6\xcc\xb2\x5a\xfd\xcf\x11\x2a\xaf\xf0\x1f\x89\x44\x2a\xfc\x45\x81\x90\x02\x69\x2c\xd0\x03\xa9\xf0\xe0\x5f\x80\x54\xf8\x08\x36\x0b\x52\xe1\x87\x0a\xa4\x11\x78\x06\x92\x2e\x08\x8f\xe0\xbf\xff\x1b\xfc\xfe\x87\x83\x01\x77\x60\x09\x2c\x0c\x2e\x1e\xb1\x57\x4d\xde\x0d\xff\x6b\x24\x19\x8e\x06\x17\x0a\x8e\x25\xe3\x99\x01\xf9\x5e\x3d\x08\xde\xaf\x3b\x27\x07\x1d\xa9\xf0\xd6\xde\x66\xdc\xa4\x0c\x70\xdc\x01\x26\x5c\xc4\x95\xb7\x53\xab\x35\xca\xdc\x67\x59\x36\x05\x23\x00\x83\x8d\xeb\xe4\xb0\x7c\xf8\xcc\x58\x8f\x0f\xa1\xd9\x3c\xb0\x5e\x3c\x3b\x90\xf1\xdf\x17\x63\x83\xbb\x23\x95\xf1\x62\x24\x85\x91\xa6\xf2\x0a\x64\xac\x37\x0e\x47\x6c\xac\x67\x24\xba\xf4\x89\x51\xe0\x4e\xcb\x41\x81\x5f\xc2\x82\xd1\x86\xdc\x50\x18\x4e\xf5\x26\xe0\x24\x0e\x20\x5a\xc6\x86\x4f\xcb\x42\xe0\xad\x0d\x35\x4e\x66\x5e\x08\x8d\xfb\x08\x12\xc7\x69\xee\x81\xb3\xfa\xde\x73\xd0\x17\xc2\xcb\x0e\x86\xb0\xae\xf3\xb0\x7f\x5e\x34\xfb\xac\xd1\xe9\xcf\x8b\xa6\xda\x16\x28\x2b\xf6\x51\x4a\x5e\x32\xab\xc7\x49\x41\x67\x66\x67\x97\x66\xb0\xb5\x38\x70\x11\xd1\x10\x18\x9b\x8b\xc6\x5c\x00\x76\x94\xea\xf2\xff\xb6\x2d\xe0\x5d\xdd\x58\x0b\xe0\xb7\xdf\x7c\x09\xff\x78\x7d\x05\x41\x22\x08\xfe\xe5\x4b\x7f\x06\xc1\x20\x78\xf7\xd0\xc7\xe6\x72\x95\xba\x97\x55\x64\x6a\x12\x4b\x76\x4a\x74\x9e\xea\xcc\x25\x34\x17\x94\xec\x55\xe9\x0b\x61\x98\x94\x9d\xe0\xf2\x2b\xde\xd6\x0e\x25\xc6\x38\x36\xe6\x6b\xf1\xc6\x19\x89\x8d\x50\xb6\x72\x6f\xb7\x7a\xe3\x24\x45\xbf\xf5\x6c\xb5\x64\x2c\x9d\x8d\xd6\x6e\x58\x97\xdb\xac\xd5\x6d\xff\xc3\x81\xe6\x71\x90\x1c\xeb\x16\xeb\x30\xf0\x56\x77\xbf\x02\x1e\x01\x86\x47\x58\x2a\x26\xe2\x6d\x6a\x8a\x3d\x4c\x76\x92\x00\x38\x2b\x0b\x25\xa3\xe8\x33\x70\xb3\x87\x1d\x32\x02\xef\x86\x3b\x45\x11\x57\x9b\x77\x20\x6e\xb5\x7b\x9a\x83\x22\x69\xb4\x7c\x4a\xbd\x36\x0e\x76\x10\xe1\x9d\x33\x3c\xee\x44\x5e\x90\xa6\xca\xd2\xf2\xad\x6f\x26\x3c\xe3\xa3\x78\x46\x82\x87\x33\x0b\x3c\xb2\x92\x79\xe9\x21\xf8\x04\x82\x8f\xe0\xfd\x85\x52\x2f\xc4\xed\x2f\x52\x13\x75\xcd\x30\x1f\x17\xbd\xb6\x9d\x74\x85\xa2\x53\xe4\xb3\x34\x91\x4e\x39\x17\xe6\xb8\xe8\x0e\xdd\xc9\x57\x68\x7b\x8a\x7a\xe9\xfb\x68\xbb\x6a\xde\x65\xd4\x3f\xd4\x59\x36\xc8\x2d\x39\x34\x20\x3f\xea\x33\x57\xe4\x96\x34\x65\x09\xf8\x1a\x13\x56\xfe\x85\x5c\xb3\x81\x98\xc8\xd1\xb3\xcf\xfe\xed\x13\x46\xd6\xab\xc0\xdb\x8d\xd2\x62\x9b\x97\xc0\x09\x65\xc4\xfc\x65\x3b\xc0\x4b\x0e\xcc\x85\x0c\xb8\xbb\x2f\xa3\xe0\x15\x43\xc6\x4e\xcf\xcc\x37\xef\x00\x01\xff\x02\xc1\xba\xf9\x64\x12\x0c\x82\x67\x1b\xc2\xe9\x24\xfd\x84\x4c\xf1\x2d\x28\x24\xeb\x2a\x0d\xdb\xa4\x82\x5d\xa3\x33\xda\xbb\x9c\xe9\xe3\xe6\xea\x14\xca\x7c\xdc\x91\xaa\x64\xcc\x6c\x86\x06\x16\xd0\x26\x15\x1f\x37\xfe\x15\x5a\x5d\xb8\xe0\x6e\x5c\x3a\xd5\x38\x5e\x65\x7a\xa4\xaa\x1d\xba\xc6\xda\xba\xcb\x6a\x47\x38\x2b\xac\xe0\x3c\x4b\x7e\x20\x9b\x30\x5e\x13\xbe\x85\xcd\x67\xc8\x6e\x97\x75\xc9\x6a\x22\x76\x73\x38\x8d\x34\xf1\x8f\xcd\x91\xe3\x8c\x4f\x0c\xb8\xa1\x14\x15\x5e\x32\x0a\x2f\x8b\x0e\x05\x8b\xb5\xff\x94\x2c\xd6\x54\x97\xf3\x74\xb5\xab\x9f\xd7\xc8\x86\x90\x56\xa1\xf6\xe1\x40\x1b\x99\x60\x97\x9a\x97\x3f\xcb\x6a\x5b\x66\xaa\xbf\x6d\xfd\xad\x46\x5f\x03\x5d\x80\xe7\x03\xa5\x73\xb8\x0a\x7f\x1f\x5c\x79\x4f\x43\x55\xd1\xee\x01\xad\x91\x88\xfb\x2b\xc6\x68\x66\x65\xe0\x51\xc3\x79\xb5\xd8\xc0\xc6\x90\xcc\xcc\x8e\xa8\xba\x00\xaf\x8e\x87\x2e\xd9\xad\xcb\x7f\x99\x18\x58\x5e\x80\x97\xfd\xd7\x29\xdf\x1a\xc3\xff\x1b\x9c\x92\x65\x96\x45\x50\xfb\x3e\xd2\x78\xd7\xab\x0b\x33\x34\xd5\x8d\x71\x18\x39\xdf\x83\xca\x19\x11\x59\xb8\x38\x12\x71\xee\x01\x91\xc6\x7c\x58\x33\xf7\x0e\xec\x7e\xa8\x79\x16\x91\xf2\x51\xd3\xa4\x91\x72\xa9\x59\xba\x93\xcd\x26\x59\x34\xaf\xe1\x0b\x0f\x21\xad\xe3\x2d\x6b\xe1\x9e\x2c\xf0\xf4\xc1\x3d\x66\xa0\x91\x12\x51\x21\xde\x94\xdc\x35\xb6\x56\x80\x07\xf3\xcd\xd8\x69\xf1\x68\xf5\xf9\xfe\x36\x7d\xdd\xc3\x61\x74\x8a\x41\xe4\xdc\x99\x19\xea\x72\x91\x65\xf1\x46\x23\x09\x47\xf4\xfe\x26\xde\x61\x08\xb7\xf8\xd6\xb2\xc3\x3d\xcd\xb9\xc4\xab\x78\x34\xbc\xbd\xd3\x9d\x18\x92\xfe\x15\xcd\xdf\x52\x22\x6e\xff\x1e\x9d\x7a\x0b\x60\xfe\x99\xb7\x8b\xc1\xe7\xf3\x38\xb3\xa5\x85\x07\x0b\x57\x04\x59\x09\x66\xe4\xd9\x9f\xea\x0c\x0c\xaf\x34\x46\x77\x19\xc6\x56\x1a\x78\xbf\x07\xfa\x74\xeb\x23\x78\xff\xfb\x34\xd0\x09\xde\xba\x24\x7d\xdc\x81\x6e\x2d\xb8\x4b\x4d\xf5\x2c\xcf\x6c\xaf\x63\x04\xd5\x70\x7e\x89\x77\x2e\xdb\x54\xfe\xd6\xdd\x69\x87\x14\xef\xb2\x7f\x73\xe9\xe2\x2e\x48\xd7\x35\x0b\x1f\x43\x97\x78\x96\x85\x2a\x94\x68\x88\xfe\x8a\xb6\x65\xd5\x12\x6e\x5b\x4e\x85\x39\xcd\xc7\x4a\x89\x30\x06\x0f\x08\x0f\xd7\x8d\xba\xb1\x07\xc7\x78\xb8\x1e\x0c\xfa\x74\x8a\xd1\xfb\xcc\xd8\x33\x72\xb7\x91\x62\xb6\x3c\xa3\xf6\xf3\x8c\x0b\xfd\xaf\x0d\x84\xaf\x3f\xbd\x30\x3f\x70\x4f\x17\x8d\xb5\xac\x0b\x45\xfc\xd3\x4b\x4b\x71\xd7\x9a\xaa\x5d\xfc\xb4\x12\x75\x15\xd4\xb5\xda\xe0\x94\x72\x2a\x1b\xfc\x07\xbe\x84\xe7\x11\xbc\xff\xf3\x0a\x86\x2b\xdd\x8d\x83\x8a\x39\x59\x82\x39\x2f\x7b\xf4\x0d\xf9\x35\xe6\x43\xeb\xb8\xd7\x7b\x78\x5a\xb2\x28\x53\xbc\x00\x4f\x9b\xa1\x8c\xca\xb1\xe1\xcc\x56\xdd\x36\x40\xc0\x09\xc6\xdf\xa6\x9d\x01\xd6\x45\x64\x67\x35\x6d\xec\xae\x32\xb7\x76\x5d\x2e\x60\xe9\x8a\x17\x97\x61\x8d\xd3\x45\x4a\x22\x79\xc1\x39\xa6\x24\x92\xfb\xb0\x7d\x54\x29\x89\x6f\xed\x0a\xbc\xb9\x2c\xc5\x25\xe6\x0f\x39\xc9\xa2\x2c\xaf\xf9\x8f\xa3\xf9\xb4\x09\x16\xb8\xa0\x58\x7f\x96\x3d\xa2\x31\x52\xff\x4f\x78\xc5\x92\x8c\x2f\x1b\xf9\x99\xa1\xe0\xf2\x5e\xe1\x55\x88\xee\x01\x35\x86\x84\x77\xb1\x59\xd3\x34\x05\x8f\x0d\xef\xc2\x4a\x8a\x70\xc8\x6b\x77\xe1\xad\x23\xa4\xff\x35\x7e\xdb\x34\x1d\xec\xb6\x6d\x23\x72\xbc\xb6\x99\x10\xe1\x0d\xda\xdf\xe1\xb3\xaf\xf8\x1f\x0b\x9d\xed\x3d\x35\xe6\xbb\x8b\x32\x86\x11\x7c\xb2\xf0\x87\xb1\x6d\x0b\x0e\x9a\x76\x81\x3d\xe3\x83\x95\x24\x92\xfb\xfc\x12\x82\x37\x10\x05\xff\x02\xde\xb4\x10\x08\x02\x64\x74\x5f\x43\x88\xf0\x99\x9b\xe0\xe3\x3d\x44\x90\x61\x51\x58\xa7\x73\x68\x16\xef\xc8\xc1\x7b\x0a\x72\x96\x81\x7d\xa2\x28\xb2\xec\xed\x26\xec\x83\xb7\xd2\xff\xea\xee\xe1\x87\xfc\xa6\xb5\x5b\x62\x84\x5d\x94\xdf\x79\x7e\xca\xc9\x81\xf3\x7b\x2a\x1d\xfb\xbe\xc7\xe7\xf9\xfc\x9d\xbf\x11\x9b\xfc\xfa\x1b\xb1\x1f\x6a\x82\xaf\xc3\xf4\x02\xb9\x15\xec\x6b\xe1\xfe\xd6\xed\x6a\xd9\xd6\x06\x72\x5e\x02\x96\x44\xae\x96\x6d\x4d\x5f\x4c\x8e\x1e\xcc\xfc\x47\x97\x24\x9e\xf6\x64\xdd\xd3\x89\x1b\xae\xd1\x90\xcd\x77\x67\x18\xa4\x31\xb7\xcb\x19\xf7\x7b\xba\x0b\x1a\x09\xfe\x92\x3e\x19\x4f\x52\xb9\x8c\xe7\x7b\x8d\xc4\xbc\xe5\x0c\xaf\xc0\xdf\xe8\x5b\x55\x79\x07\x2e\xde\x28\xea\x52\x87\x1b\x9e\x96\x85\x70\xd2\x95\xe7\x3b\xa7\xe2\x3f\x8d\x72\xf9\xd8\x89\xab\x09\x5c\xc2\x9f\xbd\x80\xdf\x63\x96\x36\x21\x2b\xd1\xb3\x71\x09\x39\x34\xad\xf7\xb0\xa7\xf5\xfd\xbc\xf6\x87\x0a\x87\xd3\x4d\x6f\x57\xb4\x6c\x53\x7d\xe1\xe2\xb6\x80\xd6\xfd\xdd\xe1\xa4\xb9\x8f\xc5\xbc\x85\xd3\x7b\x6d\x2b\x50\xa8\x70\x02\x77\xe5\x4b\x88\x00\xe5\xbd\x50\x8e\x8b\x5f\x1a\x00\x99\x07\xbd\xea\xc6\x69\xa2\x30\x88\x81\x17\xa3\x2d\x9f\xca\x15\x4d\x00\x14\x11\xa0\xb4\xd4\x38\xe7\xe0\x92\xa7\x20\xee\x04\x2d\xb8\x91\x3c\xe4\xac\x6f\x0c\xf8\x2a\x19\x1f\x37\x10\x6c\xfd\xdb\xaa\x38\x27\xf4\xbb\x07\x73\x18\xc4\xfe\x30\x8f\x21\xd9\x25\x71\x29\xf4\x1d\x85\x0d\x78\xfb\x62\x48\xfc\xe3\x3f\xe5\x74\x3f\x0b\x2e\xa1\x1c\xdb\x34\xa4\x7a\xfb\x72\x66\x20\xa7\x8b\x2e\xff\x6d\xed\xb6\xb1\x90\x5a\x1a\x02\xa1\x57\x10\x4b\xe1\xf3\x69\xd6\x22\xe7\x19\xc0\xdb\xeb\x47\x55\xe1\xdb\x99\xe3\xde\xf4\x23\x2c\x8d\x24\xe3\x8a\x77\xe0\xbf\xfc\x34\xf0\x66\x10\x68\xcb\x2a\x3c\xdd\x51\xf9\x33\xac\xda\xb8\x70\xf0\x2f\x35\x68\xeb\x4a\xc3\xef\xb1\x65\x9b\xaf\xbf\xc8\x82\x6d\xf4\x17\x8c\xe6\xb2\xd5\xde\x28\xf0\xa1\xad\xde\x26\xf6\xbf\x62\x9f\x67\xea\xfd\xdb\x59\xa5\x75\x75\xe5\x5f\x6a\x97\xce\xf5\x98\xdf\x69\x99\x56\xb9\xcf\xdb\xe6\x69\x1f\xb1\xa8\xf9\xbb\x57\xef\xb9\xad\x13\xb5\x0b\xd6\x73\xed\x8c\xdb\x77\x14\xb2\xd8\xb8\x71\xfe\xcd\xbb\x91\xe0\x6e\xf4\xce\xf8\xe9\xbb\x4a\x5c\x0a\x34\xbd\x40\xd1\x0e\x4b\x8d\xa5\xb5\x24\xef\x24\x60\x15\x31\x76\x59\xdf\xb1\x85\x34\xf0\x26\x8a\x5c\xe2\x19\x7c\x0f\x37\xb8\x04\x70\xdf\xd2\xc9\xa4\xbe\x13\x01\x93\x72\x97\xbf\xa7\xa8\xa1\x2a\xcb\xaa\xc0\xbb\x09\x6f\x2f\x43\x39\x62\xfa\x77\x95\x7f\xe0\xe6\xae\x53\xbb\xea\xe8\x3e\x60\xf0\x03\x57\x77\x93\xe0\xff\x96\xb3\xf3\xb7\xd8\xbf\x8f\xbb\x3b\x8d\xda\xd1\x5f\xe6\xeb\xae\x38\x38\x5c\x01\x67\xde\xcd\xef\xd4\x4e\x40\xd6\x56\x7b\x4b\xb9\x2e\xa7\xf5\xe2\x9a\x50\x9c\x59\xe0\xef\x1e\x2a\x17\x86\x85\x97\xe1\x02\xe7\xa6\x75\x11\x13\xde\xf7\x74\xa2\x7e\x97\x15\xb9\x84\xb8\x60\x42\xee\xdc\xb7\x57\x9f\x4e\xfe\x3e\x66\x63\xb2\x89\x79\xfe\x9f\xb1\x9a\xcf\x47\x18\xfc\xa1\x85\xfb\x82\x0b\x17\xc2\xa9\x38\x74\x60\xf7\xb4\xb2\xa0\x8b\xc6\xd6\x53\xf3\x09\x05\xdc\x41\x05\x1b\xb3\x7d\x09\xbf\x77\xff\x3c\x4e\x2d\x1c\x1e\xcc\x82\x91\x35\x3c\x78\x23\x01\xd6\x91\x6f\x2b\x1b\xf7\x62\xe0\xdd\x97\xed\xee\x0f\x31\xb6\x26\x3c\x18\x77\x3a\x9c\x50\x1a\x1d\x1f\xce\x2a\x41\x44\xe3\xe0\xd5\x6f\xbf\xe4\xd2\xe9\xe8\x57\x23\xf6\x85\x9f\x53\xf8\xf9\x42\x5f\x07\x80\x37\x16\xe2\x8d\x14\x5c\x88\x78\x9e\xc7\x3b\x5d\x31\x11\xfb\x02\x09\xcc\x09\x64\x8c\x4a\x0d\x80\x53\x8b\xf2\xcf\x8f\x6e\x04\x16\x5f\xc8\x4f\x1c\x09\xb0\xba\xbc\xd3\x9e\x34\x7f\xf4\xc4\x89\xc3\xf9\xce\x2c\x69\xcc\x5d\x4c\x5d\x3c\xaf\x75\x89\xc2\x8d\xfa\xfb\x5f\x3e\x02\x65\x8b\x70\xe1\xe4\x13\x78\xb8\x94\x69\xdc\xaa\x05\xde\xed\xed\x17\x5e\x51\x2f\xaa\xd7\x1a\x14\x5d\x11\x17\x1b\xea\xb9\x30\x22\x8f\xb0\x4f\xbf\xbc\x5e\x76\x79\x5b\xcc\x99\x9d\xba\xed\xd2\x13\x12\xfd\xb4\xcf\x33\x36\x3c\x5f\x71\x77\xb6\x7d\xf8\x3e\x5d\xe3\x58\xf7\x19\x8c\x0b\x65\xe0\xcd\x61\xe9\x32\x3a\xdf\x87\x50\x5c\x45\x5b\x66\x4e\xd7\xca\xb0\x51\xe0\x08\x50\xe2\xcd\xca\x04\x06\x64\x24\x12\xf1\xad\x4c\xb9\xc8\xd8\x1f\x56\x71\xd8\xbd\x06\x10\xc6\x5f\xfa\xa0\x96\x61\x5e\x62\x65\x17\x1b\x3d\xbb\xbc\x75\x6e\xc5\x06\xa7\x48\xd5\xba\x58\xc4\x88\x42\x4a\xf2\xee\x35\x10\x75\xa7\x88\xbc\xe4\x4f\x21\xf7\xaf\x81\x78\x2a\x1a\xf5\x69\xc5\x55\x6f\xbe\x97\xbb\xeb\xf3\xb4\xc7\xd1\x92\x93\xd5\x25\x73\xeb\xb7\x42\xaa\x08\x5a\x4b\x0a\x0f\xc8\xfc\xfd\xe8\x7c\x3b\x45\x80\x9a\x71\x59\x11\x78\x75\x92\x80\x7d\xe9\xd7\x33\xb0\xc0\xed\xf3\x2c\x4f\x0e\x04\x5e\x79\x46\xa7\x7c\xe3\xf5\x94\x8b\x6d\x1e\x3d\x83\xdf\xff\xf0\x26\x9d\x07\x6e\x30\x8c\x05\x62\x77\x04\xac\xac\x82\x07\xcc\x15\x2e\x31\x56\x05\xc3\xc7\x5a\x64\x70\x12\x3a\xf1\x0e\x0c\xce\xad\x91\xbd\xa2\x23\xce\x16\x2f\x72\x1a\xd3\x8c\x55\xe1\x8f\xc7\xaf\xd7\x68\x60\x27\xed\x27\x70\xce\xa5\x9b\x22\x2e\x65\x8d\x84\x3d\x2a\x03\x06\xae\x67\xe3\xff\x93\xd4\x2e\x55\x38\x69\x36\x13\x17\x44\x95\xd9\x0f\x38\xf9\x1d\xa3\xff\xc3\xcd\x0f\xb0\xb9\xb9\x43\x0d\x17\x58\x70\x14\x78\x4e\xcb\x44\x65\x61\x3f\x53\xe1\xad\x82\xb8\x4b\x7c\x78\x20\x9f\x00\xf5\x08\x5e\xdf\x5c\xcc\xaa\x50\xd3\x55\x09\x90\xde\xc9\x58\x18\x50\x9e\x04\x87\x94\x43\xd4\x2a\x87\x69\x7a\xbe\x0c\x34\xd1\x8d\x1b\x2d\x15\x59\x82\x92\xf6\x10\xec\x5d\x8a\x24\x07\x9f\x1c\x06\x6c\x8f\xf7\x0c\x82\xbf\x28\x97\x60\x6d\xdf\x17\xb4\x6b\x10\xdf\x83\x26\xf2\x96\xa5\x06\x7f\xfd\x86\x17\xae\xde\x83\x8e\x59\x63\x86\x1e\x1e\xcf\x05\xbc\x50\x3d\xd6\xb0\xf7\x19\xc4\x52\x67\xd5\xf0\x6e\xe3\x53\x54\x59\x41\xcf\x2e\x7c\x97\x15\xfc\x0c\xf2\xaa\x4a\x1e\x2c\x28\xd3\x9e\xde\x1f\xbf\xde\xd2\x89\x13\x87\xbc\xad\x8e\xb3\x70\xe5\xdf\x4a\x13\x7e\xc1\x6d\x60\x2c\x2e\xfe\x1c\xc8\x19\xbc\x25\x90\x87\x31\x5c\x49\x48\x17\x34\xdc\x7a\x6d\xb2\x67\x8d\x11\x5f\x77\xa8\x71\x3c\x3a\xf7\x38\xf8\x87\x67\xed\x6e\x5d\x46\x9a\x31\x8a\xc5\x5f\x43\x31\xb0\xfa\x41\x6d\x6a\xbf\x7b\xe0\xed\x68\x84\xd1\xc2\xf0\xa3\x63\xe9\x96\x64\x00\x6f\x14\xba\x0f\x95\xcf\x0b\x59\x1c\x32\xcf\xe0\xcf\x88\x2e\xf1\x1b\x1d\xd6\x99\x87\x20\x26\x6c\x5f\x61\xf7\x67\xf0\xf1\xe9\x8b\x17\xdc\x51\xaf\xc1\xe6\x1f\x5f\x3c\x59\xe0\xdd\xcb\xdb\x97\xcb\xcf\x56\x85\xff\x19\x31\x7a\x3a\xf4\x60\xe9\xe3\xeb\x17\x3f\xf0\x5d\xf6\x6a\xc5\x14\x3e\xb6\x58\x17\xe0\xff\x15\x9b\xb5\x44\xfa\x2b\xac\xf6\x1f\xee\x5b\xba\xfc\x00\xb8\x21\x49\x1a\x2f\xe9\xce\xc7\xf5\x2c\x9e\x2f\x1b\xbf\x85\xc5\x0c\xe6\xdd\xd9\x00\xdc\x65\x7e\x42\x23\xf0\xa0\xbb\xab\x21\x58\x25\x6e\xb6\x05\x0b\xe6\xd9\x3a\xcb\x6e\xbe\xfd\xa5\x4d\xc6\x9a\x36\xfb\xdb\xce\x13\x70\xba\x5f\xdc\x8f\xda\x4c\x5b\x7a\x33\x63\x49\x2e\xa5\xdd\xd7\xc0\x86\xde\x98\xd8\x95\xd6\x75\x25\x72\xf6\x33\x9b\x96\x2b\x18\xf4\x13\xda\xd5\xc7\x4e\xc5\x09\xe8\xdc\x72\x28\x0e\xd0\x5f\xe2\x4c\x8c\x18\x06\x2e\xef\x4a\x04\xe0\x1b\x58\xc3\xc3\x33\x08\xea\xaa\x10\x7c\x32\x3e\x5a\xff\x0c\x82\xe3\x41\x2b\xf8\x04\x0c\x7b\x78\x36\x7b\x1b\x63\x2c\x75\x9a\xf3\x3f\x5d\xc4\x81\x07\xe2\x3a\x3a\xa1\x19\xda\xef\x17\x30\xa9\x08\xd6\x25\xed\xc1\x15\x1f\x30\x8e\xb7\x46\xaf\xe1\x36\x26\xdf\x27\xd4\x23\xeb\xf5\x32\x8f\xa7\xb8\xc1\x7f\xff\xb7\x71\x3a\xf8\x32\x4e\x6b\x5f\xc1\x09\xab\xb5\x13\xe7\x2a\x5e\xd7\x4c\x1a\xfc\xeb\xda\x14\xfe\x19\x84\x63\xd7\x28\x5a\xd3\xef\x13\xc5\xb6\x35\x1f\xff\x1e\xca\xbf\xfd\x76\x96\xe6\xcc\xeb\xff\x75\x35\xcb\x1e\xf0\x3e\xe3\x1b\x4a\x5d\xcc\x39\x46\x84\xff\x5a\x41\x2f\xdb\x1e\x7c\x39\x38\xe6\xf5\x0c\x8c\xeb\x64\x7f\xb0\xc1\xdc\xea\x88\x5c\x31\x2d\x8f\x4d\x5b\xd3\x1f\xc3\x8c\xc1\x2b\xf8\x33\x82\x4f\x18\x3c\x18\x5d\x8e\x15\x2b\x7c\xb2\xf5\x6c\x24\xda\x01\xbc\x77\x8f\x6b\xb7\xbb\x2b\x04\x5e\x4f\xbe\xef\xd4\x6f\x3d\x59\x04\x4c\x67\xe8\x29\x69\xb5\x29\x07\xb7\x15\x01\x34\x90\x45\x54\x7c\x5e\x02\xc1\x87\x47\x60\x7a\x6e\xf4\xf5\xba\x86\xcc\xd3\xf1\x1e\x1d\x59\x7c\xe0\x98\xa5\x47\x62\xdc\x6d\x7a\x84\xc1\xd1\xc8\x33\x28\x70\x12\xd8\x60\xea\x15\xfc\xc3\x93\xf0\xf5\xcb\x07\x1d\x9b\x03\x8d\xf5\x65\x10\xf8\x7a\x39\xdf\x42\x6f\x98\x80\x07\xeb\xb9\xb4\x37\x7d\x62\xd5\x0e\xf8\x5c\x71\x87\x67\x01\xa1\x7b\x3d\xe1\x4d\xeb\x7b\xfa\xbe\xa9\xcd\x2d\x23\x15\xc9\x35\x2c\x91\x1a\x89\xe0\xd9\x08\x1f\x5b\x98\x24\x33\x86\x85\x7d\x7b\x77\x6b\x09\xe7\x40\xc6\xb4\xbd\xdf\xff\xf8\xfa\xe5\x73\x43\x29\x0c\x51\x67\xc0\x2b\xf8\x2f\xfc\xf4\xe7\xaf\xdf\x9c\x88\xef\xfb\x7f\xb9\xa9\x01\x93\x0b\x63\x54\x5d\x67\x2e\x0d\xd5\x71\xc8\xc0\xcc\x3d\x69\xc6\xe2\x14\x7f\xa6\xd3\x1a\x83\xe8\xaa\xe0\xcf\xc6\x9f\x26\x56\x9e\x41\x10\xe7\x07\xfd\x99\x96\x03\x8b\x79\x92\xdf\xbf\x7e\xb9\x3c\x90\xc3\xd7\x3b\xf9\x25\x74\xa9\x03\xdf\x04\x25\xb3\xe0\x06\xa8\xa9\x56\x8d\x5c\x9a\x3a\xd1\xc8\xe5\x9f\xbf\x7e\xc3\x37\x39\xe1\xc3\x7e\x7e\x8d\xd8\xa4\xff\xf1\x60\x16\x30\xae\x8e\x61\x20\x7a\xbc\x84\xd7\x56\xa0\x01\x7a\x79\xaa\x63\x6b\xd1\x00\xf1\x2b\xc2\xa3\x4a\xfb\x6e\xa9\xcb\x40\xb6\x42\x35\x72\x79\xa6\x4f\xaf\x56\x2f\xe5\x7a\x8c\xec\x46\x33\x3f\x17\xca\xda\xc3\x18\x7a\x05\x89\x0b\x38\xce\x52\x0c\xe3\x35\x43\x33\x97\x30\xb3\xaa\x2c\x3a\x16\x05\x34\xd9\xd2\xcb\x19\xa4\xd7\x23\x9f\x93\x7a\xff\xe2\x79\x75\x6c\x85\x64\x18\xf5\x96\xb1\xe0\x7c\xc7\x5a\xae\x00\x9b\xe6\x82\x33\x4d\x7b\xc1\x4f\x7f\xfe\xfa\x0d\xff\xba\x6e\x2c\x16\xf8\x5d\xd6\x62\xc2\xde\x36\x17\x13\xe6\xa6\xbd\x60\x90\xdb\xb6\x82\x21\x3e\x30\x96\x9f\x64\x2b\x96\x48\x2e\x63\x39\xc7\xf1\xe3\xb6\x62\x52\xf9\x84\xb1\x5c\x31\x1c\xc7\x2c\xac\x99\x8b\xc7\xab\x9e\x3b\x7f\x7f\x9d\xe2\x9a\xbf\x34\xe7\x01\x2f\xaf\x20\x76\xff\xec\xd5\xf3\x6a\xe1\x33\x2d\xcf\x7a\xf9\xf3\xd7\x6f\xd6\xd3\x0d\x1f\x6e\x41\x5c\xb6\x2b\x6c\x51\x0e\xc0\xd3\x97\x8b\xe6\x14\xb4\x04\x3e\x33\x18\xdb\x9a\x4e\x5f\x1b\x38\x03\xb1\xad\x09\x84\xae\x68\xe4\x3f\x40\xe2\xf1\xa6\xb7\x37\xaa\xc2\xee\xd9\x3c\x28\xce\x15\x79\xd3\x6e\x4c\xab\xb9\xd0\xf1\x99\x26\x64\xa1\x3e\xb3\x22\xbf\x0d\xf9\x6c\xe6\x7c\x90\xf7\xbb\x04\x77\x60\xcb\xa3\x48\x89\xd4\xc8\x21\xd4\x4e\xb3\x63\xcb\x01\x3c\x01\x3f\x84\xc1\xf7\xe3\x1f\x5f\xfc\x34\x4e\xc3\x3e\x59\x97\x8c\x98\x8b\xb3\x38\xe2\x19\x38\x18\xa6\xf9\xab\x04\xf7\xda\x88\xa7\xd7\x0f\x0f\xbe\xe8\x35\x00\xbf\x3e\x04\x7f\x31\x2f\x9f\x0b\x3e\x46\x38\x9e\x81\x0f\x1e\xa9\x70\xf6\x85\x95\xab\xe0\x63\x04\xef\x59\xf0\xc2\xda\xeb\x2e\x78\xf4\x02\x5e\xcd\xd1\xa3\x7b\x44\x73\x09\xf6\xcc\xf0\x0c\x4d\x3c\x3b\x78\x7e\x8f\x7a\x66\x12\x56\x45\xba\xf2\x63\x7f\x7c\xb9\x5c\x03\x98\x82\xbd\xae\x05\x5e\x4f\x82\xd8\x6b\x5f\x41\x7b\x10\x79\x02\xb7\xbe\x06\x02\x5e\x9d\x6a\xe8\x98\x29\x0f\x4e\xe9\xe0\x23\xe6\xc8\x20\x7f\x1a\x63\x5a\x18\xc8\x83\xac\x6b\xcf\xe7\x0d\x49\x54\x54\x79\x0b\x99\x96\x95\x6f\x0c\x73\xbd\x42\xbd\x3f\x5d\xd2\x81\x1f\x11\xe2\x48\x05\x8f\x63\x19\x59\x0b\xde\x2c\x6f\xe9\xc8\x5f\xde\xfc\x32\x34\xf8\x06\x78\x89\xc3\xc7\xb0\x9f\x41\x50\x93\xcf\xa6\xb1\x00\x20\x51\x96\x35\xee\x1e\x46\x15\xee\x80\x78\xfa\x02\x29\xe7\x62\xa6\x0b\x38\x8c\xae\x95\x86\x79\x4d\x20\x51\xbc\x40\x22\xef\x10\xd8\xfe\x83\x14\x95\x97\x96\x2d\x63\x76\xf9\x0c\xe2\x89\xe8\xd3\x15\x90\xa2\x2c\x21\x8d\x94\xb4\x67\x10\x8d\xc4\xb2\x3e\xa0\x33\xd9\x44\x72\x3f\x81\x82\x4c\xf3\xda\xe1\x19\xc4\x92\x69\x7f\x3e\x92\x85\x2d\x54\x9f\x41\xd0\xcf\xe3\x99\xff\xc2\x57\x67\x22\x0d\x2a\x98\x6e\x22\x75\x86\x47\x23\x29\x5e\xe0\x8f\xc6\xed\x4c\x97\xe4\x73\x34\x84\x3f\xdd\xe0\x2f\x0d\x00\x9e\x8b\x18\x65\xd1\x33\xc0\xab\xab\xe7\x10\xba\xc2\x90\x1a\x8e\x76\x18\xdf\x63\xc1\x50\xb7\x65\xf7\xbd\x1a\x1e\xfa\x42\xcd\x99\xa3\xef\x4b\x1c\x5b\xe6\x13\xfc\x25\x9e\x25\x33\xc9\x54\xf0\x36\x39\x60\x0e\x3b\x6f\x22\x8a\x46\x33\x14\xcb\x7e\x8c\x08\xf7\xe1\xb7\x31\xc5\x32\x64\x9c\xca\x7e\x8c\xc9\xd5\x1f\xdd\xc4\xc7\xb2\x74\x2c\x9a\x39\xc3\xe7\x79\x77\x3b\x1b\x67\x46\x6a\x35\x60\xd3\x6d\x44\x64\xe9\x21\xe8\xb1\x04\xc7\xf9\x3c\xe1\xc1\xa7\x4a\x8a\xe8\xcc\x21\x5b\x9e\x0b\xaa\xf8\xe8\x07\xee\xdc\x5e\x6d\xd0\xc8\xc9\x28\x00\x01\xac\x34\xeb\x8e\x55\xe3\xd4\xb3\xdb\xc1\x02\xc7\xf9\x45\x48\x4d\x53\x1f\x82\xa7\x25\x7b\x49\xde\x05\x9f\xc0\x19\xce\xc7\x08\x8d\xd0\x43\x70\xc7\x33\x1a\x17\x7c\x02\xff\xf5\xeb\xb7\x13\x13\xef\xff\xfc\xaf\xc7\xaf\xf7\xc8\x4b\x43\x9f\xc4\x75\x07\x7f\x49\x96\x70\x6c\xed\xbc\x0b\xfa\x90\x55\xdc\x00\x7c\xdc\x05\x63\xd1\xe8\x3f\x83\x1e\x9e\x6e\x75\x56\xe7\x1d\xdb\x15\x09\x6c\xde\xe1\x83\x41\xf4\xeb\x97\xf3\xce\xde\xb1\x2a\x06\xe2\x5b\x9c\x0e\x3f\xab\xf3\xf5\x77\xa8\x2e\x8a\x37\xa3\x1e\x1d\x59\x33\xae\xb9\xbd\x1a\xf8\x08\xbc\x70\xb1\xb7\xae\x2c\x2b\x28\x02\x4a\xb2\x14\xd4\x00\xde\x15\x0d\x76\x1c\x54\x21\xd0\x38\x52\x03\x3c\xc2\x9b\x4d\x62\x6f\x81\x9b\x84\x3c\x1b\x70\xaf\x84\x58\x2e\x7d\x8c\xea\xd3\x51\x16\x3c\x04\x1d\x6a\xd8\xc9\x3f\xdd\x8c\xbc\xdc\x8c\xa9\x78\x3e\xb3\xe4\xa9\x1e\x67\x5c\xf6\x67\x84\xe6\x74\x69\xed\x09\xd8\xc5\xdd\x35\x71\x3d\xfa\x24\xf2\x7b\x5e\x7a\xf8\x76\x3d\x06\x77\x61\x2f\x98\xb1\x61\xcb\xcb\x08\x9e\x16\x18\xc9\xc6\xc6\xc0\x60\x3e\x88\xa3\xd6\xae\x84\x42\xd0\x0b\xef\xb0\x6e\xee\x3a\x0b\x23\x9d\xa6\x21\x42\xc1\xaf\x5f\xce\x26\x60\x3e\xd4\x45\x3f\xea\xd2\x07\xa8\xed\x03\xcd\x1e\xd4\x5f\xae\x41\x33\xa4\xb4\x84\xaa\x0b\xd8\xa9\x1b\x00\x2e\x6c\x71\x23\xf1\x8b\x97\x3e\xf6\x7e\xf8\xae\x3a\x23\x9c\x15\x1c\xe2\xfd\x6b\xcf\xc6\xae\x3a\xd2\x15\x0d\x0f\x81\x20\x11\x8b\x46\x83\x7f\xb8\xb9\xc2\x92\x92\xee\xe0\xb4\x5f\x30\x03\xad\x39\x79\xb4\x43\xe4\x6e\xd4\x76\x48\xfb\xb4\x7d\xed\xf1\x9a\xd0\x27\x52\x3b\x48\xae\x25\x88\xd0\xf9\xa4\xc3\x16\xc2\xf8\x1d\xa1\x65\xfc\x8d\xa2\xf3\x42\x1f\xa8\xd5\x2c\x7c\xba\xd8\xed\xeb\x17\x3f\xf0\xfb\x5d\x6d\x96\xb9\xd2\x5e\xfd\x9f\x64\xfa\x74\x5b\xc5\x84\x9e\x41\x97\x5a\x41\x5a\xfb\xe2\xab\xfa\x8f\x9a\x85\x7d\x1f\xbd\x2b\xdf\xb4\x03\x73\x09\xa7\x28\x63\x5b\x3d\xad\xee\x10\xff\xdf\xc3\x7f\x32\xa1\xc7\xff\x44\x44\x04\xee\x21\x7d\x6a\xb6\xf6\x92\xcf\xef\xd1\x3f\x1e\xfd\x86\xe1\x42\xf5\x06\x92\xb9\x9c\xbf\xb6\x3e\x30\x63\x4f\x73\xf2\xe0\x4a\xe4\x72\x9f\x68\x40\x57\x90\xc5\x3f\x42\x86\x37\xf2\xdd\x85\x29\x96\xcb\x7d\xda\x65\xdc\x2c\x66\xdf\x08\xee\x2d\x78\xa9\xbd\x7b\x3f\x48\xf5\x00\xb7\x50\xf2\xb5\xf5\x5f\xcd\xc4\x88\xb9\x35\xd8\xec\xe2\xbf\x81\xa0\xa6\x92\x12\x62\x65\x55\x0c\x3e\x83\x20\xa2\x49\x01\x3e\xc4\x1f\x83\xae\x0e\xd1\x43\x46\x97\x7e\x26\xa1\xd8\x75\x42\x17\x3e\xa0\x75\x89\x16\x36\x5c\x67\x43\x29\x78\x3d\xa7\x2d\xc8\x08\x22\xed\x21\x18\xf1\x7d\xc7\xe2\xb4\x0d\xd5\x3b\xb0\xf9\x88\xf9\xb0\x79\x89\x65\xf0\x19\x3c\x58\x90\x18\xf1\x0c\x84\x4f\x6c\x58\x57\xe6\x3d\x3c\x46\x04\xc8\x6a\x8f\x80\x70\x65\x19\x03\xbe\x87\x47\x6b\x0c\x09\x42\x20\xf8\x4f\xc3\x29\xba\x91\xcd\x2f\x23\xd3\x64\xc5\x8b\xcb\xbc\x0e\xc6\x8b\xec\xaa\x3e\x2f\x7c\xfb\xeb\x92\x3e\x2d\x2e\xf0\x5a\x98\xa4\x95\x20\x4b\xea\x82\xe6\x1d\xcb\x61\x8d\x8b\xf8\x6e\x7b\xdb\x8b\x19\x5a\x0f\xfc\x72\x52\xad\x81\x3c\xe0\x29\xe4\x29\x60\xae\xfa\x05\x23\x46\x62\xd8\x5c\x15\x7e\x34\xee\x6f\x77\x79\x17\x5d\x15\x3e\xc6\xe0\xaa\x4e\x81\x97\xd6\xc1\x47\x6b\x4c\x8b\x77\xc3\x07\x9f\x4e\xa1\x42\x17\x20\xbe\x93\xe5\x63\xc4\x3e\x63\x71\x10\x23\x95\xbe\x85\xd7\x82\x22\x05\xcd\x03\x75\x5b\x16\xe3\xed\x21\x88\x47\xa4\xc1\xeb\x75\x67\x7d\x42\xe0\x2f\xa8\x38\xc6\x85\xd9\x5b\x6b\xb8\xaa\x55\x63\xa9\xcb\xee\xe8\x78\x01\x3e\x04\xef\xb9\x04\xe0\xf6\xf9\x7f\x6f\x93\xc3\xf1\x9f\x89\x0e\x7d\xb1\x42\x1c\xf5\x71\x77\x62\xee\x61\x0d\xb2\xd6\x87\x5d\x0b\xe5\xc8\x03\xe8\x52\x1e\xfe\xab\x42\xfc\xd1\xeb\x67\xbc\xf7\x27\x62\x3e\x7b\xf3\xb1\x33\xe7\xe9\x81\x91\x53\x91\x90\x09\xe8\x4b\x74\x15\x78\x7f\x8c\xfc\x6a\x84\x02\x1f\x82\x1e\xed\x81\xc8\xb9\xac\x5e\x51\xb1\x46\xf1\xd7\x0a\xae\xe8\xd4\xcc\xb2\x74\x69\xbc\xe0\xdb\xf6\x35\x78\xd2\xa3\xf1\xf6\x03\xfa\x33\xca\xbb\xb5\x67\x24\xe0\x51\xea\xef\x7f\xdc\xa3\x41\x03\xfc\x3e\x1d\x9a\xa0\x9f\xd6\xa2\x51\xfc\x5c\x7b\xf8\xa3\x0a\x17\x75\x87\x33\x2c\xcd\x91\x0a\xff\x1a\x30\x3e\xcf\x60\x69\x8d\x54\xf8\x1f\xd0\x19\xa9\xf0\x6e\x8d\x91\x0a\x7f\x8f\xa6\xf0\x77\x1f\xee\xd2\x13\x06\xfc\xb4\x96\x48\x85\x3f\xd7\xd1\x69\x2f\xff\x65\x55\xb9\xf2\x2d\x8d\x9d\x52\xdc\xf7\x29\x3b\xfa\x3b\x25\xfd\x80\x1a\x4f\x48\xdc\xda\x3c\xa5\xde\xa3\xd4\x13\xf4\x7d\xba\x75\xc1\x7f\x5a\xc5\x27\x1c\xe7\x9a\xb6\xee\xee\xbd\xac\x66\x3b\xd3\xd2\xb1\xf5\x6a\xdf\xc7\x7b\x6a\xd3\xd6\xfb\x0f\xa8\xd6\xc2\xe0\xd6\xab\x95\x74\x8f\x52\x2d\xd0\xfb\x34\x6a\x03\x7f\x5a\x9d\x16\x82\x73\x5d\xd2\x48\xb9\xac\x47\x9c\x61\xe9\x90\x46\x8a\x75\x61\xae\xa5\x3b\x1a\x29\x3f\xa0\x37\x1a\x29\x6e\x9d\xd1\x48\xb9\x47\x5f\xf8\xc2\xd6\xbb\x74\x85\x01\x3f\xad\x27\x1a\x29\x17\x74\x64\x5c\x89\x75\xa5\xf7\xb0\x33\x6d\x5d\x99\xaf\xf6\xe5\x69\x27\x7b\xb3\xde\x7f\x44\x6f\xd6\x75\x7e\x6e\xdd\x99\x49\x77\xe9\xcf\x04\xbd\x53\x87\x16\xf0\xe7\xf5\x68\x22\x38\xd7\xa5\x75\x07\xe5\x15\x65\x3a\xb9\x96\x36\xed\x77\xe7\x4e\x4f\xe4\x9c\x82\x36\x6f\x73\x74\xcf\x2d\xf0\x9d\x0f\x57\x6e\x79\xb4\x2b\xc1\xc6\xf2\x03\xb5\x60\xa3\x70\x9b\xb0\x8e\xa0\x6a\xdc\x44\x6b\x5f\x44\xeb\x55\x2f\x00\x97\x98\x72\x23\xb8\x94\x7f\x4f\xa5\xda\xcc\xdc\x57\xab\x0e\xf4\xa7\xab\xd5\xc6\x10\xbc\x31\xb2\xfe\x69\xf3\x8c\x2d\xfe\x7e\x96\x71\x84\xde\x3a\x33\x7e\x7d\xa6\x71\x27\x3e\xb8\x0b\xab\xe4\xce\x19\x2a\x7e\x84\xd5\x82\xbb\x6f\xf2\xe2\x60\xb7\xbf\x3c\xf7\x21\xd3\xf6\xf5\xb4\x77\xe2\x36\x42\x06\xc6\x09\xc2\x0f\x31\x9f\x40\x3f\xc0\x7f\x6d\x16\x74\x7f\xe0\xcd\x1c\x30\x5e\x8f\x94\x7b\xbe\x53\xf6\xe9\xd0\x9b\x35\x80\xf6\xec\x32\xfc\x98\x37\x3c\x4c\xbb\xce\x99\xeb\x63\x63\x9f\xe6\xcb\x18\xa4\x7a\x23\x82\x1f\xb3\xe5\x1a\xda\x5c\xe7\xee\xfc\xeb\x2e\x9f\x66\xf2\x44\xef\xfb\x79\xb5\xc7\x0d\xd7\x19\xf5\x7d\x21\xe3\xd3\x5c\x5a\x94\xbe\xbb\x92\x71\x8f\x7d\x9d\x3b\xd7\x07\x02\x3e\xcd\x99\x31\x5e\xf1\x2a\xee\xee\xb0\xaf\x75\xef\xfb\x83\x73\x2d\x3c\xf8\xe6\x8f\xda\x5a\x39\xe6\x52\x05\xc7\x2f\xb9\xe0\x0f\x05\x6e\x3d\xe8\x44\xc8\xf0\xba\xf8\x99\xe5\x8f\x6b\x18\x05\x79\xf7\x01\xba\xf3\xf8\xed\x97\x6b\xa0\xce\xb7\x17\x83\x9f\xf2\x3d\xf6\x20\xe3\x46\xf5\x7b\x6f\x56\xfe\xbc\x09\xd8\x43\xaf\xef\x34\x4e\xa7\xbf\xbc\xce\xa2\x3d\x64\xf8\x61\x1e\x6d\x5a\x16\x93\x4f\x5f\x6e\x0f\x3e\xcc\x55\xc7\xfb\x45\xb1\x3b\x4d\x33\xbe\x72\x5d\x1e\xeb\x40\x86\xe7\x52\xd6\x4f\xcb\x64\x11\xf5\xe9\xfd\x46\xeb\xbb\x7c\xb1\xa9\x0b\xc0\x5c\x2a\xb1\x2e\x22\xe5\x25\x5a\x85\x24\x82\xc8\xfe\xe6\xc7\x35\xcb\xb6\xae\x6f\xb9\xbd\x04\x69\x21\x65\xe0\x77\x21\xfd\xa0\xf5\x59\x48\xf1\xf9\x22\xf0\xfa\x0a\x02\x2d\x99\x36\x36\x1d\x04\x6e\x63\xbd\xab\x11\x7e\x77\xab\x73\xdd\x2b\xf4\xe1\x09\xb4\xbf\x64\xb9\xcd\xe2\xce\x64\x8e\x96\x25\xa4\xd9\x47\xef\xf1\x2e\xbb\x6f\x91\x77\x6b\x97\xae\x99\x65\xed\xbe\xfb\x33\x02\xf7\x1a\x94\x98\x87\x8b\x77\x2a\xe0\xb3\x2f\xb4\xae\xaa\x50\xd2\x06\xb2\x8e\xed\x78\xc7\x4b\x8c\xbc\x8b\x08\x96\xa6\x8d\xfd\xf0\x4e\x80\xdf\xc4\xac\x62\x48\xd5\xda\x45\x37\xd1\xa1\x51\x52\x75\xe6\x0f\x46\xb6\xe7\xc4\x18\xbe\xb7\x04\xb7\xba\x20\x11\x7c\x02\xa4\xc0\x93\x08\x3f\xe3\xf6\x82\xac\xdb\x9a\x82\x4f\xc0\xd1\xf4\xf3\x47\xc7\xff\x1e\x9f\x1c\x7d\xd9\xdb\x05\x9c\xa3\xfd\xf8\xc3\x80\xef\x4f\x17\x28\x1b\x80\x04\x75\x08\xbb\xb6\x08\xdd\x22\x6a\x1d\xea\x3d\xed\xf8\xbd\x48\xfa\x7c\x43\xb0\x8b\x97\xf3\xcc\x0f\x99\xc3\x87\x9e\xd1\x3d\x7c\x9d\x0e\xc7\xff\x98\x36\xac\x83\xa3\xf7\x90\x74\x1d\x5b\xfe\x11\xa2\xb6\x0b\xfd\x80\xde\xe9\xe8\xe3\x0f\xd0\x32\x36\x0b\xdd\xa4\x75\x3a\x52\x74\x93\xcc\xd3\xcf\xaf\x6d\x3c\x0d\xbd\x5d\xd5\x78\xd9\x1c\xfd\x45\xbc\x3d\xd9\xd7\xa1\x18\xfc\x1b\xcf\x57\xd8\xfd\x8f\x9b\x3c\x7a\x36\x27\x3d\x5a\x3e\x0a\x80\x3f\x3c\xbe\x6a\x4b\xaa\x80\x54\x14\xf0\x7a\x16\x6b\xc0\xc7\x85\x82\xbf\x90\x8a\x72\x72\x94\x46\xdc\x01\x73\x75\xa7\xeb\x34\xdc\x8d\xfa\x6c\x79\x25\x8b\xee\xd7\xb3\xeb\x67\x5c\x97\xe7\x18\xf3\x3e\xc0\x92\x8c\xf1\x6d\x32\x1c\x4f\x81\xfb\xd7\x40\x38\x66\xdf\x96\xc3\xf0\xa4\x20\x2f\xad\x4b\x70\x38\x9e\x61\xa0\xf4\x1a\xc0\xdb\x38\xcd\x1b\x76\x4e\x41\x17\xeb\x7b\xdb\x67\x97\x0e\x19\x04\xc2\x26\x1a\x73\xce\x19\xde\xdb\x70\x97\x20\xf1\xfa\x2a\x94\xec\x5b\x70\x2e\xc3\x98\x5d\xa1\x0b\xc4\x7b\x6d\xe8\x29\xde\x10\x78\xf3\xde\x08\x79\xba\xf0\xce\xbc\x7a\xc7\x9c\x3e\x3b\x97\xa2\x19\xab\xd5\x01\x43\xed\x61\x86\x47\x22\xef\xa0\xb3\x14\x60\x1c\x05\x78\x0d\x14\x0d\xb8\xb7\x2f\xe7\x17\x67\x9d\xab\xe9\xed\x37\x63\xf3\xec\xd7\xf3\x4b\xa9\xbc\xb7\xdd\xf9\x2e\x0e\xba\x2c\x38\x0e\x14\x78\xc5\xb6\xbf\x9c\x7e\x7e\xe5\x98\x55\xd0\x15\x16\x13\x78\xe7\x3b\x28\x56\xa6\x6f\x9d\x35\x00\x8c\xcf\xa3\xb8\x6e\x3a\xf5\x7c\x0d\xe7\x43\xf6\xce\x3e\xec\xfe\x81\xbe\xed\xbb\x02\x9d\xd1\xff\x65\xdd\xbf\x19\xfa\xfe\x40\x5d\xae\x17\xe7\xd1\x7a\xf8\xb9\x26\xef\x0e\x86\x59\xa2\xfe\x3f\x7b\xff\x1f\xb3\x77\x17\xc8\x29\xea\x74\x76\x1d\xd7\x05\x40\x6b\x3d\xf2\x23\xb0\x53\x88\xe4\x1e\x68\x2b\x54\x71\x0f\xa8\xb5\x6a\xf2\x21\xd8\x69\xb1\xe0\x23\x50\x7b\x9a\x77\x09\x96\x4b\xbc\x0d\xac\xe8\x23\xb0\xe6\x61\xbe\x8f\xff\xf8\xef\xad\x3c\x9f\xda\x05\xde\x3c\x57\xd3\x7d\xda\x03\x7c\xe8\xa2\xfc\x57\x86\x9e\x85\x7b\xf1\xf7\xaa\x11\xaf\x01\xdc\xb1\x7a\x3c\xd2\xe7\xb0\x5f\x0c\xfe\x5a\xdf\xc4\x1e\x90\x3b\x5b\x61\x3f\x8f\x92\x2f\x10\xec\x22\x65\x57\xd2\xcf\xa1\x75\x16\x18\xb6\x28\x8d\x9c\x74\x3f\x9d\xbf\x81\x77\x7e\x21\x70\xaf\xf6\xf6\xe5\xcb\x0b\xc1\x69\xa2\xf0\xf6\xe5\xff\x1f\x00\x07\x58\x87\x66\x60\xc3\x00\x00")
 
 func staticReport_templateHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -378,12 +443,12 @@ func staticReport_templateHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/report_template.html", size: 31485, mode: os.FileMode(436), modTime: time.Unix(1627482565, 0)}
+	info := bindataFileInfo{name: "static/report_template.html", size: 50016, mode: os.FileMode(436), modTime: time.Unix(1792397207, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticReport_template_localHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x67\x7b\xeb\xb6\xb2\x30\xfa\x3d\xbf\x82\x47\x49\x8e\xec\x23\xcb\x24\x45\x55\xc7\xf6\xf3\xaa\xcb\xea\xbd\xe5\xcd\xcd\x06\x49\xb0\x48\x24\x40\x91\x20\x29\x69\x3d\xeb\xbf\xdf\x87\x4d\x56\xb5\xbd\x92\xec\x73\xf7\x87\xeb\x64\x59\x22\xca\x34\x0c\x06\x83\xc1\x80\x7e\xfe\x2f\x11\x0b\x64\x67\x40\x4a\x21\xba\xf6\xfa\xd3\xb3\xf7\x41\x69\x00\xc9\x2f\x31\x88\x62\xaf\x3f\xfd\xf4\xac\x40\x20\xbe\xfe\x44\x51\xcf\x3a\x24\x80\x12\x14\x60\x5a\x90\xbc\xc4\x6c\x22\x25\xf3\xb1\xf7\x0a\x04\x74\xf8\x12\x73\x54\xe8\x1a\xd8\x24\x31\x4a\xc0\x88\x40\x44\x5e\x62\xae\x2a\x12\xe5\x45\x84\x8e\x2a\xc0\xa4\xff\xf0\x40\xa9\x48\x25\x2a\xd0\x92\x96\x00\x34\xf8\xc2\x3e\x50\x96\x62\xaa\x68\x9d\x24\x38\x29\xa9\xe4\x05\xe1\x0b\xc0\x22\xb4\x04\x53\x35\x88\x8a\xd1\x11\xec\xe2\xc6\x06\x04\x23\x48\x0d\xa1\x8f\xf5\xbc\x17\xb0\x89\x82\xcd\xa3\x0e\x1d\x55\x50\x00\xd4\xa8\x06\x44\xa6\xba\xb6\x20\xa2\xee\x14\x42\x0c\xeb\x89\xa6\x89\xab\x12\x68\x3e\x0a\x58\xa7\x75\x55\x50\xa2\x06\xf7\x17\x40\x65\x88\xa0\x09\xc8\x09\xdc\x03\x21\xce\xb7\x6f\x8f\x53\x68\x5a\x2a\x46\xdf\xbf\x5f\x74\x35\x31\x8f\x89\x75\xd4\x0f\x61\x15\x89\x70\xfb\x40\x21\x2c\x61\x4d\xc3\x6e\xd0\x85\xa8\x44\x83\xaf\x67\xdc\x3d\xd3\x41\xb1\xd7\x40\x53\xd1\x9a\x32\xa1\xf6\x12\xb3\xc8\x4e\x83\x96\x02\x21\x89\x51\x8a\x09\xa5\x97\xd8\xca\xfa\x53\xc3\x02\xd0\xfe\x94\x54\x0d\x5a\x34\x8f\x31\xb1\x88\x09\x8c\x47\x5d\x45\x8f\x82\x65\xc5\x7e\x14\x82\xa3\x5a\xa7\x7d\xfd\x1e\x94\xa7\x36\x2f\x31\x02\xb7\x84\x8e\x6a\x28\x4a\xc2\x98\x40\x93\xfa\xe6\x3f\x50\x14\x8f\x4d\x11\x9a\x49\x82\x8d\x27\x8a\x35\xb6\x94\x85\x35\x55\xa4\x4c\x99\x07\x77\xcc\x03\x15\xfc\xff\xc8\xa6\x32\xf7\xbf\x85\x1d\x74\x60\xca\x2a\x0a\x3a\x64\x18\x63\x1b\x95\x1b\x40\x14\x55\x24\x9f\x16\x7a\xb8\x93\x40\x53\x65\xf4\x44\x09\x10\x11\x68\x46\x35\x12\x46\x24\x69\xa9\x7b\xf8\x44\xb1\xa9\xf7\x0e\x02\xd6\xb0\xf9\xe4\xe1\xbf\xcb\xe6\x1f\xa8\xe0\x5f\x88\xfb\xfb\x4f\xc7\x0c\x80\x03\x0b\x61\x1f\x15\x29\xd0\x54\x09\xf5\x5f\xaa\xee\x0d\x06\x40\xe4\x84\x0a\x11\x0a\xd8\x04\x9e\x7a\x3e\x51\x36\x12\xa1\xa9\xa9\x08\x9e\x00\x7e\x14\x80\x89\x6d\x0b\x6a\x07\xc8\x21\xaf\x3c\x26\x04\xeb\xc7\x9c\x9d\xf7\x48\xaa\x04\xea\xe7\x04\xfd\xcc\xe5\x39\x31\xcd\x7e\x26\x8b\xeb\xb0\x1e\x0d\x20\xc3\xa4\x00\x4c\xf1\x00\xd6\x9f\x9a\x4f\x54\xfa\x96\x80\x35\x28\x91\xd3\x51\x7a\xa2\x52\x19\x63\x4b\xb1\x8c\xb1\xa5\x32\xd1\xb7\xa8\x89\xa8\x5a\x86\x06\x76\x9e\xe0\x3c\x51\x24\x79\x0d\x0b\xeb\x53\x92\x2c\x15\xc9\x1a\x4c\x06\xa4\x60\x44\x80\x8a\xa0\x79\x44\xda\xc3\xe7\xcd\x3c\xe3\x04\x4d\x2b\x49\x00\xaf\xc1\x33\xc1\x3e\x51\x1e\x61\x3e\x71\xe1\x97\x53\xf4\x3e\x00\x4b\x30\x21\x44\x96\x82\xc9\x11\xec\x08\x8e\x81\x2d\x35\x18\x52\x13\x6a\x80\xa8\x0e\x8c\xb8\xc3\x0e\x34\x25\x0d\xbb\x4f\x94\xa2\x8a\x22\x44\xbf\x9d\xea\x7b\x34\xa4\x5f\x50\xf9\x1b\xd4\x1c\x68\x20\x26\x40\x11\x15\xfe\x77\x09\x9b\x3a\xf5\x98\xb1\x28\x08\x2c\x98\xc4\xf6\x61\x50\x04\xdb\xb4\x3c\xc5\xd8\x63\xac\x27\xd5\x03\x49\xe1\xb8\xb2\x0c\xf3\xeb\x0d\x8d\xf0\x18\x37\xb1\x96\x34\x4c\xe8\x3c\xdc\xa8\x43\x70\x4b\xce\x55\x25\xf3\x15\x80\x49\x55\xc0\xe8\xdd\x1e\x00\x61\x2d\x9b\xd8\x46\x62\x52\xd5\x81\x0c\x9f\x28\xdb\xd4\xee\x62\x22\x20\xe0\xc9\x2f\xa0\x2d\x47\x4e\x6c\x75\xed\xe1\x57\x4e\xb0\x1c\x99\xda\xea\x1a\xb2\x5e\xe2\x9e\x91\x7e\xa2\x69\xd7\x75\x1f\x5d\xee\x11\x9b\x32\x9d\x62\x18\xc6\x6b\x1c\xa7\x24\x55\xd3\x5e\xe2\xbf\xa6\xb8\xac\x90\xcb\xe4\xc4\x38\xe5\x2d\x42\x25\xbc\x7d\x89\x33\x14\x43\xe5\xa9\x7c\xfc\x57\x0e\xfe\xca\x09\x06\x20\x0a\x25\xbe\xc4\x3b\x99\xc7\x54\x86\x62\xb4\x64\x9a\x0a\xfe\x63\x1f\x33\x49\xef\x5f\x2a\xf8\x47\x85\x9f\xc9\xb0\x7c\x1f\xa7\x03\x00\x1e\xba\x5f\x39\x18\xbb\xff\x84\x6d\x4f\x56\xff\x81\x6c\xa7\x1e\x73\x3e\xdb\xec\x63\xc6\x63\x99\x3a\x62\xd5\xff\x1e\x95\xa7\x93\xfe\x7f\x5f\x66\x5b\x45\xa2\x2a\x78\xeb\xa1\x45\x69\xea\x35\x96\x23\x83\x15\x10\x7a\x0a\x85\x07\xa2\x7c\x3e\x71\x93\xa6\x2a\x2b\xe4\x89\xca\x5c\x9d\xb1\xd7\xa7\xfc\x4d\x2d\xbf\xd2\x87\xbc\x1b\x3d\x7f\x9d\x90\x80\xae\x6a\xbb\x27\xaa\x88\x30\xda\xe9\xd8\xb6\xa8\xbe\x89\x1f\xa8\x32\x46\x16\xd6\x80\xf5\x40\x75\x20\xd2\xf0\x03\xd5\xc1\x08\x08\xf8\x81\x6a\xdb\x82\x2a\x82\xb0\x1e\x3e\x50\x6d\x95\x87\x81\xed\xf7\x9a\xe0\x07\xaa\x02\x57\x60\x6a\x53\x23\x80\xac\xb0\xa4\xa4\x7a\x6b\x30\x04\x3a\x35\x85\x26\x38\xae\x29\x63\xdb\x54\xa1\x49\x75\xa1\xfb\x40\xe9\x18\x61\xcb\x00\x02\x7c\xa0\x2c\x68\xaa\xd2\x17\x58\x79\x0c\x0a\x92\x0e\xd0\xec\x23\x71\x60\x53\x4c\xf2\x26\x04\xeb\x27\xca\xff\x48\x02\x4d\xfb\x8a\xf5\xfd\xf6\x97\x0d\xd9\x17\xd6\x33\xd9\x04\x86\xf2\x43\x76\xf6\x62\x58\x29\x4a\x81\x81\x76\xe4\x98\xa3\xd5\xe6\xd8\x6d\x48\x1d\x95\x07\x6c\xfc\x90\x21\xf6\x89\xbc\x42\x1a\xe0\x2d\xac\xd9\xe4\x40\x9a\x8f\x8b\x89\x9e\xbc\xd5\xf1\xe8\xf1\x03\xba\x2f\x55\x34\x10\x8b\x86\x81\xe7\xe1\x24\xbd\xa5\x45\x03\xbb\xff\x15\x0a\x28\x6a\x9f\xf4\x1d\xd0\x27\xaa\x50\x28\x14\x7e\xbb\x3d\x77\x25\xff\xe7\x73\xc7\x2b\xf4\xd3\xc2\x91\x38\x5f\x21\xae\x73\xfa\x68\x98\x58\x36\xa1\x65\x9d\xdb\x81\x80\x25\x60\x13\xfc\xdb\x55\x03\x71\x5c\x13\xad\x49\x97\xec\x72\x17\x76\xc4\x52\xb0\x9b\xd4\xb1\x09\x93\xbc\x4d\xc8\x91\x9d\xbe\xe5\x7d\x7e\xa6\xd9\x3f\xbf\x2f\xdc\x1d\x2c\x02\xed\xf6\x72\x7e\x65\x58\xa2\x75\xdb\xc0\xea\xb1\xdb\x46\x51\xcf\xb4\xef\x68\xbf\xfe\xf4\x4c\x07\x9b\xb0\x9f\x9e\x79\x2c\xee\x7c\x17\x1c\x01\x87\x12\x34\x60\x59\x2f\x31\x04\x1c\x1e\x98\x54\xf0\x91\x84\x5b\x03\x20\x31\xa9\x8b\x51\x81\x08\xcc\x35\xc5\xcb\xfe\x67\xe8\xa4\x3f\x83\xd3\xbe\x49\xde\x04\x48\x8c\xbc\xff\x9f\x63\xaf\xc5\xc1\xa4\x38\xee\x75\xab\xcf\x34\x08\x7b\x84\x82\x3a\xed\x46\xb0\x2c\x6b\xd0\x8c\x85\x5b\x81\xa0\x4d\x8c\xf2\x96\xb5\xb0\xee\x25\x26\x60\x4d\x03\x86\x05\xa3\x62\x60\xca\xde\xf6\xf1\xe7\x00\x44\x07\x22\x3b\x16\xca\x01\x98\x2a\x88\xd6\x50\xeb\xb4\x45\x50\x17\xb0\x06\xc5\x97\x98\x04\x34\x0f\xa2\x5f\xaa\x01\xde\xdb\xc5\x8c\x7d\x7c\x1e\xd3\xaa\xec\xdb\xe2\x90\x57\x6f\xbb\x62\x80\x1b\x94\xfb\xab\x74\xec\xf5\x99\xf6\x9a\x84\x9c\xd2\x01\x1b\xaf\xc1\xc8\x3e\x8b\xea\x41\xd0\x11\x2b\x91\x64\xdf\x59\x53\xc5\x08\xb2\x4f\xee\x01\xb3\xad\x9d\xe1\xf5\x86\x4d\x37\x93\x9e\xe2\x1e\x5a\xf9\x9b\xb1\xa3\x76\x81\x87\x2e\x9a\xd8\x10\xb1\x8b\x8e\x9a\x9d\x0d\x5c\xd2\xdf\xc2\x45\xed\x42\x96\xde\x07\xd1\x27\xca\x53\x43\xab\x12\x81\xa2\x4c\xac\xdd\x1a\xa7\x03\xbe\x23\x74\xe1\x98\x28\xc0\x32\xb0\x61\x1b\x2f\x31\x62\xda\xf0\xc6\x60\xbc\x9e\xf4\xeb\x7b\x78\x8f\x09\x8f\x14\x29\x7c\x3c\x92\xea\x81\x01\xfd\x7d\xa4\xfd\x31\xd5\xa0\xc8\xef\xce\x59\x38\x45\xf3\x2e\x8f\x03\x14\x4f\x78\x07\x21\xd0\x7e\x67\x3a\x58\xea\x62\xaf\x23\xff\x33\x20\xee\x8c\xa2\x2f\xc3\xe2\x77\x49\x4b\xd5\x55\x0d\x98\x2a\xd9\xc5\x5e\x4b\x3b\x6a\x74\x78\xfc\x1b\x30\x15\x6c\x11\xcb\x07\xd7\xf0\xbe\x9d\xcb\x8b\x16\x55\xe7\x48\x5f\x68\x4d\xfd\x50\x7b\x3e\x51\x9a\x73\xfc\xbe\x59\x8e\xbd\xd6\xbd\x8f\x13\xcc\xc7\x88\x9e\x69\x5b\x8b\xa6\x48\x48\xcd\x33\x8d\x80\xe3\x4f\x94\x67\x1d\xa8\x28\x54\x2f\xef\x6b\xec\x7d\xce\x84\x8b\x7d\xa0\x8f\xc0\x30\x22\x1b\x64\x62\x9b\x78\x7e\x8b\x0a\xdd\xd7\x67\xfa\xf8\xc9\x87\xec\x41\x09\x40\x87\x3b\x72\xaf\x7b\xf0\x35\x82\x60\x44\x48\xfc\xe5\x48\xb7\x09\x14\xdf\x4d\xd7\x69\x24\x86\xfa\x6f\x5d\x15\x45\x4c\x7e\xa3\x74\x20\x42\xca\x55\x89\x12\xd8\x85\x03\xab\xbe\xa9\xf5\xe7\x38\x36\x9f\x4c\x28\xfe\xe6\xbb\x86\x6e\xb0\x86\xf0\x58\x13\x63\xaf\xff\xad\x40\x60\x12\xeb\xb7\xd0\x5c\x50\xfc\xce\x93\x6d\x20\xca\x28\x8a\x74\x1c\x3a\x7a\x14\xb0\x1e\xa3\x22\x8b\xf7\x27\xaf\x01\xb4\x8e\xbd\x86\x21\xa8\x03\xe2\x43\x28\xca\x93\x3c\x05\x90\x78\x09\x94\xb8\x2a\x89\x62\x53\x96\x02\x35\xcd\xe2\x84\x3f\x2f\x21\xf7\x15\xa0\x53\xa3\x1d\xd5\x51\x91\x3f\x8c\xcf\xb4\x11\x49\xea\xf5\x02\xa6\xb7\xa7\xe0\xed\x9d\x0e\x81\x80\x25\x09\xc2\x8b\xc0\xd7\x25\xfc\x67\x55\x97\x8f\xf4\xca\x32\x85\x97\xe3\x2d\x8c\x81\xe4\xdf\x78\x60\xc1\x6c\xfa\x41\x9d\x96\x7a\x43\x97\x69\xd5\x65\x5c\x2c\x16\x8b\xdd\xd1\x44\xa9\x4e\xe4\x62\xb1\xd8\xf2\x9f\xb5\x72\x71\x51\x2c\x16\x2b\xa3\x75\xa3\xd5\xf7\x0a\xea\xf3\x61\x6d\xd6\x18\x8e\xf9\xd4\x92\x11\x53\xb5\xdd\x72\x50\x2a\x2d\xeb\x05\x75\x39\x2a\x35\xf9\x59\x0d\x2d\xa7\x4d\x6d\x31\x1b\x66\x04\x41\xd3\xbc\x0e\xe5\x5e\xa9\x39\xac\xd6\x26\xb0\x6b\x5a\xf3\x4e\xa1\x3f\xad\x0a\x02\x62\x99\x69\xb3\x9e\x9a\x6e\x2b\x63\x32\x1a\x4b\x55\xe3\x4d\xac\xcf\x60\xa6\x9e\x16\x5b\x4c\x93\xae\x4a\x9b\x6e\x65\xd1\x49\xb4\x58\x20\x94\xe9\x62\x75\xe7\x34\x37\xe5\x46\x41\x7f\x2b\x23\x62\x54\xd6\xf9\xa9\x0b\x90\x21\xaf\x18\xb6\x53\xcc\x2e\x52\xfd\x85\xfe\x66\x58\x56\xab\x63\x70\x7d\xb7\x27\x6d\xb9\x59\x03\xa6\x68\x98\xb2\xf3\xc4\xd4\x27\xf9\xdd\x6c\xce\x43\xba\xbf\xea\x89\xb9\xdc\x9e\x1e\xcf\xfa\xed\x91\xdc\x27\x5d\xb0\xca\x6c\x7a\x56\x51\x6e\xf5\x4a\x64\x5a\xc6\x7c\x11\xb7\xdc\x4d\x4f\x2e\x66\xf9\xd5\x5e\x1b\x8f\x70\x6d\x5e\x9c\xc0\x4e\x77\xda\xaf\xaf\x84\xa2\xdd\x1d\xa8\x9b\xaa\xd8\xda\x4a\xa3\x6a\xb7\xdc\x91\xc7\x6f\xad\xfd\xbe\x04\x6a\xcd\x56\xba\x8a\x8a\x63\x54\x2b\x17\xa7\x6c\x77\xb9\xca\xc9\x95\x5d\xae\x28\xcc\x0b\x6e\x79\xfd\x06\x26\x65\x38\x19\x9b\xcb\x1d\x5c\x25\x52\x7c\x17\x91\xcd\xb8\xa4\x0c\xac\x39\x5f\x5c\xbf\xe5\x7b\xb5\x75\xd3\x85\xb4\x08\xed\x59\x8a\xac\x16\x93\x3e\x57\xa0\x05\x2d\x2b\xcd\xd8\xee\x9c\x27\xa9\xb1\x98\xa2\x25\x6f\xdc\xb3\x29\xcd\x11\xe8\xb1\x9b\xaa\x73\xab\x55\xaf\x93\x5d\xd2\xb3\xc6\xa4\xcc\xce\xc8\x0c\x8d\x0d\x6e\x34\x94\x55\x9e\xac\x27\x3c\x5f\x70\xc8\x14\x70\x74\xab\x64\xf5\x6d\x8d\x36\x13\x18\xf7\x7a\xed\x0c\xb6\x99\xa5\x38\xd3\x8c\xd1\x38\x93\xce\x4f\x04\xa7\xbd\x2b\x80\x49\x9f\xdb\xa7\x3b\xb5\x09\x0d\xba\x4c\x4e\x4c\x64\xf1\x2e\x23\x38\xb3\x04\x93\xed\xd7\x5d\x26\xdb\xef\x28\xc6\x7c\xc1\x15\x14\x53\xce\xb9\x55\xb1\x5b\xb5\x5c\x1a\x32\x25\xa5\x31\x4c\x48\x5a\xba\x5b\x29\xee\x70\x3e\x21\xf5\x67\xf9\x5a\x57\x66\xec\x79\x5b\x5b\x73\xc5\x39\x53\x6a\x65\x65\x69\xaf\x22\x76\xa1\xb5\x0c\x34\x9e\x69\x7b\x2b\x55\xe5\x06\x9b\x72\xca\x5e\x0c\xcc\xe9\x70\x34\xcd\x16\x20\x0f\x90\x93\xb3\x73\xb6\xbb\x94\xb8\xa1\x9c\x67\xb2\xb2\xb8\xb2\xa4\x34\x51\x95\xb9\x25\xb7\x17\x65\xd5\xea\xa5\x85\x37\x31\x5d\xe6\x32\x7b\xc4\x75\x9c\x4d\x8d\xf0\xb3\x94\x91\x83\xac\x35\x2d\xcb\xf3\x29\x5b\x80\x68\x6c\xb8\xe9\x05\x24\x0a\xd9\x54\xa7\x9b\x5c\xde\xde\x38\xed\x1a\x70\x70\x89\xde\x2f\xed\x41\x7e\xe2\x2e\x80\xb8\xde\xa6\xe5\xc1\x5b\xb6\x52\x4d\xf4\xd5\x34\x2b\x6e\x56\x38\xdb\x9b\x59\xc2\xb8\xab\xef\xa5\x69\xaa\xab\x2c\xd6\xed\x25\x2d\x0b\xa8\x39\xe2\xed\xb9\xc0\x75\xf7\x15\xde\x15\xea\xca\x66\xe7\x54\x80\xbd\xc8\xa5\x6b\x64\x9a\x75\x36\xec\x86\x18\xd8\xac\x61\x32\x2b\xf6\xf6\x56\x6e\x32\x1b\xf5\x19\x56\xb0\x35\x76\x9e\x61\xb8\x34\x5b\x98\x4e\xea\x83\x79\x2a\x31\x2d\x2c\x12\x75\x2b\xbb\x6e\x8c\x74\x41\x4d\xdb\x6d\x85\xdb\x6a\xfd\x36\x29\x24\x38\x30\xb0\x4b\xcb\xd2\x7e\xb4\x2e\x55\x46\xd6\x74\x60\x8a\x03\xbe\x35\x1f\xa7\x72\xa2\x93\x83\x70\xd9\x49\x89\x13\x3e\x95\x70\xfa\x53\xe4\x70\x66\xaa\x8d\xd6\xdd\x01\x4b\xe7\x3a\xbd\xd6\x6a\xb8\xe9\xce\x51\x4a\x60\x9a\xf5\xa2\xd8\x19\x33\x09\x73\xb4\x99\xa9\x53\x4d\x9c\xe3\x42\x97\xce\x15\xb2\x85\xb7\x3a\x4b\xaa\xb5\x51\xa6\xb9\x1d\x8f\x78\xc3\x2c\x68\xf2\x8c\x35\xb2\x52\x43\x32\x33\x09\x5a\xc4\xad\xb6\xe0\xd2\xe3\x71\xde\xed\x55\xd4\x34\xc9\xab\x89\x4a\x23\xb7\x32\xf4\x46\xc7\xd6\x31\x93\xd8\xae\xdd\xee\x78\xaa\x75\xc7\xd5\x45\xaf\x52\xdd\x32\x42\x65\xc2\xeb\x69\xab\xcb\xeb\x26\x37\xe7\x80\x2a\xd0\x36\x67\x32\x7c\x69\x59\x17\xf3\x95\x2e\x5a\xa6\x24\xd2\xa8\xa2\xbc\x5b\xe9\x70\xf9\xfe\x7c\x88\x7a\x23\xa9\xa3\xac\xea\xf3\xda\x40\x2e\x95\x5d\x98\xd5\xb8\xb6\xb6\xdd\x90\x4c\xad\xde\xb5\x45\xd1\xe1\xcc\xfd\x30\x9b\x70\xcc\x94\x52\x46\x2b\xbe\x54\xdf\xb3\xd9\x84\xd4\xd2\xd0\x52\xe7\x65\xa7\xb7\x6a\xe1\x5c\xcb\x96\x5a\xf4\x48\x9b\x25\x26\xb9\x59\x3f\xff\x36\x26\xf5\xfa\xa6\x28\x26\x14\x55\xef\x8a\x03\x5e\x48\xd1\xe6\x4a\x2c\x6c\x9c\x2d\xe9\x82\x5c\x62\x85\x56\x25\xc0\x15\x16\xcb\xca\x6c\xdf\x70\xe7\xc2\xa4\x96\x2d\xa1\xc5\xac\x51\xea\xed\xe9\xec\x42\xcf\xae\xf6\x33\x26\xb7\x7a\x13\x55\xae\x5c\x2e\x58\xe6\xdb\xa8\x3f\x13\x0a\x89\x5e\xab\xb7\x9f\x09\xb8\x5e\x16\x0d\x13\x2e\xe4\xa1\x9e\xda\x76\xcd\x71\xa3\x5f\xd5\x0a\x76\x35\xb7\x2b\x8f\x07\xc3\xf4\x9b\xbd\xae\xb8\x73\xb2\x9b\xd3\xb3\x9d\xc4\x15\x51\x4b\xae\xb4\x27\xda\x5e\x1e\x40\x61\xc7\xaa\x69\x65\x85\xd4\x44\x53\xaf\x12\x55\xca\xbb\x63\xa5\x39\x2d\x5b\x9a\x09\x4a\xa3\x62\xa7\x2a\xd3\x45\x46\x1f\xe9\x40\x19\xaf\x5a\x73\x59\xb6\xea\x96\xcc\xe1\x8c\x50\xdb\x95\xa6\x59\xbb\x39\xd3\x12\xfc\xdb\x26\x57\xc2\xae\x56\x5a\xd8\x35\x3d\x2d\xb0\x96\x92\xa8\x6d\x45\x36\x5f\x16\x0b\x0b\x61\xcd\x24\x26\xd5\x52\xbe\x5f\x6e\x10\x47\x6e\x26\x76\x3d\x61\x94\x69\x4d\xf2\x85\x62\x29\xa3\x56\xa6\xdb\xf9\x58\x7d\x13\x94\x9d\x5d\xe5\x86\xda\x90\x6f\x88\x86\xcc\x27\x5a\xb3\x62\x6a\x06\x19\x49\xe9\x0e\x6a\x7d\x75\xd9\x19\x99\x1d\x73\x9a\x49\x48\xbd\xd5\xdb\x6e\xe1\xb0\x13\x30\x7f\x83\xfd\x86\x3c\xd0\xa7\xa2\xde\xec\x0d\xb9\x7d\xb1\x9b\x5d\x4b\x56\x6d\x5d\xd1\x07\xf8\x8d\x6e\x77\x79\x4d\x66\xaa\x70\xac\x3a\x99\x45\xa9\xb0\x2c\x76\xdd\xd2\xbe\xde\xaa\x77\xb6\x9b\x8a\xa1\x14\xb5\x6a\x3f\x37\x60\xeb\xea\x72\x2b\x8d\xcb\xc8\x28\xad\x87\xbd\x86\xd2\x6e\xb6\xb5\x56\xb7\xdd\xad\xab\xed\xfd\xb2\x4a\x9a\x9d\x94\x55\xa4\xd3\xfd\xc6\x6a\xcb\x56\x73\xe2\x8e\x7e\x9b\xe7\x20\x74\x3a\x4b\xa1\x52\xaf\x0c\x15\xbd\xa3\xf0\x72\x85\x38\x66\x5a\xcc\xb3\x75\xbe\x38\xb4\x16\x99\x4c\x87\xad\xe6\x64\x6b\x6c\x6e\x84\x22\xd7\x2b\x33\x23\x45\xae\x35\xd5\x52\x65\xb1\xa4\x87\xf6\x72\x37\xd8\xa9\x0b\xba\x9a\x56\xe4\x7a\x9e\xd0\x23\xd6\x16\xbb\xd8\x2a\x15\xa7\x65\xa2\x0a\x24\x67\x83\x41\x49\x77\xe5\xee\xbe\x6f\x0f\x3a\xab\xee\xd0\xa8\x27\x96\xca\x96\x14\x9a\x93\x6d\x9b\x63\x39\x5a\x66\x13\x72\x43\x4a\x57\xec\xaa\xc2\x8b\xd0\x99\xef\xf3\x93\x6e\x7b\xcd\x6c\x25\x3d\x93\xa9\x34\xea\x46\x2e\xd1\x75\x36\xfb\x46\xaa\xb2\x4f\xaf\xad\xbc\x58\x98\xd6\xf9\x22\xc0\x85\x9d\x98\x68\x15\xf3\x6e\x33\x51\x98\x9b\x22\x9f\xca\xd8\x22\x92\xe9\xdc\x46\xae\x4b\xed\xee\x50\x2a\xf4\xf5\x55\xaa\xdc\xc4\xab\xc2\xbc\xdd\xc1\xdb\x0c\x4f\x16\xad\x8c\x88\x0a\x25\x24\xeb\x53\x89\x2d\xd0\xab\x46\x65\xac\x31\x9b\xf1\x78\x9e\x5e\x2c\x35\x98\xe9\xa3\xb2\xb5\x62\xd3\x83\x44\xa7\xad\xdb\xb3\x44\x73\xdf\x2c\xa8\x52\xd3\x90\x6d\x19\x0d\x4b\x69\xb4\x1d\x32\x2a\xc9\x34\x05\x26\x97\x10\xd8\x04\xbf\x62\x71\xb3\x94\xd8\x0e\x19\x51\x4f\x28\xeb\xa1\xad\xd5\xa4\x19\xe6\x5a\x53\x3a\x35\xd8\x30\xd3\x44\xcd\xa0\xbb\x42\x9f\xb7\x52\x80\x37\x5a\x29\x63\x03\x94\x4e\x51\xc8\x69\x40\x9f\xb1\xb8\xa4\x6b\x10\x4f\xf4\x41\xb6\xca\x6f\xdf\x26\x69\x7e\x30\x75\x9a\x3d\xa0\x16\x52\x55\x00\xc4\x6e\xf9\x6d\x57\x52\x9b\xa2\x42\xd3\xa3\x1a\x5d\xe9\xf2\x1d\xd7\x99\xe9\xfb\x46\x39\xd3\xd7\xcb\x13\x05\xcd\x57\xbd\x1e\x18\xd5\xac\xad\x90\xa9\x68\xa9\xc5\x3a\x05\x24\x89\xaf\xd9\x6c\x86\x2d\xf5\xc5\x45\xaf\xe0\x66\xa5\x59\x59\x12\x57\xbb\xfe\x78\xf3\xe6\xea\x1d\x46\x4c\x25\xf2\xd5\xee\xe2\x6d\x38\x61\x53\x98\x4d\x6c\xd7\x0d\x50\x69\x70\x62\xa5\xf3\x86\xd7\x7d\x07\xa1\xe2\x52\x1e\xbf\x15\xd7\x85\x2a\x1e\x9b\x6b\xbe\x51\xad\xf1\xc2\x70\xb7\xac\xcf\x2a\xb3\xc1\x60\xd9\x9c\xd8\x64\x50\xcd\xd9\x25\x55\xda\xf5\x2c\x71\x3d\x47\x99\x15\x9f\x59\xa6\x84\x41\xa1\xdd\xee\xce\xab\xf9\x3a\x18\xb9\x7b\x85\x6d\x9b\x5a\x61\x33\xda\xeb\xb6\x9e\x5e\x17\xe7\x85\xad\xbc\x32\x77\xa3\xd9\xa0\x9f\x6f\x8f\xba\xd9\x1e\xe0\x3b\x19\xa3\x9c\x32\xaa\x65\x37\xcd\xd6\x69\xae\x53\xb4\x16\xe5\x11\x2c\xcd\x06\xb0\x86\xdd\x6e\x29\xd5\xc1\x4e\x69\xb0\xe9\xbc\x65\x3a\xcb\xfa\x78\x33\xdc\xd4\x13\x2e\x1a\x4d\xcd\x7a\x1f\xec\x66\xd2\x4e\x6a\x0c\xb7\x4c\x6a\x90\x2b\x34\xa5\xbd\x25\x73\x9b\xde\xb2\x60\x56\xed\x3e\x36\xea\x15\x77\xd1\xd6\xec\x32\x24\xc6\x6e\xa5\xf7\x1a\xc5\x44\x79\x94\x83\x25\x7e\x52\x77\x6c\x1a\xa4\x73\x6f\x0b\x61\xbc\x4d\xb7\xb4\x82\x90\x5f\x95\x54\x3e\x9d\x93\x5b\x86\x6d\x97\x47\x2a\x3f\x9c\x32\xec\x98\xe9\x82\xf9\x96\x71\x57\x9b\x76\xb6\x9c\x9f\x97\x64\xa3\x0b\xc6\x7b\x76\xd7\x1d\xcd\x40\x85\x77\x56\xad\xfe\xa6\x96\x2a\x2d\xea\x0d\xb7\x3f\x5f\x59\xa5\xdc\x64\x34\xe2\x4c\x7e\xd5\xa2\xd3\x6c\xcf\x76\x13\xe2\xd8\x5e\x69\x00\x15\x96\xfd\x3c\xe9\x16\xa4\x7e\xb5\xb0\xde\x6b\x13\x2d\x27\x2e\xa4\xad\xeb\x64\x24\x73\xb0\x27\xb3\x9d\x51\xb3\x5a\x4e\xc6\x81\xbd\x55\xb3\x54\x1a\xd5\x52\xd5\x6c\x76\x52\xe8\x8f\xaa\xaa\x5a\x90\xf4\x7c\x2a\x03\xcb\x45\x79\x36\x65\x3a\xe5\xd2\x70\x8f\x45\xd9\x62\xdb\x5a\x66\x56\x77\x5b\xf5\x2a\xdd\x1d\xc8\x8c\xbd\x9f\xe5\x46\x25\xd4\xdd\x4b\x53\x50\x54\x25\x51\x4f\x37\xe5\xbc\xdb\x5b\x99\x4d\x4b\xdd\xd2\xa6\x2c\x74\x88\xd9\x26\xb3\x46\x57\x2f\x11\x53\x50\xf3\xa3\x79\x45\x78\x2b\xf4\xd1\x6c\x44\x60\x23\x43\x52\xa8\xd4\x2f\x77\x06\xaa\xd2\xed\x8d\x0a\xd3\x4d\x75\xa6\x2d\x0d\x09\x70\xe6\x44\x06\xdd\x6e\x0b\x77\x99\xc4\x40\x62\xc9\x0c\xda\x92\x43\xfa\x59\x33\x0b\xbb\x8c\x94\xe0\x86\x8e\x92\x98\xd2\x0d\x6d\x99\xef\x15\xdb\xb9\x96\x64\x55\x73\x25\x31\x55\x1f\x36\xc7\x06\x59\xf2\x69\xab\x69\x96\xf8\x75\xb7\x5e\xd8\x17\x4b\x6f\xfd\x0c\x53\x6e\x95\xf3\x5b\xa6\x9b\xe1\x12\xb5\xba\x24\xbe\x39\x33\x67\x2c\xe5\x25\x4e\x5b\xbb\xeb\xc5\xb8\xba\xcc\x24\xe6\x59\xbd\xdf\xde\x2f\xeb\x74\x7e\x9e\x90\x69\xb1\x35\x9f\xed\xf8\x5d\x1f\x1a\xea\x12\xd3\xbb\xbc\x40\x17\xd4\x86\xaa\x29\x55\x16\x3b\xcd\x9e\x83\x8b\x43\x6d\xef\x74\xab\x85\x6d\xbb\x34\x5b\xd8\xb0\x5d\x2f\xbd\x39\x3d\x66\xb4\x14\x56\xf3\x39\x63\x6c\x17\x4e\x69\xef\x72\x9a\x62\xeb\xd2\xbc\xae\x2d\x70\x95\xcd\x14\xca\x4b\x6b\x8b\xed\x82\xc6\x36\x76\x56\xbd\x9e\x1f\xcf\x5a\x59\xb5\xa7\x83\xa9\x9e\x19\xd1\xeb\x7c\x5a\x25\x52\xb6\xa7\xda\x78\x9e\xcf\xd4\x53\xe6\xb0\x84\xe9\xc5\xba\x5c\xaf\x92\x7e\xba\xdd\xd2\x77\xab\x81\x6c\x71\x4a\x4e\x60\xe9\x01\xb4\xd9\xfa\x7e\x27\xd8\xd5\x5a\x65\x4f\xfa\xdd\x4e\xba\x3b\xef\x77\xc7\x62\xba\x5a\x68\xd0\x6c\x0a\x34\x51\x3f\xa1\x64\xf1\x06\x2d\x48\xb3\xef\x24\xb0\xb0\xe9\xb1\x73\x93\xcd\xd6\xc4\xaa\x9a\xcb\xb7\xfa\x6f\x5c\xb9\x54\x9c\xd5\x27\xb5\x2d\x9d\x36\xdd\xf5\x5b\x33\xbf\xe9\xd6\xf7\x82\x9a\x86\x5c\x9d\x53\x26\x83\x71\x13\xf5\x37\x93\x4c\x57\x2e\xb2\x8e\x68\x27\xfa\xd5\x84\x96\x13\x40\x9b\x77\x8b\xbc\x9c\x19\x02\x63\x2a\x15\xcb\xa3\xb6\x28\x55\xad\x74\xdb\x2d\x92\xcd\x98\xcf\x58\xae\x02\x8b\x89\x52\xba\xc4\x1b\x9b\x2c\x9e\x56\xdb\x89\x3d\x6d\x58\xd9\x62\x19\xeb\xa4\x3c\x97\xd1\x6e\x09\xf7\xab\x55\x5b\x9e\x1b\xa3\x46\x91\x83\xc3\x6e\xa2\x59\x67\xe4\x3e\x5d\x85\xb3\xaa\xdb\x1d\x66\xd2\xd5\x65\x69\xb5\xaa\x91\x12\x27\x15\xa6\xdc\xae\x6c\x15\xf9\xf5\x64\x62\x29\x28\x51\x47\x8c\xdc\xdd\x01\xb8\x9b\x26\xea\x0e\x23\x15\x07\x8b\xe2\x4a\x6e\xf0\xd6\x24\x35\x52\xd8\x81\xb7\x2d\x28\x8e\x26\xd3\xde\xb0\x95\x29\x2f\xde\xde\x5e\x8e\x03\x13\x40\x23\x2f\xb1\x92\xbd\xa3\x3a\x90\x2a\x52\x65\x7f\x03\x13\x8b\xb6\x70\x51\xdc\x0f\xd8\x04\x1f\x1f\xd7\x86\xa1\xb7\xf3\xe2\xd8\xeb\xd1\x5e\xe9\x99\x0e\xb6\x98\xc1\xce\x33\x48\x39\x08\x36\x3a\x67\x67\xe2\xab\x8d\x0d\xcd\x5d\x92\x7b\xe4\x1e\xd9\x47\x4b\x53\x75\xff\x84\x7c\x65\xf9\xc1\x24\xbf\xdb\xeb\x27\x10\x0c\x6c\x18\xd0\xfc\xe1\x6e\xa7\xc7\xf9\x3f\xd2\xd3\x3f\x96\xb6\x04\x6c\xc2\xe4\x8f\x76\x75\x6c\x78\x1d\xdd\x7f\x25\x93\x54\x05\x3a\x50\xc3\x86\x0e\x11\xa1\x9c\x60\xc3\x4d\x61\x89\x9a\xda\xe1\x3e\x5b\x81\x9a\x21\xd9\x1a\x25\x04\xe7\x25\x94\x86\x65\x59\x45\xf2\x4f\x3f\x7d\x01\xc0\xd0\x0f\x0b\x7c\x0c\xe7\x8c\xf4\x68\x83\x2b\x88\x1e\xbd\x22\xd4\x54\xc7\x7c\x44\x90\xd0\xc8\xd0\x3d\x46\x92\x41\xa8\xe1\xff\x70\x8f\xcc\x63\x96\x16\x55\x8b\x1c\x95\x7a\x1c\xfa\x7a\xa6\x22\x02\x65\x53\x25\xbb\x97\x98\xa5\x00\x2e\x9f\x4e\x8e\x87\xa5\xaa\xd3\x5d\x0c\x25\xe4\xae\x44\x77\x47\x2b\x93\x69\x55\x9d\x0d\x7a\x1a\xcf\x88\xfd\xee\x4e\x4d\x94\x19\xba\x67\x2f\x7b\x8b\x7d\xbb\xef\x14\xfa\xb9\x4e\x8a\x2c\x53\xab\x4d\x0b\xf6\xe6\x89\xb5\x31\xe2\x02\xb8\x82\x89\x2d\x0b\x9b\xaa\xac\xa2\x97\x18\x88\x0e\x9c\x8e\xa4\x4a\x25\x93\x5f\x18\x8d\x88\xdc\x1f\x1e\xc8\x30\x95\xe3\xb4\xcf\x51\xa7\xa3\xa4\x8e\x6d\x92\x40\xdd\xd0\x00\x81\xef\xb1\xc4\x72\x78\xe8\x37\x8e\x6a\x0e\xd1\xa1\xa3\x88\x5e\x10\xfb\x3e\x44\xc5\x92\x82\x66\x5b\xde\x28\x1e\x12\x20\x2c\x4d\x15\x61\x8c\x7a\xf2\xa0\xc6\xa3\xd2\x3f\xe3\x54\x82\x52\xc5\x30\x2c\xe9\x87\xc2\x1d\xa0\x5d\x86\x17\x9f\xf1\x21\xa8\x7a\xe5\x08\xf2\x34\xf2\xa5\xa9\xd4\xd3\x49\xd8\x39\xfe\xf3\x05\x3a\x27\x29\x61\xf3\x25\x76\xe7\x51\x5d\x37\xb1\x6d\x3c\x50\xfe\x91\xc8\x3d\xa5\x22\xca\x8f\x8f\xbd\x21\xbf\xdc\x8a\x85\xc0\x7c\xf2\x93\x04\xbf\xc4\xfc\x86\x31\xea\x29\xa4\xe7\x1b\x15\x07\x02\x51\x1d\x18\x7f\x0a\x60\x50\x2f\x2f\x2f\x14\x43\x7d\xf7\x84\x7d\x12\xb2\xa3\xb1\x76\xf4\x74\x1c\x63\x7e\x67\x09\x1d\x22\x5d\x1f\x35\xf3\xc3\x89\x3f\xc4\xc3\xe7\xc4\x9e\xc6\x30\xdf\x53\x45\x42\x34\x5e\x41\x04\xd8\x87\xea\x11\xc0\xab\x48\x7c\xf2\x4a\x82\xfa\x43\xd1\x1a\x86\x31\xdc\x47\xdb\x56\x45\x4f\x10\x07\x78\x57\x22\x9c\x57\xc3\x96\x57\xf3\x0a\x62\xd4\x53\x10\xd0\xba\x32\xa4\x57\xc2\xdc\xfe\x98\xbd\xc4\xfc\x9e\x67\xfc\x1d\x1f\x0f\xdc\x4e\x61\x08\x23\xd3\x41\xba\x47\x18\x09\x3f\x39\x38\xb8\x0a\xcf\x32\x93\x18\x69\xbb\xd8\x6b\xdf\x84\x8e\x8a\x6d\xeb\xb2\xc7\x79\xa8\xf7\x36\xdb\x08\x6e\xc9\x5f\x63\xdb\xef\xf9\x23\x6c\x1f\x52\x18\xfe\x26\xdb\x5d\xb8\x25\x9f\xb0\x7c\x1e\xdb\x56\x4c\x8a\xbe\x88\x33\xff\x98\xa5\xea\x07\x96\x4a\x3c\xb3\x52\x67\x13\x48\xa4\x0e\x9a\x78\xd5\x8c\x79\x15\xe1\x71\x7b\x70\xe0\x49\x4c\x1b\x09\x3e\x92\x27\x3f\x13\x2f\xd2\x6b\x53\x3b\x92\xed\x2f\xdf\xa8\xa8\xd4\x3f\xc4\xbb\x60\xf1\xd2\x52\x5e\x49\x41\xf2\xa6\x0f\x46\x4f\xde\xda\x00\xb1\x03\xcd\x97\xd8\x1e\x63\x7d\x74\x68\x79\x52\x6f\x93\x97\x98\x8d\x6e\x37\xd0\xb1\x03\x5f\x62\xfe\x71\xed\x12\x63\x7d\xa6\x12\xa5\xec\x9f\x39\x1e\x5b\x55\x55\x97\x29\x27\xa9\x4a\x21\x53\x0a\xb0\x8e\x81\x3d\xf9\x8b\x89\x5f\xf3\x4e\x6e\x1f\x10\x25\x76\x22\x2d\x0f\xc8\x19\x4f\x31\xea\xc9\xf7\xd6\x0e\xa2\x0a\x08\x13\x34\x55\x58\xbf\xc4\xb0\x01\xd1\xe8\xf4\xec\x34\x16\x0d\xff\x11\x59\x50\xb3\xe0\x5f\x8a\x37\x43\xef\xb1\x6a\x95\x8a\x9d\x62\xb1\x58\x31\x98\x06\x6b\xf8\xf1\x66\xb6\xd4\x99\x56\xe7\x6a\x3a\x31\x49\xf7\x27\x75\xce\xe6\x77\xdd\x75\xb3\xdf\xd9\x93\xb2\x6a\xb4\x44\x0e\x72\x99\xee\x64\x3a\x55\x97\xfa\x86\xcb\xcf\x5b\x1b\xaf\x4f\x79\x5e\x7a\x9b\xcd\x3d\x38\xb9\x6a\xb1\x58\xec\x6d\x8b\xf5\x69\xcb\x4d\xf3\xc5\x62\xb1\xc6\x33\x5a\x75\x30\x1d\xa6\x51\x8f\x5b\x8c\xa7\x12\x3f\x54\x46\x8d\xbc\x50\x75\xdc\xd2\xdb\xb8\x52\x76\x6b\x40\x7c\xb3\x85\x99\xa2\x6a\xa8\x89\xf5\x5d\x8e\xa0\xcd\x78\x99\xde\x2c\x6a\x6d\xb7\x2a\x55\x0d\x7e\xd0\xed\x95\xfb\xdc\xdc\x71\xf6\x55\x79\xef\xce\x6a\x25\x54\xce\x64\x11\xc9\x67\xac\x11\x67\xec\x2d\x4b\x5a\xcd\x06\x99\xbd\x5c\x2d\xfe\xbd\x9f\x4a\xda\xe1\x34\x21\xab\xdb\xb9\x75\x53\x9a\xe5\xf2\x52\x3f\x4b\xa7\xc6\x62\x96\x66\x1d\x69\xae\x66\x4c\x7d\xd2\xef\x66\xe8\x7c\x86\xcc\xba\x0e\x3f\x45\x76\x66\x00\x24\xbb\x6e\x72\x5b\x75\x3f\x28\x88\x8c\x5d\x57\x58\x98\xee\x2f\x0a\x05\x67\xa3\xd6\xb5\xcc\x5a\xe2\xf3\x1d\xb8\xe6\x41\x6f\x53\x46\x93\x94\x58\x51\xf0\x46\x5d\xe7\xc7\xbd\xc2\xdb\x9c\x95\xd6\x64\x3c\x4d\x38\xfb\x44\xa2\xdc\xb6\xe7\xa4\x90\x16\x51\x5f\x17\xdb\x4c\x36\x3b\x59\x01\x1e\xcd\xb8\xe6\xbc\x69\xf2\x1d\xae\xa6\xf5\x98\x31\x98\x1b\xa6\xc4\xaf\xcc\x39\xa1\x17\x2b\x8d\x1b\xa7\xb3\xa9\x6d\x4a\x9a\xe9\x44\xea\x80\xde\x52\xe3\x58\x3d\xcf\xb0\xd2\x30\x65\xa5\xf2\xcb\x05\x59\x27\xcc\x8d\xb4\xce\xd6\xb9\xcd\x7e\x55\x62\xd0\x84\x53\xe4\x74\x7f\x92\x4e\x4f\x25\x34\x9d\xa7\x97\x33\x6b\xb9\xd9\x36\x19\x3a\x21\x56\x7b\xed\x4c\x3f\x53\xa8\x14\x1c\x27\xeb\x4a\x68\x03\x4a\x8c\x9b\x99\xaf\x57\xfd\x91\xb4\xa1\x73\x29\xc5\x4e\x59\x33\xb3\xc1\x6d\x73\xfd\x32\xdc\x9b\x66\xa7\x23\xb1\x46\xbf\x28\x0a\xd3\x4a\xa1\x4a\x97\x95\x2e\xdb\xe9\xef\x07\x30\x21\x72\xca\x7e\xce\xe0\x41\x46\x4f\x38\x95\x4d\xb6\x9e\x53\x36\x4e\x6e\x34\x6f\x90\x4a\x11\x2c\x44\x23\xdd\x9d\x22\x40\x4f\x06\x32\xd3\x94\xfa\x89\xdc\x62\xa8\xa4\xd3\x6c\x4d\x6f\x90\xb4\xd5\xa6\xeb\x66\x7f\x9c\x5b\x19\x74\xa2\x55\x60\x36\x20\xd3\x58\x99\x92\x5a\x9f\xa5\xc8\x78\x81\x84\xfa\x8e\x9e\x64\x07\x8d\xa1\x9a\x73\x3a\x45\x26\xdf\xea\x71\x65\x5d\x1c\x6b\xe6\x82\x99\xda\xdc\x78\xef\xb6\x1a\xbd\x16\xe2\x5b\xca\x60\x96\x32\x46\x93\x71\x45\xeb\xef\xf8\x2c\x33\x98\x75\x0a\xf9\x3e\xa0\x53\x4e\xa7\xbc\xa5\x41\xe9\xad\x92\xde\x0a\x9c\x5e\x05\x89\x4e\x09\x69\x83\xad\x0a\x14\xdd\xd6\x36\x34\xd3\x1f\xe4\x85\xec\x66\x5b\xc9\xce\xd9\xa1\x2c\xa6\xba\xa3\x7c\x61\x90\x2d\xa7\xad\x2c\x5f\xd9\x3b\x56\x79\x4b\x2f\x19\x0d\xcd\x67\x8b\x92\x99\x73\x67\xb3\xd4\x7c\xce\x60\xd3\x4d\x2f\x88\xb2\xdf\xba\x9b\x7e\x17\xc1\x46\xad\x9d\x52\x17\x7a\x35\x91\xcb\xe4\x26\x20\x5b\xed\xf5\x7b\x9d\xe6\x46\x50\x56\x7a\x69\x40\xdb\xe9\xc4\xc6\x29\xce\x16\x62\x73\xd1\xd5\x94\x59\xde\x46\x2c\x74\x35\xbd\xc9\x19\xed\x46\xd9\xb2\xdc\x8c\x53\x53\x94\x45\x29\xb3\x68\x26\x18\x6b\xd3\xb6\x97\x53\x9a\x66\x98\x8d\x60\x0b\x88\xef\x64\xe4\x49\x37\x27\xee\x9d\x4e\x31\x25\x88\x4d\xdc\x58\xa1\x3c\xdb\x33\x49\x9e\x2e\x0b\xa9\x9d\xdb\x6e\xf4\x72\xa4\xd9\x28\xbb\x7b\x41\x27\x9b\x2a\x9f\x6f\xf5\x4c\x44\x9b\xe3\x89\x35\xe7\xcd\xc1\x76\xbb\xa9\x5b\xf9\x04\xaf\x5b\xcb\x12\xee\xcf\x39\xba\x95\x42\x8e\xae\x39\xa9\x4a\xbd\xda\x58\x6d\x0a\x22\xa7\x57\x47\xb3\x5e\xa6\x4f\x6f\xf6\xe6\x48\x9a\xcc\xf3\xeb\x79\x7a\x5d\x9c\xf5\x44\x9e\x5b\xed\xa4\x89\xd4\x96\xd7\x82\x41\x57\x06\x6e\x3d\x33\xd9\xcb\x48\xc8\xda\xf6\x5c\x12\x77\x46\x67\x96\xe5\xca\x5b\x8d\x6c\x70\x3e\x93\xdf\xd4\x9d\x5c\x3e\x31\x2a\x38\x6f\x8d\x9e\xe4\x8c\x95\x41\x3f\x57\x70\xc7\x33\xd0\xed\xb8\xa4\x96\xaf\xeb\x96\xd5\xb2\xac\xf2\x76\xbc\xda\x08\xd9\x4a\xb7\x5f\x1b\x2b\xbd\xb4\x50\x2f\x65\x78\x87\xe6\xf5\xd2\x72\x88\xf3\x89\x32\xbd\xeb\xeb\x74\x5f\x9e\xf0\xf3\xb9\x3a\xa5\x9d\xe6\xc4\xc9\x8e\xd2\x55\x64\x49\x33\xd9\x6a\x74\x4d\xb5\x20\x72\xc8\xa3\x4b\xda\x38\x02\xaf\xa7\xcd\xdd\x2c\xb7\xd3\xc7\x65\x41\x9a\xce\xe4\x29\xeb\xe8\x65\xda\xd0\x97\x96\x94\x6a\x43\xce\x9e\x8f\xc6\x6e\x4d\x6f\x8c\x66\x15\xb1\xa1\x8c\x7b\xb4\x56\xec\xc2\xdc\x70\x51\xc7\xcb\x76\x7f\x60\x09\xd9\xec\xb6\x52\x9f\x95\xb6\xb2\x98\x6a\x16\x90\xa4\x92\x44\x87\xb3\xda\x7d\x3e\x5b\xd5\x40\x57\x59\xf5\x2a\x89\x3d\xaf\x67\x3a\x6b\xa1\xbb\x54\x1a\xbc\x4a\xb4\x44\x69\x91\x2d\xd8\x88\x27\x08\xac\xa4\x91\xaa\x75\x24\xb7\xdd\x28\x4d\x33\xb9\xfc\xb0\xbb\x5d\x2c\x61\x7d\xda\x6f\xae\xdc\x56\x3a\xbb\x9d\x2a\xa9\xd1\x46\x40\x68\xb6\x14\xe7\x2d\x75\x6f\xef\x0a\xfa\x72\xc0\xbe\xd5\xf7\x15\xdb\x29\x6e\xb6\xb4\x56\x5e\x6d\x17\x79\x9a\x71\x6a\xbc\x61\xd6\x36\xb9\xac\x07\x87\x75\x0b\xfb\xd9\xac\x22\x17\xf0\x22\xd1\x92\x50\x6e\xee\xc8\xc3\x45\xce\xd8\x1a\x3b\x7a\x2c\xec\x27\x9c\xd5\x9e\x70\xd6\x4a\x35\x3d\x9e\x44\x58\x2e\x2d\xf5\xfd\xb2\x67\x16\xb6\x3c\xd3\x59\x64\xf2\xce\xd8\xad\xcd\xc5\xae\xbb\xb2\x96\xab\xb6\xb2\x6e\x8f\x5a\xd9\xca\xd8\x05\xc6\xd2\x29\xe0\x79\x91\x25\xd9\xb5\xcc\x77\x7a\xd9\x7c\x25\x91\xe8\xb8\x73\x4e\x1c\x34\x49\x63\x9b\x5f\xa6\x2b\xcb\x2e\x8b\x46\xbc\x53\x2e\x70\x15\x3a\xcf\xc1\x4d\xaa\xaf\x0e\xfb\xa5\x0d\xdb\x00\xcb\xb5\x95\xef\xeb\x25\xc2\x73\xcb\xd1\x72\xc9\xb0\x7a\x55\x4c\xb4\x99\xf6\x5c\xd0\xa5\x0c\x37\x67\x53\x85\x31\x3d\xaf\xba\x95\x29\x37\x9f\x61\xc9\xcd\xd4\x14\x3d\x9d\x80\x8d\x37\xde\x32\x7b\x74\x16\x4f\x95\x41\x66\x57\x47\x7c\xbd\x63\x20\x96\xee\x54\x80\xa3\x34\x46\xec\x38\xdf\x67\xdc\xac\xe9\xf6\xea\xba\x5d\x1f\x37\xfa\x9a\xe6\xc8\xf9\x66\x4a\xe4\xfb\x45\x71\xc9\x8a\x63\xd8\xa9\xd1\x48\x19\x24\x8c\x3c\xbf\x17\xb8\x32\x2d\xed\x4b\x95\x44\x36\x35\xcf\xdb\x1c\xd8\x34\x68\x67\x5a\x4e\x6b\xb4\xd3\xdc\xe7\xfb\xfb\xf9\xa8\xda\x48\x38\x9b\x84\x9e\x1b\x4a\x09\x6d\xa0\x3b\x85\x0e\x2b\x74\x0d\xa5\x36\x56\x3a\x2c\x97\x16\xbb\x3c\x9f\xca\xaa\x08\x17\xb2\xe9\x3a\x91\xeb\x89\x51\xc2\x58\x1b\x65\x69\x95\xdf\x2b\xea\x6c\x42\x2b\xc0\x6d\xf5\x9b\xed\x52\x2e\x65\xa3\xb4\xc1\xf4\xd0\x98\x49\x89\xab\x55\x06\xdb\xb5\x7c\x16\x09\x39\x29\x2f\xe4\x86\xa2\x90\xea\xad\x11\x41\xfb\x7d\x7a\x9d\x9b\x3a\x85\xb1\x0e\x73\xe3\x62\x0f\x35\xa6\xa0\xe4\xba\x12\x4d\x6f\x59\x64\xf0\x99\x1e\x3d\xac\x2d\x9d\xa1\xb9\x48\xd8\x8c\x2e\x8e\xdb\x23\x63\xbc\xaf\x28\x4a\xbd\x51\x18\x8e\x12\x73\xdd\xe6\xc6\x95\xf4\x5c\xe4\x24\x98\x4b\xcc\x6d\x69\xc8\x94\xff\xe6\x9a\x94\xef\xd2\xe9\x1a\xc7\xe5\xd5\xbd\x58\xdf\xce\x66\xf9\xcb\xb8\xcf\x67\x1e\x46\xf0\x8c\xf0\x89\xd3\x41\xbf\x7e\xe6\x7b\xf9\xe0\x78\x2c\xee\x8e\xbd\x20\x25\x73\x52\xed\xbb\x79\xb1\x63\xbf\xc8\xfb\x35\xf6\x4b\x5f\x23\x4f\xef\x50\x44\x7d\x7f\xa6\x95\xcc\x17\xa0\x79\xee\xcc\xeb\x33\xd4\x5f\xbb\x98\xf2\x0b\x9f\x69\xa8\xbf\x9e\x75\x36\x4e\xfb\x9e\x7b\xf0\x81\xbf\x1d\x6d\xe6\xe2\x41\x1e\xad\xef\xa6\xfa\xf9\x9e\xc1\x57\xd7\x04\x06\xe5\x6d\x0f\xfc\xea\xb2\xd7\xb6\x86\xcd\x11\x01\xc4\xb6\xee\xee\xdf\x59\xb0\xfc\x12\x8f\xfe\x0b\x57\x1d\x44\xbb\x3e\x02\xe4\x68\xd3\xf7\x48\x80\x6c\x1d\x76\x22\x04\xc8\x8f\x41\x56\xc9\x59\xc2\x40\xc4\x40\x40\x9b\xff\x3b\x69\xa8\x9a\x76\x44\x66\xec\x8c\x83\xa4\x47\xac\x07\xd0\xf3\xee\x7d\xfa\xfc\x07\xb8\x25\x1e\x71\x27\xbb\x06\xe3\x6b\x23\x7c\x92\x32\x12\x72\x74\x48\x91\x8a\x08\x24\x88\xe2\x09\x4a\x62\x9b\xf8\x77\x15\x0c\x53\xd5\x81\xb9\xf3\xcb\x2c\x9d\xf2\xe1\x04\x1c\x9e\xfb\xae\x15\x48\x80\xaa\x59\x81\xe3\xfa\x3a\x55\xa1\x4b\x85\x45\x7e\x36\xc7\xfb\x66\xee\x1c\x85\x05\x05\x8c\xc4\x6b\x48\x28\x49\xc3\x80\x04\x29\x8e\x07\x19\xbf\x7b\xcf\xe7\x32\xf6\xaf\xf0\x20\x6c\x42\x09\x9a\xa6\xc7\xe8\x54\xb5\x54\xe2\xe7\x38\x1d\x09\xec\x48\x46\x7f\x79\x57\xe5\xd1\xd0\x08\xb2\x8f\xc7\x80\xd7\xe0\xf9\xee\x2a\xc8\x48\x8e\x52\x72\x82\xf4\x64\xef\x77\xd2\x22\xa6\x6a\x40\x31\x7c\x52\xbc\xfd\x4c\x54\xa3\x53\x97\x49\xcd\xef\x9b\x31\xe2\x95\x1f\x20\x7a\x0f\x49\xcd\x17\xcb\xd1\x68\x12\xf3\x44\x5d\x89\x42\x59\x02\x36\x82\x4c\x9e\xd8\x6b\x40\xef\x33\x4d\x94\x8f\x5a\x4d\x81\x66\xc3\xd3\x46\xcf\xf4\x3b\x60\xaf\x26\xbc\x04\x17\xf4\x8e\xb2\x30\x0f\x24\x44\x73\x24\xdc\x2d\xaa\x88\x0a\x39\x7a\xd7\x6f\x21\x9c\x7c\x01\x45\x77\x41\xfd\xfd\xe9\x94\x26\x07\x66\xc3\xa4\x6e\x04\xf4\x60\x16\x04\xcf\x8f\xde\xb3\x37\x11\x88\xf8\x71\x3f\x3f\x19\xfc\xb8\x63\x90\x1d\x7e\xd6\xf3\x8c\xc7\x77\xae\x9e\x69\x7f\x20\xfe\x8a\x92\x04\xa9\x76\x9e\xf6\x7d\xb0\xf9\x36\xb1\x4b\x5d\x4d\x3f\x8f\xdd\x08\x8a\x61\x2d\x99\x3e\x15\xd5\x71\x50\xea\x3c\xf4\x74\x3d\xc6\x74\x1e\x67\x38\x83\x9f\xbf\x02\xff\x34\xd7\x3e\x44\x14\x16\x46\xfb\xe4\x70\x9c\x23\x9c\x27\x5d\xae\x22\xff\x5b\xf3\xcf\x2a\xed\xde\xd3\x0d\x6f\x48\xf9\x30\xa4\x4a\xea\x90\x7d\x18\x5c\xc6\x4a\xa6\x03\xd3\x1b\xa4\x6c\x9f\xe6\xf8\x53\x06\x9f\xe4\x62\xaf\x7e\x6e\x24\xc5\x9f\x66\x35\x2a\xa9\x13\xf3\x1a\x2c\x87\x61\x54\xf7\xcd\x0f\x1d\x26\x29\x96\x7a\xf6\xe7\xf2\x7b\xbf\x72\xd0\xc0\x7a\xd4\x20\x92\x89\x72\x88\x52\x9e\x74\x54\x11\x15\x3e\x5b\x63\x3c\x52\xc2\x0b\x90\x67\x83\x1c\x44\x8d\x43\xf9\x47\xa2\xb8\x44\xf4\xfb\x39\x49\x7f\x04\x31\xc7\x63\x15\xb1\x7e\xa0\xb3\xdf\xfe\xf8\xd8\xe9\x3c\xa4\xf9\x75\x12\x4e\x16\xae\x63\xae\xae\x2f\x62\x61\xb6\xf5\xff\x09\x57\x9a\x53\x09\x51\x89\x17\x8a\xcd\xc4\xa8\x27\x51\xb5\x3c\x2d\x13\x2f\x1a\xbc\xbe\x7c\x36\x14\x67\xab\xd2\xf1\x82\xa7\xc9\xfe\x87\x7f\x5f\x8f\x3a\xcf\x94\x8f\xbd\xfa\x08\x3a\xd8\x84\xef\x89\xd2\xff\x84\x56\xfb\x59\xaf\xff\x56\x85\x0e\xf3\x6a\x7f\x44\x97\x23\xba\xfe\x4d\x1a\x1c\x81\xbf\xa2\x34\xd7\xb5\xf6\x83\x0e\x9f\xea\xea\xc7\xc8\xfe\x3f\xd1\xcf\x0b\xf1\xfe\xe7\x68\xe5\xfb\x32\xf6\xef\x53\xca\x1b\xba\xe8\x49\xe6\x42\x11\xcf\x35\xf0\xbd\x51\x74\xc0\x73\xa9\x7b\x47\x2b\xec\x85\xe6\xfd\x7e\x82\xe5\x8a\x9d\xbc\xde\xee\xf2\x54\xe7\x3a\xa4\xd7\x67\xfa\x08\xfb\x97\x74\xe8\x88\x89\x2b\x0a\x74\x5c\x1b\x69\xcf\x7f\xa0\xda\xf8\x89\xf4\x9f\x38\x3f\x67\x97\xe0\xae\x1e\x3d\x04\x09\xf9\xef\x20\x3d\x81\xde\xd8\xe4\x5c\xbd\x52\x75\xd4\xb5\x1d\xd4\xf4\xc2\x8a\xe3\xdd\x29\xf7\x1a\x56\x52\x7e\xcb\xc7\xc7\xc7\x67\x5a\xe1\xae\xbb\x48\xd1\x15\xad\x9b\x27\x92\x51\x83\x24\x0f\x4c\x8a\x97\x93\x2a\x92\xf0\xb1\x50\xa2\xfe\xe1\x29\x55\xd4\x9c\x07\x66\x78\xc4\xe4\xbb\xa8\x08\xbb\x2f\x31\xe6\xb8\x44\x57\xd1\x79\x09\xd8\xbe\xc4\x52\x19\x86\x39\x93\xca\xb9\x82\xfd\x05\x97\x6b\x05\x1c\x10\x94\x46\xaf\x33\xb0\x91\xe0\x5f\xec\x34\x80\x69\xc1\x11\xb4\x2c\x15\xa3\x3b\x2b\xf8\xbc\x3f\xdc\xea\xd2\x20\xf1\xcf\xdb\xa8\x97\x43\x11\x15\xa5\x32\x3c\x51\x61\xf3\xc7\xb0\xe0\xe1\xe8\xce\x01\x20\xd6\x7b\xbd\xff\xf8\x5e\xeb\x2b\xf9\x13\xf5\xfb\x1f\xa7\x45\x97\xab\xba\xd7\x26\x6c\x12\x9d\x38\x49\xd8\xa4\xee\x3c\xaa\xbc\x1e\x13\x53\xf3\xcc\x44\x84\xc6\x87\x7b\x7f\x44\xa8\x47\x79\x50\xfa\x68\xd8\x96\x72\x77\xd2\xf0\xf7\x10\xc2\x1f\x87\x6b\x9e\x17\x38\xbc\x29\x7f\x8e\xe0\x92\xca\x63\x8c\x5e\xaf\x28\x2b\xe0\x58\x64\x94\x0f\xeb\xc9\xff\xfd\x70\x54\x7a\x10\xc5\xa1\xec\xfb\xe1\xdb\x05\xab\x58\xfa\x84\x92\xdf\x3d\xf0\x7f\xdc\x9f\xe0\x0d\xa9\xf9\x82\x18\xae\x90\x70\x10\xe0\x15\x8f\xcb\x07\x15\x42\xbf\x10\xe1\x47\x1d\x2d\x6c\x92\xbb\x3b\xf0\x40\xf1\xf7\xd4\xcb\xeb\x11\xb1\x26\x24\xb6\x89\xa8\x68\xc8\x02\x2b\x48\x25\x29\xfe\xa4\xe0\x80\xea\x80\x34\xec\xe7\xe1\x3c\xb9\xbc\x38\xb5\xfd\xcb\x1f\x06\x46\x10\x91\xbb\x78\xff\xda\x36\x23\xfe\xf0\xfe\x42\x82\xd0\xb4\x3d\x51\xf1\x9f\x3f\xdc\x92\xc4\xa3\x11\x14\xa1\xa6\xea\x6a\xa8\xa9\xf1\x5f\xbe\xc5\x1f\xa8\xf8\xf7\xf8\x41\xad\x3d\x82\xee\xee\x2f\x19\xbc\x32\x3c\xe1\x12\xf0\x44\xb1\x99\x8b\x61\xf8\x1e\xc1\x33\x4c\x6c\x58\x4f\x47\xdd\x6f\xcd\x9a\xa2\x69\x82\xdd\xc9\x88\x78\xc2\xfa\x40\x26\x07\x27\xf5\x63\x71\x5c\xf8\xb2\xff\x51\x92\x38\x67\x3c\x6a\xec\xb1\x6b\x13\x28\x5e\xb4\x0f\x19\xba\x3b\x9d\x30\x26\xb4\x6c\x8d\x78\xb3\xf7\xfb\x51\xe9\xc9\x64\xf4\x66\x22\x51\x54\xeb\xd2\xe2\x78\x3f\xaa\x44\xdd\x05\x5b\x68\x6c\x11\x3f\xb0\xa1\xa2\x10\xea\x79\xd3\x08\xdb\xef\x27\xed\xff\x38\x9e\xac\xde\xd7\x83\xa6\x87\x9c\x51\xfe\xb1\xf1\x97\x40\x9d\x59\xa1\x90\x42\xf1\x89\xfa\xf3\xd1\x46\xea\xc6\x86\x6f\xe2\x5d\xdc\x6b\x1d\x25\x33\xfd\x19\xbf\x7f\xb8\xe8\x10\x99\x29\xef\xf3\x8f\xb3\xda\xef\x3f\xdd\x7a\xfa\x7e\x22\x55\x7f\xc0\xff\x0c\x02\x36\xd6\x5d\x28\x8f\xdf\x2e\xc7\xf8\x43\x7d\x1d\x9d\xba\xaf\x37\xd4\xf5\x86\x93\xfb\x4f\x6a\xeb\x91\xdf\xf6\x0f\xa8\xea\x87\x3c\xd7\x23\xdf\xeb\x06\xb7\x17\xbe\xd9\x57\xf9\xfc\x90\xb4\xcf\xd7\xe6\x2f\x4f\x36\x1d\xac\x61\x05\x10\x60\xc1\x8b\xc9\xe6\xcd\x28\x84\x45\x68\xf9\xf3\xed\xb7\xb3\x1a\x28\xca\x7e\xcd\xef\x7f\xfc\xf6\xd3\x5f\x9b\x8b\xbe\x0f\x2f\x52\x2f\xd4\xbf\xbc\x6f\x7f\xfe\xf2\xed\x90\xb0\xf5\xfd\x5f\xa7\x93\xca\xa7\x22\xf0\xf9\xc5\x6b\xb3\xc6\x9b\x33\x41\xed\xf9\xf4\xf0\xef\xf4\x3e\x1d\x92\x63\xce\xab\x65\x13\xdb\xc6\x13\x15\x37\xfc\x11\x3c\xab\xf4\x67\xc3\x13\xc5\x9e\xce\xa1\x13\x6e\x8f\x0c\x0a\x01\xf2\x05\x87\x47\xe2\x20\x40\xf6\xa4\xf1\x41\xd3\x40\xac\x04\xc8\x81\x4c\x08\x90\xff\xfc\xe5\x1b\x01\xf2\xa3\x02\x2c\xe5\x5c\x22\x11\xea\xff\xba\x0b\x3a\xa8\x28\x10\xd2\xfd\x35\xb8\x91\x00\xfd\xa6\xd7\xad\x4e\x24\x45\xbf\xc9\xa5\x8d\x39\x12\x65\x74\x34\x72\xbd\x51\x24\x50\x02\xe4\x0b\x79\x06\x3f\x91\x54\xaf\xd5\x7e\xbf\x64\xf2\x86\x3d\x3d\x67\x2a\x8c\x35\x27\x5e\x28\xee\x0a\x8c\x8b\x12\x5f\x79\x03\x1b\x7e\x0d\xb2\x64\x62\xfd\xa0\x51\x14\xc1\xa1\x5c\x2e\x01\xdf\x9f\x23\x3b\x37\xb8\xd7\x75\x05\x88\xa2\xf9\x91\xb2\x78\xf5\x07\x6d\xb9\xd1\x38\x50\x17\xaf\x32\xd0\x17\xef\xdb\x9f\xbf\x7c\xf3\x3e\x6e\x2b\x4b\xd8\xfc\x4b\xda\x12\xb4\xfd\x58\x5d\x82\x36\x1f\xea\x8b\xd7\xe4\x63\x5d\xf1\x5a\x7c\xa2\x2c\xff\x90\xae\x84\x2c\x1d\x29\xcb\xbf\x43\x57\x02\x2c\x7f\x41\x59\x8e\xbf\x5f\x33\xa9\xd1\xe6\xe5\xd8\xaa\x7e\xbc\xe5\xa1\xc2\x91\x3f\xdd\x68\x84\xce\xfb\xf3\x0b\xc5\x5e\x2a\x80\x80\x11\x51\x91\x0d\x7f\xfb\x48\x93\xa3\x70\x9e\xaf\x79\x91\x73\xf2\xcb\xb7\x08\xcd\x6d\x1b\x7e\xe8\x78\xcb\x8c\x1f\x1a\xdc\xb0\xe4\xf1\x90\xe1\x0b\x85\x89\xb4\xe9\x3d\x05\xfc\xa6\x41\xa7\x12\x37\x24\xf2\x3f\x14\x77\xff\xa1\xb5\xf7\x87\x22\x5a\xd9\x4e\x40\x5c\x0a\xf2\x43\xbd\x09\xb4\xe6\xca\xc2\x17\xa8\xd0\x41\x0a\xe7\x1a\x73\xee\x6d\x9e\xea\xcc\xa5\x4f\xf7\x3b\x82\x2e\xe5\xa8\xd6\xa3\xb7\xc6\x8f\x20\xb9\x3b\x38\x79\xa1\x01\x78\xa0\xce\x5b\xf8\x74\xdf\x5f\x6e\x7a\x0f\x6e\x84\x8e\x6d\xe4\x7b\x11\x87\x38\xc5\x89\xe3\xe0\xab\xe6\x2f\x08\x6e\xc9\x58\x15\xd6\x77\x77\x67\x1b\x49\x8a\xfa\xe5\x2e\xfe\x73\x70\x40\x1e\xbf\x7f\x54\x54\x11\xde\x9d\x70\xe5\x55\x5f\x09\x22\xc5\xef\xfd\x37\xe8\x9c\xb6\x8d\x42\x20\x9e\xf7\x42\xbd\x04\xa8\x8f\x3d\x9a\x6b\x6d\x2f\x14\xcf\x97\xc4\xd3\x01\xce\xef\xcc\x1f\xa7\x8a\xe3\x0b\xe4\xa8\x9e\xfd\xe3\x86\x1f\xed\xbb\x3d\xd1\x5b\x85\x5e\xde\x19\x89\xc2\x50\xf1\xfb\x13\x75\xf2\xfd\x2b\x48\x5c\x6c\xae\xa9\x97\xc3\x30\x74\x83\x92\xbb\x43\xef\xf8\xbd\x47\x91\x8f\xfe\xe1\x8c\x72\x0d\xec\xb0\x4d\x9e\x2e\x27\x92\x6e\x98\xd8\x81\x62\x3b\xac\xf7\x6f\x33\x9c\x32\xf5\xfd\xe1\x9a\x0c\xce\x01\x59\x0a\x30\x3c\x3f\x56\xc4\x24\xfe\x61\xff\x50\x46\x97\xc6\xc4\x7f\x91\xd3\xb7\xe8\x45\x96\x9e\x67\x80\xe3\xe7\x9d\x29\xca\xd2\x31\x26\xca\x57\x08\x35\x94\x9d\xa5\x0a\x57\x50\x41\xe4\x47\x6d\xaf\xc2\xf0\x27\xae\x00\x8b\x44\x03\x56\xaa\x04\xac\x53\x17\xf8\x40\x84\x61\xaa\x48\x6e\xfb\xa6\xe0\x89\x4a\x71\xcc\xe5\x9a\x14\x34\x29\x63\x64\x11\x80\xc8\x13\xc5\x3c\xb2\xf9\xf3\x29\x7a\xde\x4b\x07\xdb\x29\xd4\xb0\xa0\x92\xdd\x13\xc5\xa6\xb3\x17\xbc\x63\xcd\x81\xe6\x13\x15\x3f\xa7\xf1\xc2\x7e\x11\x55\x87\x16\x81\x86\x87\x97\xcb\x5c\xc0\x21\x80\x57\x35\x75\x1f\xbe\x0f\xf4\x92\xbf\x83\x84\x88\x69\x5f\x08\x88\xa2\xbc\xbd\x88\xdf\xd7\x7a\xa2\x52\x19\xe6\x0a\xf7\xb6\x21\x02\x02\xdf\xc2\x4b\x32\x5e\xab\x8f\x79\x3f\x7b\xf4\x2d\xf4\x95\x91\x0b\xbc\xef\x6b\x14\x87\xea\x13\xff\x39\x95\x07\xb9\x74\x26\xfe\x99\xa8\x7d\xb7\xf3\x43\x40\x0c\x93\xe3\x25\xe9\x73\x40\xbe\x4f\xf2\x21\x24\x36\x07\x52\x7c\xfe\x73\x48\x47\xeb\xd1\x87\xf0\x24\x49\x60\x99\xdc\x05\xbc\xdb\xe6\xfe\xd4\x98\x84\x86\xe4\x11\xa3\xbb\xf8\x89\x26\x1c\x8c\xcf\x83\xb7\x72\x99\x40\xb7\x2e\x0c\x72\x68\xb9\xa0\x29\x40\x44\xbc\xc5\xed\x25\x6a\xfa\xf8\xae\x14\x14\x4d\x85\x65\x04\x13\xa0\xdd\x53\xff\x43\xb1\x0c\x73\xba\x1c\x45\xc6\xef\x11\x10\x62\xde\xc5\x4f\x22\xec\xf1\x07\xea\x02\xe6\xfd\xa3\x60\x59\x77\x71\xff\x8e\x64\xfc\x81\xfa\xd7\x2f\xdf\xde\x89\xf8\xfe\xeb\xbf\x4e\x4c\xfd\x4d\x7e\x05\x78\xc6\xf1\xdb\x01\x7e\x05\x23\x6f\x63\x7e\xb9\x04\x7d\x4a\xaa\x37\x01\xce\xa8\x8b\xb3\x0c\xf3\x6b\xfc\x6c\x01\xbe\xbd\x58\x5d\x2e\x6c\x37\x38\x88\x68\x87\x77\x3e\xd2\xa3\xa8\xcb\x7b\xe4\xf6\x3d\x68\x60\x11\x13\xef\xfe\xa9\xc5\xf7\x7c\x41\xfd\x7e\x16\x2b\xbe\x15\xf5\xe8\x62\x52\xc3\x36\x12\x6f\x06\x3e\x62\xcf\x0a\xfb\xda\xc3\xd8\xb0\x1e\xa9\x0a\x46\x71\x42\xad\x11\x76\x29\x57\x81\x26\xa4\x88\x02\x08\xa5\x5a\x8f\xcf\xb4\xc2\xbe\xc6\x3e\x44\x74\x72\x2a\xfc\x41\xfc\xf3\xfc\x86\xe0\x5f\x8e\xb2\x78\x2e\xe8\x88\x78\x46\xfe\xe2\x48\xe4\x24\xf2\xf2\x79\x00\x33\xba\xfb\x76\x11\xc1\x0c\x63\x6d\x82\x62\xa3\xf5\xdd\x7b\x74\xe4\x81\x4a\xfd\x70\xc4\xed\x90\x70\x74\x43\x34\xe7\x57\x92\xfe\x56\xf0\xe9\x89\xea\xf1\x2b\x28\x90\x0b\x77\x10\x12\x05\x8b\x27\xcd\xaf\x26\x7e\x5e\xc4\x96\x82\xfc\xcf\x32\x16\x3d\x83\xe3\x1f\x75\xbd\x21\x72\x47\xff\x3f\x77\xff\x57\x4c\xdc\xff\x5f\x8b\x7e\x84\x5b\x28\xbc\x4b\x28\xcc\x17\xf5\xbc\xa1\x93\x69\xe5\xed\x6f\x8e\x40\xbd\x52\xe9\x42\xe1\xdc\x1b\x0f\xa5\x1e\xa6\x7b\x8a\x00\xc9\xd0\x8c\x9f\xcc\xcd\x60\xeb\x78\x01\x8b\xfb\x0c\x96\x0b\x4c\xa4\x22\xf9\x4b\xc0\x52\x9f\x01\x53\x91\x84\xbf\x04\x89\xfd\x0c\x92\x65\x0b\x82\x67\xf4\xaf\x00\xfb\xb0\x5b\x94\x20\x7a\xda\xf1\x5d\x2d\xdf\x67\xc5\xe9\xcd\xaf\x3b\xe8\x40\x74\x16\x42\xff\x25\x28\x7c\x0c\x92\x47\x03\x6b\xfa\x8d\x8a\x1f\xde\x27\x1d\xf7\x76\x6b\x02\xd0\xe0\x5d\xea\x3e\x7e\xb2\xb5\x39\x42\x73\x7e\xc5\xec\xef\x21\x62\x6f\x23\xba\x72\x53\xed\x1a\x2e\x7f\x1f\x7e\x78\x97\xec\xcb\x25\x6e\x0d\x5b\xd0\x22\x77\xf1\xdb\x6f\xfa\x8e\x9f\x6d\x77\x3e\x26\x3e\x19\xdc\xdb\x8e\x3f\x51\x77\x61\x4b\x0f\xf0\x9c\x4a\xbe\x93\xf1\x88\x25\xc9\x82\xe4\xee\xfe\x51\x83\x12\xb9\xa7\xe8\xa3\x2a\x7f\xf5\xba\xbb\x0f\x97\x6b\x2a\x41\xc5\x7f\xf5\x73\xb3\x8f\x81\x2d\xae\x03\x23\xd8\x38\x85\x15\xbc\xe3\xe0\x14\xd8\x4d\x79\x5e\xb9\x64\x77\x4d\x9e\x21\x15\xa6\xff\x59\x81\x12\xb0\x35\x72\xb9\xc7\xd3\xbd\xee\x91\x15\xf3\xa5\x1e\x3b\x7f\xfb\x69\xec\xa4\xd3\x49\x87\x47\x49\x45\xe2\x5d\xfc\xd1\x2f\x0c\x12\xe2\xe3\xf7\x7e\x10\xf3\xc8\xba\xd8\xa6\xf6\x39\x84\xa3\xe1\xd4\x54\xb4\x8e\xdf\x87\xee\x83\x62\x42\x29\xfe\x40\x1d\xd9\xaa\x93\xfb\x8a\x9f\x03\x3e\x53\x96\x03\x60\xcb\x14\x3e\x82\x1b\x79\x2f\x1a\x39\x69\xf5\x31\x2f\xfe\xd3\x5d\xdc\x5b\xfc\xe3\xb7\xc7\xee\x38\xc9\xfc\x9f\x1d\x38\xf1\x38\x7d\xfd\xa2\x87\xe9\x9f\x2a\x44\x0b\x9d\xaa\xc1\xbb\xf8\x57\xf2\x62\x3f\x4e\x89\x3d\x9d\x72\xde\x56\x7b\x6a\xc3\xb3\xb0\x8c\x7f\xc9\xf3\xc2\x43\x0f\xe1\x3c\x1d\x49\x37\x2c\xfa\x68\xab\x63\x42\xe4\xbf\x01\xda\x84\xd6\x63\xf0\xfd\xb4\xde\x33\xe6\xaa\x30\xf4\x6b\x6a\xde\x86\xcb\x6b\x78\x56\x78\xe2\x39\x3e\xfe\xe2\x47\x5d\xee\xe2\x27\xd2\xbb\xf6\x76\xee\xf8\xff\xc6\x3c\x70\x54\x4b\x25\x41\x1a\x75\x90\xc2\x74\x7b\x26\x7c\x11\x1e\x74\x93\x26\x70\x0f\xac\x7c\x06\x35\x6c\xf7\xb5\xc9\x75\x80\x6e\x42\xcb\xc0\xc8\xfa\x9c\x68\x1e\x8b\xbb\x4f\x60\xdf\x9a\x45\x5f\x77\xdc\x4e\x87\xed\xb6\x73\x7b\xed\xfa\xc3\x5f\xf6\xe4\x0e\xfa\x7c\xf5\x84\xf0\x8a\x2f\x77\xfd\x0a\xc1\xc9\x3c\xf1\xdc\x93\x30\xe5\x5f\x45\x82\x09\x81\x05\xad\x11\x14\x6c\x6f\xd3\x7b\xcb\x4d\x09\xaf\x62\xdc\x76\x53\x8e\x80\x8a\xf0\x87\x80\x7e\xe2\x92\x1d\xdf\x6a\x78\x79\xa1\x62\x6d\x2c\x04\xaf\x6e\xfe\x18\xea\xa5\x6f\x76\xe9\xd5\xc7\xe3\x3f\xaa\x08\x47\x09\x8b\x9f\x9e\x97\xff\x5b\xbc\xf8\x90\xba\x80\x38\x01\x23\x8b\x44\x79\x4c\xd4\x0b\xf5\xed\xdb\xe3\xf7\xf0\x9c\x25\xa8\x0a\xe3\xa7\x7f\x3e\xc2\x2d\x81\x48\xbc\xbb\x9a\xa0\xf6\x40\x7d\xa3\x04\xdb\x34\x21\x22\xfe\x9b\x74\x9e\x28\x57\x45\x22\x76\x1f\xb5\x50\xd2\xfe\x89\xe6\xc1\x6f\x08\x20\x07\xaf\x96\x09\xe3\xa0\x53\x1b\x06\xef\xe0\x39\x98\x68\xbf\xda\x63\xf3\xc0\xcc\x37\xca\x00\x44\x79\xa2\xe2\x74\xfc\xc1\x73\xde\x80\xe5\x7d\x37\x8e\x5e\xbd\x1c\x7f\xa0\x0e\x92\x7e\xfa\x2c\x59\xe1\xfe\xe1\x20\xaf\x68\xc3\xf7\x9e\xa1\x46\x7d\x3f\x36\xf0\xef\x98\x8d\xcb\x97\x33\x7f\x88\xf4\x7a\x96\xd3\x39\xea\x6b\xe7\xf9\x1f\xe4\x6c\x7d\x4a\x9c\xff\x96\xe7\xaf\xd0\xf5\x9e\x69\xf4\x37\xa4\x11\xc4\xac\x3f\xc2\xf6\x9e\x33\xf1\x21\x9a\x87\x7f\x5e\x18\x7e\xae\xe3\xc7\x6a\xe1\xb5\xf8\x37\xd1\xf6\x10\xa5\x5e\xfa\x6d\xfc\xef\x37\xc8\xfd\x9f\x0f\x69\x3c\x89\xbe\xdc\x1f\x0c\xcd\x1f\x27\x53\xd9\x01\x26\x05\x0c\xe3\x7d\x42\x1d\xa6\x92\x7f\x8a\xf6\x33\x30\x8c\xf8\x71\x4e\x4d\x40\xd5\x17\x2d\x4b\x30\x59\x9f\xc2\xcf\x83\x81\x3b\x4f\x75\x3d\x4a\xd4\xf5\x57\x4b\x4a\x02\x22\x8c\x51\x04\xf0\xfe\xab\x7f\x5e\x62\x49\x36\xca\xcc\x15\x55\xa0\x61\xf9\xda\x7b\x5f\x82\xcc\xf8\x33\x5f\xff\x32\xc1\x39\xf0\x69\x02\x30\xc1\x4a\x9d\xdc\x6a\x57\xd3\x9c\x83\xca\xf0\x8f\xa1\xdd\xb8\xfb\x15\xb4\x09\x56\x8a\xd3\xe4\xe3\xf7\xdb\xb5\x47\x5e\x54\xec\xec\x1a\xed\x7b\xa2\xf9\xe9\xdf\x28\x38\xdc\xca\xc3\x87\x3f\x4d\x20\xaa\x96\xae\x1e\xc0\x9d\xfe\x75\x81\xb2\xdf\xee\xda\x1b\x6f\xae\xbc\x1e\xe7\xbf\xfd\xd3\x81\xdf\xae\xbd\xf7\xe6\x38\xcb\x9c\xfa\xf8\x52\x5a\xc0\xd4\xd9\x05\xe5\xa3\xeb\xab\x37\xaf\xdb\x9e\xed\x8c\x82\xf7\x76\xdf\x78\xe3\x4c\x2c\x78\xab\x4a\x2c\x78\xa3\x5e\x8c\xa2\x5f\x3f\x7c\x37\xcf\x05\x79\x17\xb7\x6b\x3f\x91\x77\x94\xa3\x7f\x88\x6e\x5c\x97\xfd\xab\x2f\xef\x4f\xc4\x75\x3d\xc1\x3b\x7a\x8d\xd4\x3f\xa8\xf2\x27\xbb\xa4\xff\x5f\xdf\xff\x97\xf5\x5d\xe1\x5e\x87\xe1\x66\x81\x0a\xfd\xef\xa7\xd3\x7b\x0a\xe7\x77\x8f\x2f\x5d\x7a\x4f\x4a\xb7\x6f\x64\x7e\x5d\xb5\x3f\x9d\x7b\xe7\x77\x50\x2e\x76\x67\x37\xee\x67\xff\x55\xe8\x57\xf7\x6a\xe1\x45\xf4\x21\x70\x23\x81\xfd\x73\x98\xce\xf6\x6d\x47\xa8\xa2\x41\x3a\xc7\xf5\x1f\x60\x0e\x9e\xe9\xe0\x6e\xf3\x4f\xcf\x74\xf0\x67\x4e\xff\xdf\x00\x00\x00\xff\xff\x31\xab\xe5\x12\xf7\x74\x00\x00")
+var _staticReport_template_localHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x77\x77\xdb\x38\x97\x38\xfc\xbf\x3f\x05\x46\x53\x64\xaf\x2c\x51\xbd\x38\xb6\x9f\x55\x97\x6d\xf5\x2e\xcd\xce\x3b\xcb\x02\x8a\x94\xd8\x44\x90\x6a\x79\xfc\xdd\xdf\x03\x36\x91\x14\x25\x2b\x4e\xb2\x3b\x67\xcf\x2f\x4e\x62\x12\xb8\xb8\x0d\x17\x17\xc0\x45\xe1\xe3\x2f\x8c\x4c\x6b\x7b\x05\x02\x4e\x13\x85\xe7\x9b\x47\xfc\x0b\x08\xa4\xb4\x78\x0a\x41\x29\xf4\x7c\x73\xf3\xc8\x41\x92\x79\xbe\x01\xe0\x51\x84\x1a\x09\x68\x8e\x54\x11\xd4\x9e\x42\xba\xc6\x46\xf3\xa1\x63\x86\x44\x8a\xf0\x29\xb4\xe1\xe1\x56\x91\x55\x2d\x04\x68\x59\xd2\xa0\xa4\x3d\x85\xb6\x3c\xa3\x71\x4f\x0c\xdc\xf0\x34\x8c\x1a\x2f\xf7\x80\x97\x78\x8d\x27\x85\x28\xa2\x49\x01\x3e\x25\xee\x01\xe2\x54\x5e\x5a\x45\x35\x39\xca\xf2\xda\x93\x24\x9f\x20\x66\x20\xa2\x55\x5e\xd1\x78\x59\x72\xe1\x2e\xae\x75\x52\x93\x25\x08\xfa\xd0\xa0\xea\x2f\x45\xea\x1a\x27\xab\xae\x02\x2d\x9e\xe6\x48\x28\x80\x06\x94\x54\x7e\x85\xa0\x04\x6e\x39\x4d\x53\xd0\x03\x41\x68\x5b\x5e\x83\x6a\x8c\x96\x45\x42\xe4\x69\xce\x06\xb8\x3b\x61\x65\x01\x25\xa8\x92\x9a\xac\x06\x31\xb2\xf9\xfa\x35\x36\x86\x2a\xe2\x65\xe9\xfd\xfd\xa4\xa8\x2a\x53\xb2\x86\x5c\xe5\x24\x99\x97\x18\xb8\xbb\x07\x92\xcc\xca\x82\x20\x6f\xcd\x22\x1a\xaf\x09\xf0\xd9\x27\xdd\x23\x61\x26\x63\x00\x81\x97\x56\x40\x85\xc2\x53\x08\x69\x7b\x01\x22\x0e\x42\x2d\x04\x38\x15\xb2\x4f\xa1\x25\xfa\x5b\x90\x69\x52\xf8\x9b\xe5\x05\x88\x08\x4a\x96\x35\xa4\xa9\xa4\x12\x13\x79\x29\x46\x23\x14\xfa\x56\x0c\x1b\x1e\x79\xcb\x1a\x25\x00\x36\x9b\xa7\x90\x06\x77\x1a\x61\xe7\x00\xc0\xca\xb2\x06\x55\xf0\xd5\x78\x01\x80\x92\x55\x06\xaa\x51\x4d\x56\x1e\x40\x42\xd9\x01\x24\x0b\x3c\x03\xd4\x05\x45\xde\xc6\xef\x81\xf9\x37\x96\x48\x66\xee\xbe\x58\x05\x44\x52\x5d\xf0\x92\x59\x20\x13\x57\x76\x76\xba\x42\x32\x0c\x2f\x2d\xbc\x89\x98\x76\x94\x14\xf8\x85\xf4\x00\x68\x28\x69\x50\xb5\x73\x58\x59\xd2\xa2\x88\x3f\xc0\x07\x90\x48\x1e\x0b\xd0\xb2\x20\xab\x0f\x98\xfe\x6d\x36\x7f\x0f\xcc\x7f\x16\xed\xf7\x1b\xb7\x00\x24\xf8\xea\x2d\xc3\x4b\x1c\x54\x79\x0d\xfc\xc2\x8b\xd8\xd4\x48\x49\xb3\x91\x1a\x5c\x30\x90\x96\x55\x12\x9b\xe7\x03\xd0\x25\x06\xaa\x02\x2f\x41\x0f\xe2\x18\x4d\xaa\xb2\x8e\xa0\x00\xbe\x7a\x65\xa5\x64\x4d\x93\x45\xb7\x64\xfe\x12\x51\x5e\x83\xa2\x9f\xa1\x5f\x53\xf9\x14\x93\x4e\x7c\xa4\x8b\x60\x5c\x31\x85\x5c\xc0\x28\x4d\xaa\x8c\x83\xd6\x68\x9a\x0f\x20\x7d\x4e\xc1\x02\x64\x1d\x91\xcd\x5a\x7a\x00\xc9\x8c\xb2\x03\x89\xb8\xb2\x03\x19\xfb\xc9\x06\x61\x78\xa4\x08\xe4\x1e\x2b\x0e\xab\x22\x4a\x09\x32\xbd\xf2\xb2\x84\x78\x69\x21\xc0\xa8\xc9\x8a\x2c\x69\x24\x2f\x41\xd5\xc5\xda\xfd\xc7\x60\xd8\x39\x41\x15\x45\x35\x92\x12\x20\xf8\xea\x63\x0f\x33\x86\xff\x65\xac\x07\x2f\x79\x96\xdc\xf0\xb4\x2c\xf9\x15\x90\xc8\x1e\x85\xe0\x20\xbf\xe0\x34\x6f\xda\x06\xaa\x1a\x4f\x93\x82\xad\x17\x43\x47\x66\x1d\x7a\xf1\x1b\x72\x20\x5a\x85\x50\x42\x9c\xac\xb9\x78\xb7\x29\x2a\x32\xe2\x4d\x93\x51\xa1\x40\x6a\xfc\xc6\xb2\x18\x00\xe4\x0d\x54\x59\x41\xde\x3e\x00\x8e\x67\x18\x28\x7d\xf1\xb6\x27\xdb\x64\xae\x68\x52\x67\xb8\x71\xa4\xd6\x54\x52\xb2\xb9\x30\x9e\x59\x59\x15\x41\x2c\x83\x00\x24\x11\x8c\xca\xba\x53\xe9\xb4\xae\x22\x6c\x78\x07\x59\x16\xa3\xbc\xf4\xc5\xa7\xb6\x78\xfc\xf7\x33\x16\x87\x05\x57\x65\x21\xaa\xa8\x70\x73\x7f\x26\x4f\x82\x3b\xcd\x5f\x13\x99\x6b\x10\x46\x3d\x75\x48\x91\xf4\x6a\xa1\xca\xba\xc4\x44\x79\x91\x5c\xc0\x07\xa0\xab\xc2\x6d\x88\x21\x35\xf2\xc1\x48\x20\xd0\x66\x11\xd9\x89\xc2\xfd\xef\x29\x1a\x6d\x16\x60\x27\x0a\x12\x7a\x0a\xe3\x4e\xe0\x81\x20\xb6\xdb\x6d\x6c\x9b\x8a\xc9\xea\x82\x48\xc6\xe3\x71\x0c\x1c\x06\x2c\x2f\x08\x4f\xe1\xdf\x93\xa9\x2c\x9d\xcb\xe4\x98\x30\xc0\x9d\x5c\x49\xde\x3d\x85\xe3\x20\x0e\xf2\x20\x1f\xfe\x3d\x05\x7f\x4f\xd1\x0a\xa9\x71\x80\x79\x0a\xb7\x32\xb1\x64\x06\xc4\x85\x68\x1a\x98\x3f\x89\x58\x26\x8a\xff\x25\xcd\x7f\xc0\xfa\x1d\xb5\xd2\x0f\x61\xc2\x44\x80\xc9\xfd\x9e\x82\xa1\xbb\x0f\xc4\xc6\xba\xfa\x07\x8a\x9d\x8c\xe5\x0c\xb1\x13\xb1\x0c\xc0\xff\x5c\xa2\x62\x91\x81\x9d\x9e\x8e\x1a\x3f\x57\x8b\xcd\x4b\x0c\x4f\xe3\xfe\x16\x01\x81\x0f\x12\xd9\x76\x88\x66\xfd\x78\xb1\x50\x24\xb3\xf0\x3b\x86\xa8\x6a\xb6\xea\x8c\xb2\xf3\x02\x5f\x70\x29\x67\xad\x3c\xa0\x8c\x76\x74\xaa\x46\x3f\xc4\x92\x22\x2f\xec\x1f\x40\x51\x92\xa5\xbd\x28\xeb\x08\x74\x55\xf9\x1e\x94\x65\x09\xc9\x02\x89\xee\x41\x0b\x4a\x82\x7c\x0f\x5a\xb2\x44\xd2\xf2\x3d\x68\xea\x34\xcf\x90\x56\x3e\xbc\x07\x4d\x9e\xc2\x03\x0e\x5e\x96\x30\x88\x7c\x0f\x2a\x70\x49\x8e\x75\x30\x20\x25\x64\xa5\x94\x78\xdc\xc7\x43\x52\x04\x63\xa8\x92\xee\x9c\xb2\xac\xab\x3c\x54\x41\x1b\x6e\xef\x81\x28\x4b\x32\x52\x48\x1a\xde\x03\x04\x55\x9e\xbd\x42\x94\x98\xe9\x62\xa3\x1b\x52\xd0\x5d\xea\x90\x55\x26\x4a\xa9\x90\x5c\x3d\x00\xe3\x57\x94\x14\x84\x6b\xbc\xfb\xd7\x4f\x3b\x32\xa7\xf6\xec\x32\x99\x13\x8f\xbe\x50\x49\x85\xfb\x26\x3f\x7b\x52\xad\x47\x9f\x9f\x8b\x3b\xf8\x1d\xd2\xc6\xb0\x24\xe9\x4a\x37\xc5\xf8\x26\x47\x6c\x30\x19\xc0\x1a\x49\x21\x59\xd0\x35\x87\x35\x83\x56\xdc\x7e\xc3\xbd\xaf\xeb\xf5\x02\xdf\xc7\x34\xaf\x5a\x04\x99\xc4\x23\xa8\x28\xee\x5a\x04\x72\xff\x3f\xc2\x01\x00\x87\xa8\x31\xc0\x7d\x00\x85\x42\xa1\xf0\xe5\x7c\xdb\x65\x8d\x3f\x41\xe3\x0e\xef\xc0\xce\x1a\x07\x9a\x03\xc4\x64\xe6\x2a\x49\x63\x8a\x2a\x2f\x54\x88\x10\xf8\xea\xad\x4e\x53\xa9\xa4\xae\xc9\x5f\xbc\x19\x96\x83\x70\xe7\x58\xf2\x66\x4e\xc5\x4d\x9d\xf8\x11\xc4\xc9\xdb\xa8\x28\xab\x30\x4a\xe9\x9a\x26\x4b\x7e\xba\x27\xa3\xdb\x8f\x2c\xfb\xd7\x63\xc7\xdd\x92\x19\x52\x38\xdf\x9d\x07\x54\x8b\xdd\x6f\x2b\x32\x7f\x3a\x2c\xc4\x78\x9c\xc6\xce\xc5\x90\xac\x7a\xfd\x5e\x60\x61\x00\xb6\x1c\xaf\xc1\xa8\xe1\x4a\x1e\x80\x24\x6f\x55\x52\xb1\xf1\x02\xf0\x48\x18\x13\x84\xe7\x9b\x47\x02\x3b\x0f\x3c\x89\xa4\x64\x66\x8f\x27\x08\x8f\x12\xb9\x01\xb4\x40\x22\xf4\x14\x92\xc8\x0d\x45\xaa\xc0\xfc\x15\x85\x3b\x85\x94\x98\xa8\xc8\xd8\x09\x0c\xa9\xae\x00\xb5\x30\x7e\x5b\x93\x8b\x47\xd2\x5b\x36\x4a\xa9\xa4\xc4\xd8\xb3\x96\x5f\x43\xcf\xc5\xde\xa8\x38\xec\xb4\xab\x8f\x04\x69\x95\xb0\x2a\xc0\x5b\x4c\x93\x17\x0b\x01\xaa\x21\x6b\x0a\x63\xc2\x84\x00\x1e\x25\x58\x79\x4f\x21\x5a\x16\x04\x52\x41\xd0\x4e\x26\xd5\x05\x9e\xf6\xfe\x6a\x52\x6e\x41\x49\x0f\x59\xba\x20\x55\x9e\xb4\xfb\x66\xe4\x85\x30\xf3\x4c\xd1\x20\xf3\x14\x62\x49\x01\x63\x34\x52\x05\x92\xc2\xb3\xaf\xa1\x41\x0f\x0b\xcd\x2f\x0c\x1f\x6f\xc9\x0a\xc0\x23\x52\xc8\x33\x9c\x1b\xbd\x7f\xe8\xf9\x91\xc0\x20\x96\xa4\x84\x29\xc6\xb3\x69\x31\x8f\x0c\xef\x28\xda\x16\xc5\xd6\xec\x51\x34\x9e\xb1\x31\x1b\x02\x39\x94\x75\xc1\x47\x17\x57\x9b\xa8\x46\x71\x83\x70\xf8\x33\xa6\xa1\x2e\x38\x73\x66\xc1\xa8\xb2\xc2\xc8\x5b\xc9\x05\xe6\xab\xb8\xa8\x31\xf5\xb4\xe1\x2c\x91\x8e\x95\x68\x30\x65\x98\x65\xc5\x46\x05\x54\x59\x38\x57\x4f\x0e\x3d\x17\x39\xab\x4e\x38\x12\x29\xb2\xa2\x2b\x4f\x21\x4d\xd5\xe1\x99\xca\x70\xb3\x09\x40\x17\xd3\x75\xa5\x38\x86\x04\x80\x5f\xab\x8e\x00\xe2\xb1\xa6\x8d\x3a\x15\x20\x43\xed\xfd\x22\x78\xc9\x3c\x92\x27\x58\xb0\xf2\x1c\x25\x10\x46\x61\xc2\xec\x42\x43\xcf\x03\xe3\xb7\xc9\x9c\x8f\xa3\xab\x71\x51\xfb\x28\xe2\x45\x5e\x20\x55\x5e\xdb\x87\x9e\x4b\x7b\x30\x70\x5e\xbf\x03\x27\x27\x23\x0d\x19\xe8\x1a\xf8\xe9\x3b\x30\x59\xd3\x31\x03\x57\xcd\x7c\xfe\x2c\x36\xc3\x85\x85\x9e\x87\xf8\x97\x0f\xc7\x23\xc1\xf0\x9b\x63\xc2\x23\x21\xf0\x17\xed\xd9\x53\x71\xa7\x66\xec\xa7\x6c\x74\x40\xa1\xe7\x3a\xfe\xe5\xa1\xec\x26\xf4\x48\xe8\xc2\xf3\x8d\x87\x9b\x47\x42\x22\x37\x46\xd3\x7d\x14\x49\x5e\xb2\x0c\x1e\x3f\x86\x6c\x92\xce\xb0\xc6\x6c\xb6\xa4\xa2\x58\xbc\x3d\xaa\xb2\xae\xe1\x11\x1a\x0f\xb7\xcf\x8f\x84\xfb\x0d\xe3\x23\x30\x16\x13\xb5\x15\xdb\xc0\xc5\xcd\x47\x1b\x83\x62\x13\x31\x3a\x5e\x51\xd7\x20\x73\x74\xa6\xde\x98\x16\xf8\x43\xe4\x19\x46\xd6\xbe\x00\x91\x64\x20\xd8\xf2\x1a\x67\x7a\x2a\x47\x54\xc3\xf9\x63\x7e\xf1\xa8\x5c\x85\xcc\x17\x63\x10\xbc\x35\x07\x07\x94\x2c\x30\xa1\xe7\x3f\x38\x48\xaa\x1a\xfa\x62\x39\x30\x40\xed\x71\xd5\x9a\xaa\xb4\xe3\x71\xee\x20\x1c\x8e\xca\x85\x80\xed\x83\xff\xa6\x04\x52\x5a\x85\x9e\xad\x60\x9e\x43\xd8\x09\xea\x61\xcd\x03\x52\x62\x4e\x91\xe2\x20\x9f\x1d\xe5\x43\x1c\x14\x04\x94\xa2\xff\x3e\xc5\xdc\xe5\x48\x11\x0c\xf6\xa0\xc5\x4b\x1c\x46\xf6\x48\x28\xb6\xa6\x9e\x4f\x70\xe2\x49\x23\xa5\xef\x45\x48\xd2\x32\xcb\x42\x78\x12\x42\x3c\xc5\xff\xc8\x8b\x0b\x87\x6d\x00\x90\x4a\x3f\xb9\x27\x6b\x8a\xb4\xf8\x42\x91\x08\x66\xd3\xf7\xfc\xb8\xd4\xe9\x6f\xe3\x6f\xf5\x85\x5c\x2c\x16\x8b\xed\xc1\x88\xab\x8e\x16\xc5\x62\xf1\xcd\x78\x17\xca\xc5\x59\xb1\x58\xac\x0c\x56\x8d\xb7\x2e\x4e\xa8\x4f\xfb\xb5\x49\xa3\x3f\xa4\x92\xf3\x38\x93\xac\xed\xe7\xbd\x52\x69\x5e\x2f\xf0\xf3\x41\xe9\x95\x9a\xd4\xa4\xf9\xf8\x55\x98\x4d\xfa\x19\x9a\x16\x04\x5c\xa0\xdc\x29\xbd\xf6\xab\xb5\x11\x6c\xab\x68\xda\x2a\x74\xc7\x55\x9a\x96\x12\xf1\xf1\x6b\x3d\x39\xde\x55\x86\xda\x60\xc8\x56\x95\x17\xa6\x3e\x81\x99\x7a\x9a\x79\x8b\xbf\x12\x55\x76\xdd\xae\xcc\x5a\x91\xb7\x04\x49\x97\x89\x62\x75\xbf\x79\x5d\x97\x1b\x05\xf1\xa5\x2c\x69\x4a\x65\x95\x1f\x6f\x49\x49\x59\x2c\xe3\x89\x56\x31\x3b\x4b\x76\x67\xe2\x8b\x82\xd0\x5b\x4b\x49\x75\xb7\x1d\x76\x97\x9a\x34\x60\x92\x80\x49\x3d\xaf\xa9\xe2\x28\xbf\x9f\x4c\x29\x48\x74\x97\x1d\x26\x97\x3b\x10\xc3\x49\xb7\x39\x58\x74\xb5\x36\xb9\xcc\xac\x3b\xa8\xb8\x78\xeb\x94\xb4\x71\x59\xa6\x8a\xf2\xdb\x76\xdd\x59\x14\xb3\xd4\xf2\x20\x0c\x07\x72\x6d\x5a\x1c\xc1\x56\x7b\xdc\xad\x2f\xe9\xa2\xde\xee\xf1\xeb\x2a\xf3\xb6\x63\x07\xd5\x76\xb9\xb5\x18\xbe\xbc\x1d\x0e\x25\xb2\xf6\xfa\x96\xae\x4a\xc5\xa1\x54\x2b\x17\xc7\x89\xf6\x7c\x99\x5b\x54\xf6\xb9\x22\x3d\x2d\x6c\xcb\xab\x17\x72\x54\x86\xa3\xa1\x3a\xdf\xc3\x65\x24\x49\xb5\x25\x6d\x3d\x2c\x71\x3d\x34\xa5\x8a\xab\x97\x7c\xa7\xb6\x7a\xdd\x42\x82\x81\xfa\x24\xa9\x2d\x67\xa3\x6e\xaa\x40\xd0\x42\x96\x9d\x24\xda\x53\x4a\x4b\x0e\x99\x24\xc1\xe2\x60\x41\x36\x29\x6c\x68\x62\xb8\x4d\xd6\x53\xcb\x65\xa7\x95\x9d\x13\x93\xc6\xa8\x9c\x98\x68\x13\x69\xa8\xa4\x06\xfd\x05\x4f\x69\xab\x11\x45\x15\x36\xda\x98\x4c\x11\x6f\x25\xd4\xd5\x05\x42\x8d\xc8\x72\xa7\xd3\xcc\xc8\x7a\x7c\xce\x4c\x04\x65\x30\xcc\xa4\xf3\x23\x7a\xd3\xdc\x17\xc8\x51\x37\x75\x48\xb7\x6a\x23\x82\x6c\xc7\x73\x4c\x24\x2b\xef\x33\xf4\x66\x12\x89\x67\xbb\xf5\x6d\x3c\xdb\x6d\x71\xca\x74\x96\x2a\x70\xea\x22\xb7\xad\x32\xed\x2a\xda\x12\x30\x5e\xe2\x1a\xfd\x08\x2b\xa4\xdb\x95\xe2\x5e\xce\x47\xd8\xee\x24\x5f\x6b\x2f\xe2\xfa\xb4\x29\xac\x52\xc5\x69\xbc\xf4\x96\x5d\xb0\x07\x5e\x4a\xcc\x84\x37\x45\x1a\x4e\x84\x03\x4a\x56\x53\xbd\x75\x39\xa9\xcf\x7a\xea\xb8\x3f\x18\x67\x0b\x90\x22\xa5\x4d\x4e\xcf\xe9\xdb\x39\x9b\xea\x2f\xf2\xf1\xec\x82\x59\x22\x36\xad\xf1\xdc\x14\x2d\x9a\xb3\x32\x8f\x3a\x69\xfa\x85\x49\x97\x53\x99\x83\x94\x6a\x6d\xd6\x35\x8d\x9a\x24\x95\x1c\x4c\xa0\x71\x79\x31\x1d\x27\x0a\x50\x1a\x2a\xdb\xf4\x0c\x6a\x9c\xb6\xae\x8e\xd7\xb9\xbc\xbe\xde\x34\x6b\xe4\x46\x2e\x11\x87\xb9\xde\xcb\x8f\xb6\x33\x92\x59\xed\xd2\x8b\xde\x4b\xb6\x52\x8d\x74\xf9\x74\x82\x59\x2f\xe5\x6c\x67\x82\xe8\x61\x5b\x3c\xb0\xe3\x64\x9b\x9b\xad\x9a\x73\x62\x41\x4b\xaf\x03\x4a\x9f\xd2\xa9\xf6\xa1\x42\x6d\xe9\x3a\xb7\xde\x6f\x2a\xa4\x3e\xcb\xa5\x6b\xda\x38\xbb\x59\x27\xd6\x9a\x22\xab\x35\x59\x9b\x14\x3b\x07\x94\x1b\x4d\x06\xdd\x78\x82\xd6\x85\xc4\x34\x13\x4f\xa5\x13\x85\xf1\xa8\xde\x9b\x26\x23\xe3\xc2\x2c\x52\x47\xd9\x55\x63\x20\xd2\x7c\x5a\x6f\x72\xa9\x9d\xd0\x6d\x6a\x85\x48\x8a\xec\xe9\xa5\x79\xe9\x30\x58\x95\x2a\x03\x34\xee\xa9\x4c\x8f\x7a\x9b\x0e\x93\x39\x66\x93\x83\x70\xde\x4a\x32\x23\x2a\x19\xd9\x74\xc7\xd2\x26\xa5\x26\x9b\xd2\xaa\xdd\x4b\x10\xb9\x56\xe7\x6d\xd9\x5f\xb7\xa7\x52\x92\x8e\xbf\xd6\x8b\x4c\x6b\x18\x8f\xa8\x83\xf5\x84\x1f\x0b\xcc\x54\x2e\xb4\x89\x5c\x21\x5b\x78\xa9\x27\xb4\x6a\x6d\x90\x79\xdd\x0d\x07\x94\xa2\x16\x84\xc5\x24\xa1\x64\xd9\x06\xab\x66\x22\x04\x23\xbf\x35\xe9\x2d\x31\x1c\xe6\xb7\x9d\x0a\x9f\xd6\xf2\x7c\xa4\xd2\xc8\x2d\x15\xb1\xd1\xd2\x45\x39\x1e\xd9\xad\xb6\xed\xe1\x58\x68\x0f\xab\xb3\x4e\xa5\xba\x8b\xd3\x95\x11\x25\xa6\x51\x9b\x12\xd5\xd4\x34\x45\xf2\x34\xa1\xa7\xd4\x38\x55\x9a\xd7\x99\x7c\xa5\x2d\xcd\x93\xac\xd6\xa8\x4a\xf9\x6d\xa5\x95\xca\x77\xa7\x7d\xa9\x33\x60\x5b\xdc\xb2\x3e\xad\xf5\x16\xa5\xf2\x16\x66\x85\x54\x53\xd8\xad\xb5\x4c\xad\xde\xd6\x19\x66\x93\x52\x0f\xfd\x6c\x64\xa3\x26\xb9\xb2\xb4\xa4\x4a\xf5\x43\x22\x1b\x61\xdf\x04\x69\x2e\x52\x8b\x4d\x67\xf9\x26\xe7\xde\x74\xf6\x8d\x18\x08\x93\xc8\x28\x37\xe9\xe6\x5f\x86\x5a\xbd\xbe\x2e\x32\x11\x8e\x17\xdb\x4c\x8f\xa2\x93\x84\xba\x64\x0a\xeb\xcd\x4e\x6b\x93\xb9\xc8\x52\x5a\x96\xc8\x54\x61\x36\xaf\x4c\x0e\x8d\xed\x94\x1e\xd5\xb2\x25\x69\x36\x69\x94\x3a\x07\x22\x3b\x13\xb3\xcb\xc3\x24\x9e\x5b\xbe\x30\x7c\xaa\x5c\x2e\x20\xf5\x65\xd0\x9d\xd0\x85\x48\xe7\xad\x73\x98\xd0\x72\xbd\xcc\x28\x2a\x9c\x2d\xfa\x62\x72\xd7\x56\x87\x8d\x6e\x55\x28\xe8\xd5\xdc\xbe\x3c\xec\xf5\xd3\x2f\xfa\xaa\xb2\x9d\x6a\xfb\x29\x31\xd9\xb3\xa9\xa2\xf4\xb6\xa8\x34\x47\xc2\x61\xd1\x83\xf4\x3e\xc1\xa7\xb9\xa5\xc4\x47\x5e\xc5\xaa\xc6\xb3\xf9\xed\x90\x7b\x1d\x97\x91\xa0\x92\xa5\x41\xb1\x55\x5d\x10\xc5\xb8\x38\x10\x49\x6e\xb8\x7c\x9b\x2e\x16\xa8\x8e\x16\x29\x39\x43\xd7\xf6\xa5\x71\x56\x7f\x9d\x08\x11\xea\x65\x9d\x2b\xc9\x5b\xa1\x34\xd3\x6b\x62\x9a\x4e\x20\x2e\x52\xdb\x31\x89\x7c\x99\x29\xcc\xe8\x55\x3c\x32\xaa\x96\xf2\xdd\x72\x43\xdb\x2c\x5e\x23\xfb\x0e\x3d\xc8\xbc\x8d\xf2\x85\x62\x29\xc3\x57\xc6\xbb\xe9\x90\x7f\xa1\xb9\xbd\x5e\x4d\xf5\x85\x3e\xd5\x60\x94\x05\x15\x79\x9b\x14\x93\x13\x18\x67\xb9\x76\xaf\xd6\xe5\xe7\xad\x81\xda\x52\xc7\x99\x08\xdb\x59\xbe\xec\x67\x9b\xc4\x88\x9c\xbe\xc0\x6e\x63\xd1\x13\xc7\x8c\xf8\xda\xe9\xa7\x0e\xc5\x76\x76\xc5\xa2\xda\xaa\x22\xf6\xe4\x17\xa2\xd9\xa6\x84\x45\xbc\x0a\x87\xfc\x26\x33\x2b\x15\xe6\xc5\xf6\xb6\x74\xa8\xbf\xd5\x5b\xbb\x75\x45\xe1\x8a\x42\xb5\x9b\xeb\x25\xea\xfc\x7c\xc7\x0e\xcb\x92\x52\x5a\xf5\x3b\x0d\xae\xf9\xda\x14\xde\xda\xcd\x76\x9d\x6f\x1e\xe6\x55\xed\xb5\x95\x44\x45\x22\xdd\x6d\x2c\x77\x89\x6a\x8e\xd9\x13\x2f\xd3\x1c\x84\x9b\xd6\x9c\xae\xd4\x2b\x7d\x4e\x6c\x71\xd4\xa2\xa2\x6d\xd4\x34\x93\x4f\xd4\xa9\x62\x1f\xcd\x32\x99\x56\xa2\x9a\x5b\xa0\xa1\xba\xa6\x8b\xa9\x4e\x39\x3e\xe0\x16\xb5\x57\xbe\x54\x99\xcd\x89\xbe\x3e\xdf\xf7\xf6\xfc\x8c\xa8\xa6\xb9\x45\x3d\xaf\x11\x83\x84\xce\xb4\x65\x54\x2a\x8e\xcb\x1a\x4f\x6b\x39\x9d\xec\x95\xc4\xed\xa2\x7d\xe8\xea\xbd\xd6\xb2\xdd\x57\xea\x91\x39\xb7\xd3\x0a\xaf\xa3\x5d\x33\x95\x48\x11\x8b\x44\x64\xd1\x60\xd3\x15\xbd\xca\x51\x0c\xdc\x4c\x0f\xf9\x51\xbb\xb9\x8a\xef\x58\x31\x93\xa9\x34\xea\x4a\x2e\xd2\xde\xac\x0f\x8d\x64\xe5\x90\x5e\xa1\x3c\x53\x18\xd7\xa9\x22\x29\x17\xf6\x4c\xe4\xad\x98\xdf\xbe\x46\x0a\x53\x95\xa1\x92\x19\x9d\x91\x16\x44\x6e\xbd\xa8\xb3\xcd\x76\x9f\x2d\x74\xc5\x65\xb2\xfc\x2a\x2f\x0b\xd3\x66\x4b\xde\x65\x28\x6d\xf6\x96\x61\xa4\x42\x49\x5a\x88\x63\x36\x51\x20\x96\x8d\xca\x50\x88\xaf\x87\xc3\x69\x7a\x36\x17\x60\xa6\x2b\x95\xd1\x32\x91\xee\x45\x5a\x4d\x51\x9f\x44\x5e\x0f\xaf\x05\x9e\x7d\x55\x16\xfa\x42\xea\x97\xd2\xd2\xae\x1f\xe7\xb5\xcc\x2b\x1d\xcf\x45\xe8\x44\x84\x5a\x26\xe4\xd7\x52\x64\xd7\x8f\x33\x62\x84\x5b\xf5\x75\xa1\xc6\x4e\xe4\xd4\xdb\x98\x48\xf6\xd6\xf1\x71\xa4\xa6\x10\x6d\xba\x4b\xa1\x24\x49\x29\x6f\x49\x65\x4d\x72\xad\x22\x9d\x13\x48\x71\x92\x90\x4b\xa2\x00\xe5\x91\xd8\xcb\x56\xa9\xdd\xcb\x28\x4d\xf5\xc6\x9b\xd7\x0e\xc9\x17\x92\x55\x92\x64\xda\xe5\x97\x7d\x89\x7f\x65\x38\x82\x18\xd4\x88\x4a\x9b\x6a\x6d\x37\x13\xf1\xd0\x28\x67\xba\x62\x79\xc4\x49\xd3\x65\xa7\x43\x0e\x6a\x68\x47\x67\x2a\x42\x72\xb6\x4a\x92\x2c\x4b\xd5\xf4\x44\x26\x51\xea\x32\xb3\x4e\x61\x9b\x65\x27\x65\x96\x59\xee\xbb\xc3\xf5\xcb\x56\x6c\xc5\x99\x64\x24\x5f\x6d\xcf\x5e\xfa\xa3\x44\x52\x4e\x44\x76\xab\x06\x59\x69\xa4\x98\x4a\xeb\x45\x5e\x75\x37\x92\x54\x9c\x2f\x86\x2f\xc5\x55\xa1\x2a\x0f\xd5\x15\xd5\xa8\xd6\x28\xba\xbf\x9f\xd7\x27\x95\x49\xaf\x37\x7f\x1d\xe9\x5a\xaf\x9a\xd3\x4b\x3c\xbb\xef\x20\x66\x35\x95\x32\x4b\x2a\x33\x4f\xd2\xbd\x42\xb3\xd9\x9e\x56\xf3\x75\x72\xb0\x3d\x70\x89\xa6\x2a\x14\xd6\x83\x83\xa8\x8b\xe9\x55\x71\x5a\xd8\x2d\x96\xea\x7e\x30\xe9\x75\xf3\xcd\x41\x3b\xdb\x21\xa9\x56\x46\x29\x27\x95\x6a\x79\x9b\x4e\xd4\x89\x54\xab\x88\x66\xe5\x01\x2c\x4d\x7a\xb0\x26\x6f\xdb\xa5\x64\x4b\xde\x94\x7a\xeb\xd6\x4b\xa6\x35\xaf\x0f\xd7\xfd\x75\x3d\xb2\x95\x06\x63\xb5\xde\x25\xf7\x13\x76\xcf\x36\xfa\xbb\x78\xb2\x97\x2b\xbc\xb2\x07\xb4\x48\xad\x3b\xf3\x82\x5a\xd5\xbb\xb2\x52\xaf\x6c\x67\x4d\x41\x2f\x43\x4d\xd9\x2f\xc5\x4e\xa3\x18\x29\x0f\x72\xb0\x44\x8d\xea\x1b\x9d\x20\xd3\xb9\x97\x19\x3d\xdc\xa5\xdf\x84\x02\x9d\x5f\x96\x78\x2a\x9d\x5b\xbc\x29\xba\x5e\x1e\xf0\x54\x7f\x1c\x4f\x0c\xe3\x6d\x72\xba\x8b\x6f\x97\xeb\x66\xb6\x9c\x9f\x96\x16\x4a\x9b\x1c\x1e\x12\xfb\xf6\x60\x42\x56\xa8\xcd\xf2\xad\xbb\xae\x25\x4b\xb3\x7a\x63\xdb\x9d\x2e\x51\x29\x37\x1a\x0c\x52\x2a\xb5\x7c\x23\xd2\x89\x8e\xbe\x8d\x30\x43\x7d\x29\x90\x52\x61\xde\xcd\x6b\xed\x02\xdb\xad\x16\x56\x07\x61\x24\xe4\x98\x19\xbb\xdb\x6e\x32\xac\xda\x3b\x68\x93\xbd\x52\x43\x6f\x9b\xcc\x06\x76\x96\xaf\xa5\xd2\xa0\x96\xac\x66\xb3\xa3\x42\x77\x50\xe5\xf9\x02\x2b\xe6\x93\x19\x58\x2e\x2e\x26\xe3\x78\xab\x5c\xea\x1f\x64\x66\x81\x12\x4d\x21\x33\xa9\x6f\xdf\xea\x55\xa2\xdd\x5b\xc4\xf5\xc3\x24\x37\x28\x49\xed\x03\x3b\x26\x8b\x3c\xcb\x88\xe9\xd7\x45\x7e\xdb\x59\xaa\xaf\x88\xdf\x11\xea\x82\x6e\x69\x6a\x53\x9b\x34\xda\x62\x49\x53\x69\x3e\x3f\x98\x56\xe8\x97\x42\x57\x9a\x0c\x34\xd8\xc8\x68\x49\xa9\xd4\x2d\xb7\x7a\x3c\xd7\xee\x0c\x0a\xe3\x75\x75\x22\xcc\x15\x96\x4c\xa9\xa3\x05\xd9\x6e\xbf\xc9\xed\x78\xa4\xc7\x26\xb4\x09\xd4\xd9\x8d\xd6\xcd\xaa\x59\xd8\x8e\xb3\x91\x54\x7f\xc3\x45\xc6\x44\x43\x98\xe7\x3b\xc5\x66\xee\x8d\x45\xd5\x5c\x89\x49\xd6\xfb\xaf\x43\x45\x9b\x53\x69\xf4\xaa\x96\xa8\x55\xbb\x5e\x38\x14\x4b\x2f\xdd\x4c\xbc\xfc\x56\xce\xef\xe2\xed\x4c\x2a\x52\xab\xb3\xcc\xcb\x66\xb2\x19\xb2\x79\x36\x25\xac\xb6\xab\xd9\xb0\x3a\xcf\x44\xa6\x59\xb1\xdb\x3c\xcc\xeb\x44\x7e\x1a\x59\x10\xcc\xdb\x74\xb2\xa7\xf6\x5d\xa8\xf0\x73\x99\xd8\xe7\x69\xa2\xc0\x37\x78\x81\xab\x26\xe4\xcd\x6b\x67\x23\x17\xfb\xc2\x61\xd3\xae\x16\x76\xcd\xd2\x64\xa6\xc3\x66\xbd\xf4\xb2\xe9\xc4\x07\x73\x7a\x39\x9d\xc6\x95\xdd\x6c\x53\x3a\x6c\x53\x02\xa7\x8b\xec\xb4\x2e\xcc\xe4\x6a\x22\x53\x28\xcf\xd1\x4e\xd6\x0b\x42\xa2\xb1\x47\xf5\x7a\x7e\x38\x79\xcb\xf2\x1d\x91\x1c\x8b\x99\x01\xb1\xca\xa7\x79\x8d\xcd\x76\x78\x5d\x9e\xe6\x33\xf5\xa4\xda\x2f\xc9\xc4\x6c\x55\xae\x57\xb5\x6e\xba\xf9\x26\xee\x97\xbd\x05\x4a\x71\x39\x3a\x41\xf4\xa0\x9e\xa8\x1f\xf6\xb4\x5e\xad\x55\x0e\x5a\xb7\xdd\x4a\xb7\xa7\xdd\xf6\x90\x49\x57\x0b\x0d\x22\x91\x24\x5f\xa5\x6e\x84\xcb\xca\x6b\x69\xa6\xbd\x76\x37\x11\x99\x5e\x77\x12\x53\x35\x91\xad\x31\x55\x3e\x97\x7f\xeb\xbe\xa4\xca\xa5\xe2\xa4\x3e\xaa\xed\x88\xb4\xba\x5d\xbd\xbc\xe6\xd7\xed\xfa\x81\xe6\xd3\x30\x55\x4f\x71\xa3\xde\xf0\x55\xea\xae\x47\x99\xf6\xa2\x98\xd8\x30\x7a\xa4\x5b\x8d\x08\x39\x9a\x6c\x52\xdb\x22\xb5\xc8\xf4\x49\x65\xcc\x16\xcb\x83\x26\xc3\x56\x51\xba\xb9\x2d\x6a\xeb\x21\x95\x41\x5b\x0e\x16\x23\xa5\x74\x89\x52\xd6\x59\x79\x5c\x6d\x46\x0e\x84\x82\xb2\xc5\xb2\x2c\x6a\xe5\xe9\x42\xda\xcf\xe1\x61\xb9\x6c\x2e\xa6\xca\xa0\x51\x4c\xc1\x7e\x3b\xf2\x5a\x8f\x2f\xba\x44\x15\x4e\xaa\xdb\x76\x3f\x93\xae\xce\x4b\xcb\x65\x4d\x2b\xa5\xd8\xc2\x38\xb5\x2f\xa3\x22\xb5\x1a\x8d\x10\x27\x45\xea\x52\x7c\xd1\xde\x93\x70\x3f\x8e\xd4\x37\x71\xb6\xd8\x9b\x15\x97\x8b\x06\x85\x46\xc9\x01\x97\xe8\x15\x8b\xc5\x62\x71\x30\x1a\x77\xfa\x6f\x99\xf2\xec\xe5\xe5\x29\xe4\x9a\x7a\x90\x82\xf6\x14\x2a\xe9\x7b\xd0\x82\xa0\x08\xca\xc6\x04\x26\x64\x4f\xe1\xec\x08\x27\x0e\xfb\xb8\x17\xbe\xad\x20\xa3\x3f\x39\xf4\xec\x9a\x2b\x3d\x12\xe6\x14\xd3\x9c\x79\x9a\x9b\x37\xcc\x89\x8e\x6f\x77\xc1\x72\xad\x43\x75\x1f\x4d\xc5\x52\xb1\x44\x0c\x09\xbc\x68\xec\x35\x58\x22\x8c\xcd\x2c\xf6\xfc\x01\x06\x45\x56\x14\xa8\x7e\x73\x31\xef\xc6\x88\x6f\x29\x69\x2c\xf0\x23\x1a\x07\x78\xbf\xb5\xe8\x46\x87\xc1\xe4\x7e\x89\x46\x41\x05\x6e\xa0\x20\x2b\x22\x94\x34\xb0\x31\x27\xdc\x40\x66\xc1\x58\xb7\xe6\xd9\x1c\x14\x14\x56\x17\xf0\xf6\x11\xbc\x32\x04\x04\x79\xb1\xe0\xa5\xc5\xcd\xcd\x15\x08\xfa\x46\x58\xe0\x32\x1e\x1f\xeb\xf6\x04\x97\x66\xb0\x8c\x0c\x14\xf8\x8d\x1a\x93\xa0\x46\x48\x8a\x48\x6c\x74\x18\x35\x43\x0d\xff\x99\x8a\xc5\x63\x59\x82\xe1\x91\xe6\x4a\xc5\x12\x1a\x76\x86\xe3\xc6\x0b\x1c\x74\x7a\x0a\x21\x8e\x4c\xe5\xd3\xd1\x61\xbf\x54\xdd\xb4\x67\x7d\x56\xda\x2e\x99\xed\x9e\xe0\x46\xe3\x2a\x3f\xe9\x75\x04\x2a\xce\x74\xdb\x7b\x3e\x52\x8e\x13\x1d\x7d\xde\x99\x1d\x9a\xdd\x4d\xa1\x9b\x6b\x25\xb5\x79\x72\xb9\x7e\x83\x9d\x69\x64\xa5\x0c\x52\x66\xe0\x95\x56\x65\x84\x64\x95\x5f\xf0\xd2\x53\x88\xb4\x97\xd6\x5c\x5a\x05\xd1\xe8\x15\xb5\x61\xb3\xfb\xcd\x15\xc9\xa3\x80\x32\xae\x42\xae\xed\x31\xbb\xa8\x06\x45\x45\x20\x35\x2b\xe4\x8a\x63\x45\x65\x6b\x79\x73\x68\xe7\x3c\xdf\x9c\xc6\x18\x31\xa0\x2b\x6c\x17\xa5\x05\x1d\x69\x50\x05\xf6\xda\x28\x40\x02\xcf\xc0\x10\x78\xc0\x21\x9d\xb0\x9d\xfa\x77\x18\x44\x00\xcf\x58\x81\x52\xac\x7f\x75\x43\x0a\xa7\x01\xcf\x47\xd9\x09\xf3\xda\x45\x5d\x8b\xad\x2e\x40\x33\x32\xf6\xe0\x09\x84\x87\x7f\x3d\x21\xb7\x89\xb2\xb2\xfa\x14\xba\xc5\x5c\xd7\x55\x59\x57\xf0\x46\x2f\x06\xee\xee\x00\x2f\x01\x9c\x88\x5e\x24\x23\x1d\x85\x2c\x64\x06\xfb\x51\x4d\x7e\x0a\x19\x80\x21\xf0\x60\xf1\xf3\x15\x84\x49\x1a\x6f\x88\x08\xe3\x0d\x24\x0c\xdc\x81\xa7\xa7\x27\x10\x07\xef\xa1\x67\x77\x24\x0d\x87\xb7\x64\x2b\x96\xe6\xd7\x9d\x4b\x24\xc9\x89\x74\x5d\x02\xc3\xd1\xbe\x6f\x93\xe1\x63\x66\x5d\x44\x71\xf0\xc8\xd9\x74\x63\x91\xc1\x54\x6c\xc4\x06\xd6\x10\xd8\x44\x29\x5e\x62\x1e\x70\x8a\x59\xff\x4e\xd2\x0a\x5a\x51\xe5\x98\xae\xf3\x0c\x56\x84\x83\xcf\x23\x9c\x19\xe1\x0c\x0c\x5b\x3a\xc2\x5a\xcb\x15\xc6\x96\x8c\x10\x78\x30\x83\x64\x01\x55\x1a\x10\x78\x37\xea\xec\x29\x64\x94\xf4\xc9\xe7\x5e\xb0\x08\x24\x65\xae\x5b\x58\xd1\x79\x63\x63\x8b\x15\x9b\xf7\x2c\x65\x00\x10\xb0\x00\x82\xd4\xa8\x2c\x09\xfb\xd0\x73\x57\x85\x1b\x5e\xd6\xd1\x69\x09\x7f\xa8\xf7\xbc\xd8\x12\xdc\x69\x9f\x13\xdb\x28\x79\x81\xcd\x40\x52\x3f\x42\xec\x36\xdc\x69\x1f\x88\xec\x8f\x6d\x73\x2a\x20\x9e\x6f\x3c\x39\xdf\xea\xa9\xba\xa6\xa7\x62\x7c\x5e\xca\xd7\x80\x18\xe0\x58\xa2\x63\xf2\x7e\x10\x6b\x63\x01\xc0\x0e\x31\xaa\xa9\xba\x44\x63\xa7\x07\x1e\x8c\x3d\x8d\xb6\x5d\xab\x82\x53\x1e\x00\x1c\x24\x05\x9b\x28\xcf\x5a\xb9\xf6\x7e\xad\x3f\xfe\x00\xee\xf7\x18\xde\x80\x12\x02\x0f\x86\x93\x0e\xc8\xb0\x78\xb0\x12\x43\x80\x14\xb4\xa7\x50\xc8\xd6\x0c\xfe\xf9\xed\x2b\xb0\xc9\x83\xf7\x9b\x00\x5d\xba\x65\xf1\x2d\xbc\x1e\x77\x1b\xe0\x76\x2a\x4b\x0f\xb8\x13\x82\x78\xe5\xf9\x29\x84\x37\x4a\x0d\x1c\x48\x4f\xbe\x8e\x77\xd0\x4a\xe7\x01\x44\x79\x03\x9f\x42\xc6\x0e\xb3\xb9\x2c\x8b\x13\x5e\xe3\xca\xc6\x4a\xec\x05\xfd\x70\x24\x72\x23\x73\x29\xe4\xc8\x6e\xd7\xad\x12\xa3\x5a\x30\x12\x9f\x4c\x21\xf0\x60\x28\xc9\xa9\x13\x93\x73\x5a\xe0\xe9\xd5\x53\x48\x56\xa0\x74\xa4\x63\x2c\x47\x7b\xb4\x69\xb1\x05\x05\x04\x3f\x15\xd8\x86\x38\x8c\x5d\x45\xa5\x62\x0b\x07\xb6\x95\x78\x23\xa1\xe0\x94\x7a\xa2\xd4\x1a\x57\xa7\x7c\x3a\x32\x4a\x77\x47\xf5\x94\x4e\xed\xdb\xab\xd7\x6e\xeb\xa0\x95\x79\xe5\x8d\x49\xc1\x54\xa6\x3d\x1a\x8f\xf9\xb9\xb8\x4e\xe5\xa7\x6f\x6b\x5c\xa6\x3c\x2d\xbd\x4c\xa6\x18\x4f\xae\x5a\x2c\x16\x3b\xbb\x62\x7d\xfc\xb6\x4d\x53\xc5\x62\xb1\x46\xc5\x85\x6a\x6f\xdc\x4f\x4b\x9d\xd4\x6c\x38\x66\xa9\x3e\x37\x68\xe4\xe9\xea\x66\x5b\x7a\x19\x56\xca\xdb\x1a\xc9\xbc\xe8\xf4\x84\xe3\x05\xe9\x55\x16\xf7\x39\x4d\x5a\x0f\xe7\xe9\xf5\xac\xd6\xdc\x56\xd9\xaa\x42\xf5\xda\x9d\x72\x37\x35\xdd\x6c\x0e\xd5\xc5\x61\x3b\xa9\x95\xa4\x72\x26\x2b\x69\xf9\x0c\x1a\xa4\x94\x03\x42\xec\x72\xd2\xcb\x1c\x16\x98\xec\xf7\xfc\xa9\xa4\x37\x29\x81\xce\x8a\x7a\x6e\xf5\xca\x4e\x72\x79\xb6\x9b\x25\x92\x43\x26\x4b\x24\x36\xec\x94\xcf\xa8\xe2\xa8\xdb\xce\x10\xf9\x8c\x36\x69\x6f\xa8\xb1\xa4\x67\x7a\x24\xab\xd7\xd5\xd4\x8e\x3f\xf4\x0a\x4c\x5c\xaf\x73\x09\x98\xee\xce\x0a\x85\xcd\x9a\xaf\x0b\x99\x15\x4b\xe5\x5b\x70\x45\x91\x9d\x75\x59\x1a\x25\x99\x0a\x27\xaf\xf9\x55\x7e\xd8\x29\xbc\x4c\x13\xec\x4a\x1b\x8e\x23\x9b\x43\x24\x52\x6e\xea\x53\xad\x90\x66\xa4\xae\xc8\x34\xe3\xd9\xec\x68\x49\x52\xd2\x24\xf5\x3a\x7d\x55\xa9\x56\xaa\x26\x74\xe2\x43\x72\xaa\xa8\x2c\xb5\x54\xa7\x1a\x31\x5b\x0a\xa9\x61\x3a\x9b\xdc\x25\xd9\x89\xa8\xb1\x2d\xb2\x33\x17\x52\x09\x31\x1f\x4f\xb0\xfd\x24\x4a\xe6\xe7\x33\x6d\x15\x51\xd7\xec\x2a\x5b\x4f\xad\x0f\xcb\x52\x5c\x1a\xa5\xb8\x45\xba\x3b\x4a\xa7\xc7\xac\x34\x9e\xa6\xe7\x13\x34\x5f\xef\x5e\xe3\x44\x84\xa9\x76\x9a\x99\x6e\xa6\x50\x29\x6c\x36\xd9\x2d\x2b\xad\xc9\x52\x7c\x9b\x99\xae\x96\xdd\x01\xbb\x26\x72\x49\x4e\x4f\xa2\x89\xda\x48\xed\x72\xdd\x32\x3c\xa8\x6a\xab\xc5\x26\x94\x6e\x91\xa1\xc7\x95\x42\x95\x28\x73\xed\x44\xab\x7b\xe8\xc1\x08\x93\xe2\x0e\xd3\xb8\xdc\xcb\x88\x91\x4d\x65\x9d\xad\xe7\xb8\xf5\x26\x37\x98\x36\xb4\x4a\x91\x9c\x31\x4a\xba\x3d\x96\x48\x62\xd4\x5b\xc4\x5f\xd9\x6e\x24\x37\xeb\x73\xe9\x74\xa2\x26\x36\xb4\x34\x6a\x12\x75\xb5\x3b\xcc\x2d\x15\x22\xf2\x56\x88\xaf\xc9\x4c\x63\xa9\xb2\x7c\x7d\x92\xd4\x86\x33\x89\xae\xef\x89\x51\xb6\xd7\xe8\xf3\xb9\x4d\xab\x18\xcf\xbf\x75\x52\x65\x91\x19\x0a\xea\x2c\x3e\xd6\x53\xc3\xc3\xf6\xad\xd1\x79\x93\xa8\x37\xae\x37\x49\x2a\x83\xd1\xb0\x22\x74\xf7\x54\x36\xde\x9b\xb4\x0a\xf9\x2e\x49\x24\x37\xad\xf2\x8e\x20\x4b\x2f\x95\xf4\x8e\x4e\x89\x55\x32\xd2\x2a\x49\x42\x6f\xc7\x93\x9c\xa8\x0b\x6b\x22\xde\xed\xe5\xe9\xec\x7a\x57\xc9\x4e\x13\xfd\x05\x93\x6c\x0f\xf2\x85\x5e\xb6\x9c\x46\x59\xaa\x72\xd8\xa0\xf2\x8e\x98\xc7\x05\x69\x3a\x99\x95\xd4\xdc\x76\x32\x49\x4e\xa7\x71\x59\xdd\xa6\x67\x1a\x77\xd8\x6d\xd7\xdd\xb6\x04\x1b\xb5\x66\x92\x9f\x89\xd5\x48\x2e\x93\x1b\x91\xd9\x6a\xa7\xdb\x69\xbd\xae\x69\x6e\x29\x96\x7a\x84\x9e\x8e\xac\x37\xc5\xc9\x8c\x79\x9d\xb5\x05\x6e\x92\xd7\xa5\x04\xdc\x0a\xe2\x6b\x4a\x69\x36\xca\x08\x6d\x33\x9b\x1a\xc7\xcd\x4a\x99\xd9\x6b\x24\x8e\xd6\x4d\x7d\x3e\x26\x88\x78\x7c\x4d\xeb\xb4\x44\xb5\x32\x8b\x51\x3b\xc7\x1c\x36\xad\x62\x92\x66\x5e\xe5\xc6\x52\xca\x27\x3a\xaa\x96\x27\xca\x74\x72\xbf\x6d\x36\x3a\x39\xed\xb5\x51\xde\x1e\x68\x51\x5b\x57\xa9\xfc\x5b\x47\x95\x08\x75\x38\x42\x53\x4a\xed\xed\x76\xeb\x3a\xca\x47\x28\x11\xcd\x4b\x72\x77\x9a\x22\xde\x92\xd2\x46\x14\x36\xc9\x4a\xbd\xda\x58\xae\x0b\x4c\x4a\xac\x0e\x26\x9d\x4c\x97\x58\x1f\xd4\x01\x3b\x9a\xe6\x57\xd3\xf4\xaa\x38\xe9\x30\x54\x6a\xb9\x67\x47\x6c\x73\xb1\xa2\x15\xa2\xd2\xdb\xd6\x33\xa3\xc3\x42\xa2\xb3\xba\x3e\x65\x99\xbd\xd2\x9a\x64\x53\xe5\x9d\xa0\xad\xe5\x7c\x26\xbf\xae\x6f\x72\xf9\xc8\xa0\xb0\x79\x69\x74\xd8\xcd\x90\xeb\x75\x73\x85\xed\x70\x42\xb6\x5b\x5b\xad\x96\xaf\x8b\x08\xbd\x21\x54\xde\x0d\x97\x6b\x3a\x5b\x69\x77\x6b\x43\xae\x93\xa6\xeb\xa5\x0c\xb5\x21\x28\xb1\x34\xef\xcb\xf9\x48\x99\xd8\x77\x45\xa2\xbb\x18\x51\xd3\x29\x3f\x26\x36\xaf\xa3\x4d\x76\x90\xae\x4a\x88\x9d\x2c\x50\xa3\xad\xf2\x05\x26\x25\x15\x27\x1d\x86\x5d\x6f\x68\x4a\x4c\xab\xfb\x49\x6e\x2f\x0e\xcb\x34\x3b\x9e\x2c\xc6\x89\x8d\x58\x26\x14\x71\x8e\xd8\x64\x13\xa6\xf4\xe9\x60\xb8\xad\x89\x8d\xc1\xa4\xc2\x34\xb8\x61\x87\x10\x8a\x6d\x98\xeb\xcf\xea\xf2\xbc\xd9\xed\x21\x3a\x9b\xdd\x55\xea\x93\xd2\x6e\xc1\x24\x5f\x0b\x12\xcb\x6b\x91\x56\x0a\x35\xbb\x54\xb6\x2a\x90\x6d\x6e\xd9\xa9\x44\x0e\x94\x98\x69\xad\xe8\xf6\x9c\x6b\x50\xbc\x26\x44\x4a\xb3\x6c\x41\x97\x28\x4d\x22\x97\xec\x80\x17\x5a\xec\xb6\xd9\x28\x8d\x33\xb9\x7c\xbf\xbd\x9b\xcd\x61\x7d\xdc\x7d\x5d\x6e\xdf\xd2\xd9\xdd\x98\x4b\x0e\xd6\xb4\x24\x4d\xe6\xcc\xf4\x8d\x3f\xe8\xfb\x82\x38\xef\x25\x5e\xea\x87\x8a\xbe\x29\xae\x77\x84\x50\x5e\xee\x66\x79\x22\xbe\xa9\x51\x8a\x5a\x5b\xe7\xb2\xcd\x46\x69\x9c\xd8\x16\x0e\x93\x49\x65\x51\x90\x67\x91\x37\x56\xca\x4d\x37\x8b\xfe\x2c\xa7\xec\x94\x3d\x31\xa4\x0f\xa3\x14\x6a\x8e\x52\x68\xc9\xab\xdb\x9a\xd8\x60\x60\xb9\x34\x17\x0f\xf3\x8e\x5a\xd8\x51\xf1\xd6\x2c\x93\xdf\x0c\xb7\xb5\x29\xd3\xde\x2e\xd1\x7c\xd9\xe4\x56\xcd\xc1\x5b\xb6\x32\xdc\x92\xca\x7c\x53\x90\xa7\xc5\x84\x96\x5d\x2d\xa8\x56\x27\x9b\xaf\x44\x22\xad\xed\x34\xc5\xf4\x5e\xb5\xc6\x2e\x3f\x4f\x57\xe6\xed\x84\x34\xa0\x36\xe5\x42\xaa\x42\xe4\x53\x70\x9d\xec\xf2\xfd\x6e\x69\x9d\x68\x90\xf3\x15\xca\x77\xc5\x92\x46\xa5\xe6\x83\xf9\x3c\x9e\x10\xab\x4c\xa4\x19\x6f\x4e\x69\x91\xcd\xa4\xa6\x89\x64\x61\x48\x4c\xab\xdb\xca\x38\x35\x9d\xc8\xec\x36\x53\xe3\xc4\x74\x04\x36\x5e\x28\xa4\x76\x88\xac\x3c\xe6\x7a\x99\x7d\x5d\xa2\xea\x2d\x45\x4a\x10\xad\x0a\xb9\xe1\x1a\x83\xc4\x30\xdf\x8d\x6f\xb3\xea\xb6\x53\x17\xf5\xfa\xb0\xd1\x15\x84\xcd\x22\xff\x9a\x64\xa8\x6e\x91\x99\x27\x98\x21\x6c\xd5\x08\x89\xeb\x45\x94\x3c\x75\xa0\x53\x65\x82\x3d\x94\x2a\x91\x6c\x72\x9a\xd7\x53\xe4\xba\x41\x6c\xc6\xe5\xb4\x40\x6c\x5e\x0f\xf9\xee\x61\x3a\xa8\x36\x22\x9b\x75\x44\xcc\xf5\xd9\x88\xd0\x13\x37\x85\x56\x82\x6e\x2b\x5c\x6d\xc8\xb5\x12\xa9\x34\xd3\xa6\xa8\x64\x96\x97\xe4\x42\x36\x5d\xd7\x16\xf5\xc8\x20\xa2\xac\x94\x32\xbb\xcc\x1f\x38\x7e\x32\x22\x38\x72\xfb\xd6\x7d\x6d\x96\x72\x49\x5d\x4a\x2b\xf1\x8e\x34\x8c\x27\x99\xe5\x32\x23\xeb\xb5\x7c\x56\xa2\x73\x6c\x9e\xce\xf5\x19\x3a\xd9\x59\x49\x9a\x74\x38\xa4\x57\xb9\xf1\xa6\x30\x14\x61\x6e\x58\xec\x48\x8d\x31\x59\xda\x6e\x59\x82\xd8\x25\x24\x85\xca\x74\x88\x7e\x6d\xbe\xe9\xab\xb3\x88\x1e\x17\x99\x61\x73\xa0\x0c\x0f\x15\x8e\xab\x37\x0a\xfd\x41\x64\x2a\xea\xa9\x61\x25\x3d\x65\x52\x2c\xcc\x45\xa6\x3a\xdb\x8f\x97\x8b\xc5\x62\xb1\x58\x2c\x16\x3f\xf7\xbb\x92\x6f\x13\xe9\x5a\x2a\x95\xe7\x0f\x4c\x7d\x37\x99\xe4\x8d\xd4\xc1\x68\xdc\xe9\xbf\x65\xca\xb3\x97\x97\xa7\x0f\x47\x18\xc6\x78\x2b\x2a\xc9\x9e\x41\x07\xf1\xfc\xd1\xd8\xcb\x18\xb0\xe0\xad\x64\xee\x51\x10\x97\xf1\x64\x1b\xe3\xc9\x90\x7b\x5c\x84\xff\x1b\x1a\xa9\xcf\xf6\x48\xcf\x49\x02\xef\x8f\x04\x97\xb9\x02\x1b\x1e\xce\x3c\x3f\x42\xf1\xb9\x2d\x03\x23\xf1\x91\x80\xe2\xb3\xaf\xb0\xb3\x8d\xc2\xe4\xc4\x3f\x55\x30\x07\xf6\xf6\x14\x37\x6c\x6e\x4d\x36\xc6\xc3\xc6\x16\x5a\x73\x68\xbc\x55\x49\x05\xe0\x79\x88\x91\x5d\xc6\xb0\x35\x59\x1d\x68\xa4\xa6\xa3\xdb\xbb\xa3\x08\xc8\x48\x01\xef\x01\x73\x02\x9c\xe0\x19\x18\x1a\x23\xef\xa2\xce\xf0\x5a\xc8\x4f\xfe\x84\x52\x5d\x25\x19\x78\xeb\x2f\x17\x5b\xe0\xe4\xbb\xe3\x78\xdd\x95\x37\xd0\x45\x91\x54\xf7\x27\x65\xee\x42\xcf\x0d\x03\x0a\x3d\x38\x43\x6c\x57\xb6\x89\x32\x50\x00\xd2\x9e\x86\x6b\xe4\xc2\x9e\x1e\xc7\x34\x72\x81\x9c\x39\x9b\x46\x2e\x62\xe6\xfe\x1b\xdf\xd6\x0a\xbb\x06\x0c\x99\x80\xf1\x7f\x54\xe1\x05\xc1\xa5\x67\xbf\x0e\xa2\x58\x07\x18\x21\x8e\xd8\x18\x0a\x36\x5e\xf0\x81\x84\x77\xdf\xfc\x4a\xb9\x5c\xd9\x6e\xa5\x6b\xbc\xc8\x4b\x0b\x5f\xfd\x8b\xa4\x20\x04\x6c\xb5\x01\x96\x52\x87\xbc\x08\x81\x26\x03\x96\x57\x91\x06\xa8\xbd\x06\x01\x01\x34\x59\x23\x05\xa0\x42\xa4\xc8\x12\x82\x40\xe3\x45\x18\x7a\x1e\x0e\x6b\x25\xac\xd4\x16\xa9\x71\x31\x63\x17\xfc\xad\x8b\x6a\xcc\x40\x50\xda\x6b\xf0\x0e\xbc\x03\x11\x1d\xf7\xec\x0c\x0d\x64\xe7\x0b\x1a\xc4\xcc\x42\x8f\x84\xc1\xae\x4b\xe2\x6f\x11\x9f\xc5\x3c\x75\x7c\xbb\x00\xcf\xc8\xef\xae\x9b\xe7\x1a\x2e\x08\x64\x09\x4f\xdf\xad\xca\xf6\x20\x3c\xa9\x70\xe3\x14\x9c\x24\xab\x90\x85\xaa\x8a\x03\x3d\xb6\xad\x59\x25\xb0\x85\x91\xcf\xe0\x96\x81\x8a\xc6\x39\x86\x68\xbe\xbd\xdf\x5d\x92\xf2\xb2\x1f\xf2\xec\xa0\xb2\xcc\xd6\xda\x0c\xe6\xf8\x3f\x4a\x93\x00\xa5\x49\xf8\x14\x8d\x71\x08\x4a\x51\x79\xdc\x54\x8c\x34\x24\xe2\x30\x1e\x63\x6d\x23\xf3\xcf\xb0\x2a\x50\x23\x79\x01\x99\xd3\xab\xe7\x31\x0f\xb7\xc0\x4a\x32\xa4\x79\x24\xcf\x91\x40\x90\x96\x25\x26\x88\x08\x60\x05\x99\xd4\xcc\xc3\x0f\x4e\x43\x3a\xce\xf1\x3e\xd4\xeb\x98\x47\xbc\x06\x70\x44\xc0\xd5\x2a\x5c\x3a\xfa\x74\x90\x01\xf3\xd0\x96\x35\x88\x2e\x44\x19\xac\x0e\x43\x83\x28\xe4\xa9\x11\xcb\x51\x48\xb2\x06\xb1\xa7\xc0\xbf\x5d\x91\xb9\x30\x29\x40\x55\x03\xc6\xff\x46\x33\xc7\xf9\x31\xcc\x8a\x1d\xe3\x31\xb2\x0c\x9b\x31\xb3\xac\x56\xff\x63\x84\x2a\x2a\xfc\x47\x22\x91\x0a\x1f\x28\x10\x52\x20\x8d\x05\xba\x25\x15\x1e\xfc\x0b\x90\x0a\x1f\xc3\x66\x41\x2a\xfc\x40\x81\x34\x02\x0f\x40\xd2\x05\xe1\x0e\xfc\xfb\xdf\xe0\xcf\xbf\x1c\x0c\xb8\x03\x4b\x61\x61\x70\xf1\x98\xbd\x02\xf0\x6e\xf8\x5f\x23\xc9\x70\x34\xb8\x50\x78\x24\x19\xcf\x0c\x28\x76\x5f\xc2\xe0\xfd\xbc\x73\x72\xd0\x91\x0a\x6f\xed\x02\xc4\x4d\xca\x00\xc7\x1d\x60\xca\x45\x5c\x79\x3e\xb6\x5a\xa3\xcc\x75\x96\x65\x53\x30\x02\x30\xd8\xb8\x8e\x0e\xcb\x87\xcf\x8c\xf5\xf8\x10\x9a\xcd\x03\xeb\xc5\xb3\x57\x0f\xff\x7d\x34\xb6\x82\x3a\x52\x19\x2f\x46\x52\x14\x69\x2a\xaf\x40\xc6\x7a\xe3\x70\xc4\xc6\x7a\x46\xa2\x4b\x9f\x18\x05\xee\xb4\x1c\x14\xf8\x25\x2a\x18\x6d\xc8\x0d\x85\xe1\x54\x6f\x02\x4e\xe2\x00\xa2\x65\x6c\xf8\xb4\x2c\x84\x9e\x5b\x50\xe3\x64\xe6\x91\xd0\xb8\x8f\x20\x71\x9c\xe6\x1a\x38\xab\xef\x3d\x05\x7d\x24\xbc\xec\x60\x08\xeb\x20\xb7\xfd\xf3\xa8\xd9\xbb\xf2\x8f\x7f\x1e\x35\xd5\xb6\x40\x59\xb1\x0f\x1d\xf1\x92\x59\x3d\x4e\x0a\x3a\x31\x3b\xbb\x34\x83\xad\xc5\x81\x8b\x89\x86\xc0\xd8\x5c\x34\x26\x00\xd8\x51\xaa\xcb\xff\xdb\xb6\x80\xf7\x3f\x62\x2d\x80\x3f\xfe\xf0\x25\xfc\xf2\xf4\x04\xc2\x44\x18\xfc\xcb\x97\xfe\x00\xc2\x61\xf0\xee\xa1\x8f\xcd\xe5\x2c\x75\x2f\xab\xc8\xd4\x24\x96\xec\x98\xe8\x3c\xbd\x30\x41\x68\x02\x94\xec\x55\xe9\x23\x61\x98\x94\x9d\xe0\xf2\x2b\xde\xd6\x0e\x25\xc6\x38\x60\xe1\x6b\xf1\xc6\x6e\xe2\xb5\x50\xb5\x72\x2f\xb7\x7a\x63\xcf\x71\xaf\xf9\x60\xb5\x64\x2c\x9d\x8d\xd6\x6e\x58\xc1\x6d\xd6\xea\xb6\x7f\x71\xa0\x79\x1c\x24\xc7\xba\xc5\x3a\x0c\x3d\xbf\xb8\x5f\x01\x8f\x00\xc3\x23\x2c\x15\x13\xf3\x36\x35\xc5\x1e\x26\x3b\x49\x00\x9c\x94\x85\x92\x51\xf4\x01\xb8\xd9\xc3\x0e\x19\x81\x77\xc3\x9d\xa2\x98\xab\xcd\x3b\x10\x97\xda\x3d\xcd\x41\x91\x34\x5a\x3e\xa5\x9e\x1b\x07\x3b\x88\xf0\x62\x33\x8f\x3b\x91\x47\xa4\xa9\xb2\xb4\x78\xee\x99\x09\x0f\xf8\xd0\x8a\x91\xe0\xe1\xcc\x02\x8f\x2d\x65\x5e\xba\x0d\xdf\x83\xf0\x1d\x78\x7f\xa4\xd4\x80\xb8\x7d\x20\x35\x51\xd7\x0c\xf3\x71\xd1\x6b\xd9\x49\x67\x28\x3a\x45\x3e\x4b\x13\xe9\x94\x73\x55\x82\x8b\xee\xc0\x9d\x7c\x86\xb6\xa7\xa8\x97\xbe\x8f\xb6\xab\xe6\x5d\x46\xfd\x5d\x9d\xe5\x2b\xb9\x21\x07\x06\xe4\x47\x7d\xe6\x92\xdc\x90\xa6\x2c\x21\x5f\x63\xc2\xca\x0f\xc8\x35\x1b\x88\x89\x1c\x3d\xf8\xec\xdf\xde\x8b\x6f\xbd\x0a\xbc\xdd\x28\x2d\xb6\x79\x09\x1c\x51\xc6\xcc\x5f\xb6\x03\x0c\x72\x60\x2e\x64\xc0\xdd\x7d\x19\x05\xcf\x18\x32\x76\x7a\x66\xbe\x79\x5a\x1e\xfc\x0b\x84\x5f\xcc\x27\x93\x60\x18\x3c\xd8\x10\x4e\x27\xe9\x27\x64\x8a\x6f\x41\x21\x59\x57\x69\xd8\x22\x15\xec\x1a\x9d\xd1\x5e\x70\xa6\x8f\x9b\xb3\x53\x28\xf3\x71\x4b\xaa\x92\x31\xb3\x19\x18\x58\x40\x8b\x54\x7c\xdc\xf8\x57\x68\x75\x21\xc0\xdd\xb8\x74\xaa\x71\xbc\xca\x74\x49\x55\xdb\x77\x8c\xe5\x7c\x97\xd5\x0e\x71\x56\x54\xc1\x79\x96\xfc\xc0\x5c\xf2\xf7\x99\xf0\x25\x6c\x3e\x43\x76\xbb\xac\x20\xab\x89\xd9\xcd\xe1\x38\xd2\xc4\x3f\x36\x47\x8e\x33\x3e\x32\xe0\x86\x52\x54\x18\x64\x14\x5e\x16\x1d\x0a\x16\x6b\xff\x25\x59\xac\xa9\x2e\xe7\xe9\x6a\x57\x3f\xae\x91\x0d\x20\xad\x42\xed\xc3\x81\x36\x32\xc1\x82\x9a\x97\x3f\xcb\x6a\x5b\x66\xaa\xbf\x6d\xfd\xa3\x46\x5f\x7d\x5d\x80\xa7\x03\xa5\x53\xb8\x1a\x7f\x1d\x5c\x75\x47\x43\x55\xd1\xae\x01\x6d\x90\x88\xfb\x19\x63\x34\xb3\x32\xf0\xa8\xe1\xb4\x5a\x6c\x60\x63\x48\x66\x66\xc7\x54\x5d\x80\x67\xc7\x43\x41\x76\xeb\xf2\x5f\x26\x06\xbc\xf9\x25\xd8\x7f\x1d\xf3\xad\x31\xfc\x7f\x82\x63\xb2\xcc\xb2\x08\x6a\xdf\x46\x9a\x96\x19\xe8\xc2\x0c\x4d\x75\x63\x1c\x46\xce\xb7\xa0\x72\x46\x44\x16\x2e\x8e\x44\x9c\x7b\x40\xa4\x31\x1f\xd6\xcc\xb5\x03\xbb\xef\x6a\x9e\x65\xa4\x7c\xd4\x34\x69\xa4\x04\x35\x4b\x77\xb2\xd9\x24\xcb\xe6\x05\x4c\xd1\x01\xa4\x75\xbc\xfd\x2a\xda\x95\x05\x9e\xde\xbb\xc7\x0c\x34\x52\x62\x2a\xc4\xdb\xf7\x3a\xc6\xd6\x0a\x70\x6b\xbe\x19\x3b\x2d\xee\xac\x3e\xdf\xdf\xa6\xcf\x7b\x38\x8c\x4e\x31\x88\x9c\x3a\x33\x43\x5d\x2e\xb2\x2c\xde\x68\x24\xe1\x88\xde\x3f\xc4\x3b\x0c\xe0\x06\xdf\xef\xb3\xbf\xa6\x39\x57\x78\x15\x8f\x86\x37\x57\xba\x13\x43\xd2\x9f\xd1\xfc\x2d\x25\xe2\xf6\xef\xd1\xa9\xb7\x00\xe6\x9f\x79\x0e\x0c\x3e\x9f\xc6\x99\x2d\x2d\xdc\x5a\xb8\x62\xc8\x4a\x30\x23\xcf\xfe\x54\x67\x60\x78\xa6\x31\xba\xcb\x30\xb6\xd2\xc0\xfb\x35\xd0\xc7\xfb\xbe\xc0\xfb\x3f\xa7\x81\x8e\xf1\xd6\x25\xe9\xe3\x0e\x74\x63\xc1\x05\x35\xd5\x93\x3c\xb3\xbd\x8e\x10\x54\xa3\xc5\x05\xde\xfb\x69\x53\xf9\x47\x77\xa7\x6d\x52\xbc\xca\xfe\xcd\xa5\x8b\xab\x20\x5d\x07\x92\x3f\x86\xae\xf0\x2c\x0b\x55\x28\xd1\x10\xfd\x8c\xb6\x65\xd5\x12\x6e\x5b\x4e\x85\x39\xcd\xc7\x4a\x89\x31\x06\x0f\x08\x0f\xd7\x8d\xba\xb1\x07\xc7\x78\xb8\x1e\x0e\xfb\x74\x8a\xd1\xfb\xcc\xd8\x33\x72\xb7\x91\x62\xb6\x3c\xa3\xf6\xd3\x8c\x80\xfe\xd7\x06\xc2\x17\xdf\x05\xcc\x0f\xdc\xd3\x45\x63\x2d\x2b\xa0\x88\x7f\x7a\x69\x29\xee\x5c\x53\xb5\x8b\x1f\x57\xa2\xce\x82\xba\x56\x1b\x9c\x52\x4e\x65\x83\xff\xc0\xd7\x55\xdc\x81\xf7\xdf\xcf\x60\x38\xd3\xdd\x38\xa8\x98\xa3\x25\x98\xf3\xb2\x3b\xdf\x90\x5f\x63\x3e\xb4\x8e\x6b\xbd\x87\xa7\x25\x8b\x32\xc5\x0b\xf0\xb8\x19\xca\xa8\x1c\x1b\xce\x6c\xd5\x2d\x03\x04\x1c\x61\xfc\x6d\xda\x19\x60\x05\x22\x3b\xa9\x69\x63\x77\x95\xb9\xb5\x2b\xb8\x80\xa5\x2b\x5e\x5c\x44\x35\x4e\x17\x29\x89\xe4\x05\x67\x43\xbf\x48\xee\xa2\xf6\xa6\xfe\x34\xbe\xdf\x26\xf4\xec\xb2\x14\x97\x98\xdf\xe5\x24\xcb\xb2\xbc\xe2\x3f\x8e\xe6\xd3\x26\x58\x28\x40\xb1\xfe\x2c\x7b\x44\x63\xa4\xfe\x9f\xf0\x8a\x15\x19\x1f\xcb\xff\x91\xa1\xe0\xea\x4e\xe1\x55\x88\xae\x01\x35\x86\x84\x57\xb1\xd9\xd0\x34\x05\x8f\x0d\xaf\xc2\x4a\x8a\x70\xc0\x6b\x57\xe1\x7d\x41\x48\xff\x39\x7e\xdb\x34\x1d\xec\xb6\x6d\x23\x72\xbc\xb6\x99\x10\xe3\x0d\xda\xdf\xe0\xb3\xcf\xf8\x1f\x0b\x9d\xed\x3d\x35\xe6\x9b\x8b\x32\x86\x11\x7c\xb2\xf0\x87\xb1\x6d\x0b\x0e\x9a\x76\x81\x3d\xe3\xad\x95\x24\x92\xbb\xe2\x02\x82\x67\x10\x07\xff\x02\xde\xb4\x08\x08\x03\x64\x74\x5f\x03\x88\xf0\xf9\x91\xf0\xdd\x35\x44\x90\x61\x51\x58\xa7\x33\x68\x16\x6f\xcb\xe1\x6b\x0a\x72\x96\x81\x7d\xa2\x28\xb2\xec\xed\x22\xec\xad\xb7\xd2\x7f\x76\xf7\xf0\x5d\x7e\xd3\xda\x2d\x31\xc4\x2e\xca\xef\x3c\x3f\xe5\xe4\xc0\xe9\x8d\x6e\x8e\x7d\x5f\xe3\xf3\x7c\xfe\xce\xdf\x88\x4d\x7e\xfd\x8d\xd8\x0f\x35\xc6\x17\xc7\x79\x81\xdc\x0a\xf6\xb5\x70\x7f\xeb\x76\xb5\x6c\x6b\x03\x39\x2f\x01\x4b\x22\x57\xcb\xb6\xa6\x2f\x26\x47\xb7\x66\xfe\x9d\x4b\x12\x4f\x7b\xb2\x6e\xb4\xc3\x0d\xd7\x68\xc8\xe6\xbb\x33\x0c\xd2\x98\xcb\xe5\x8c\x9b\xf0\xdc\x05\x8d\x04\x7f\x49\x9f\x8c\x47\xa9\x5c\xc6\xf3\xad\x46\x62\xde\x07\x84\x57\xe0\x2f\xf4\xad\xaa\xbc\x05\x81\x77\xef\xb9\xd4\xe1\x86\xa7\x65\x21\x9a\x76\xe5\xf9\xce\xa9\xf8\x4f\xa3\x04\x1f\x3b\x71\x35\x81\x20\xfc\xf9\x00\xfc\x1e\xb3\xb4\x09\x59\x89\x9e\x8d\x4b\xc8\xa1\x69\xbd\x47\x3d\xad\xef\xc7\xb5\x3f\x54\xda\x1f\xef\x44\x3a\xa3\x65\x9b\xea\x23\x97\xb4\x05\xb4\x6e\xba\x8d\xa6\xcd\x7d\x2c\xe6\x7d\x75\xde\x0b\x0e\x81\x42\x45\x53\xb8\x2b\x5f\x40\x04\x28\xef\xd5\x4b\x5c\x32\x68\x00\x64\x1e\xf4\x7a\x31\x4e\x13\x45\x41\x02\x3c\x1a\x6d\xf9\x58\xae\x6c\x02\xa0\x98\x00\xa5\x85\xc6\x39\x07\x97\x3c\x05\x71\x27\x68\xc1\x0d\xe5\x01\x67\xdd\x2e\xed\xab\x64\x7c\xdc\x40\xb0\xf5\x6f\xab\xe2\x94\xd0\x9f\x1e\xcc\x51\x90\xf8\xcb\x3c\x86\x64\x97\xc4\xa5\xd0\x37\x14\x36\xe0\xed\x2b\xd4\xf0\x8f\xff\x94\xd3\xf5\x2c\xb8\x84\x72\x6c\xd3\x90\xea\xf9\xe6\xc4\x40\x8e\x57\xc2\xfd\xa7\xb5\xdb\xc6\x42\x6a\x69\x08\x44\x9e\x40\x22\x83\xcf\xa7\x59\x8b\x9c\x27\x00\xcf\x4f\x1f\x55\x85\x6f\x67\x8e\x7b\xd3\x8f\xb0\x30\x92\x8c\xcb\x90\x81\xff\x9a\xc0\xd0\xb3\x41\xa0\x25\xab\xf0\x78\x9b\xdb\x8f\xb0\x6a\xe3\x6a\xae\x9f\x6a\xd0\xd6\xe5\x5f\xdf\x62\xcb\x36\x5f\x3f\xc9\x82\x6d\xf4\x01\x46\x13\x6c\xb5\x17\x0a\x7c\x68\xab\x97\x89\xfd\xaf\xd8\xe7\x89\x7a\xff\x71\x56\x69\x5d\xf2\xf6\x53\xed\xd2\xb9\x48\xee\x1b\x2d\xd3\x2a\xf7\x79\xdb\x3c\xee\x23\x16\x35\x7f\xf7\xea\x3d\xb7\x75\xa4\x16\x60\x3d\xe7\xce\xb8\x7d\x43\x21\x8b\x8d\x0b\xe7\xdf\xbc\x1b\x09\xae\x46\xef\x8c\x9f\xbe\xa9\x44\x50\xa0\xe9\x11\x8a\x76\x58\x6a\x24\xad\x24\x79\x2b\x01\xab\x88\xb1\xcb\xfa\x8a\x2d\xa4\xa1\x67\x51\xe4\x52\x0f\xe0\x5b\xb8\xc1\x25\x80\xfb\x3e\x3b\x26\xf3\x8d\x08\x98\x8c\xbb\xfc\x35\x45\x0d\x55\x59\x56\x05\xde\x4d\x78\x7b\x19\xca\x11\xd3\xbf\xab\xfc\x03\x37\x77\x9e\xda\x59\x47\xf7\x01\x83\x1f\xb8\xba\x8b\x04\xff\xb7\x9c\x9d\xbf\xc5\xfe\x73\xdc\xdd\x71\xd4\x8e\x7e\x9a\xaf\x3b\xe3\xe0\x70\x05\x9c\x78\x37\xbf\x53\x3b\x02\x59\x5b\xed\x2d\xe5\xba\x9c\xd6\xa3\x6b\x42\x71\x62\x81\x7f\x7a\xa8\x04\x0c\x0b\x83\xe1\x42\xa7\xa6\x15\x88\x09\xef\x7b\x3a\x52\xbf\xca\x8a\x5c\x42\x04\x98\x90\x3b\xf7\xf9\xc9\xa7\x93\x7f\x8e\xd9\x98\x6c\x62\x9e\xff\x67\xac\xe6\xf3\x11\x06\x7f\x68\xe1\xba\xe0\x42\x40\x38\x15\x87\x0e\xec\x9e\x56\x16\x74\xd1\xd8\x7a\x6a\x3e\xa1\x90\x3b\xa8\x60\x63\xb6\xaf\xab\xf6\xee\x9f\xc7\xa9\xa5\xfd\xad\x59\x30\xb6\x82\x7b\x6f\x24\xc0\x3a\xf2\x6d\x65\xe3\x5e\x0c\xbc\xfb\xb2\xdd\xfd\x21\xc6\xf6\x06\xf7\xc6\x9d\x0e\x47\x94\x46\xc7\x87\xb3\x2a\x10\xd1\x38\x78\xf5\xc7\xaf\x85\x6c\x36\xfe\xc5\x88\x7d\xe1\xe7\x0c\x7e\x0e\xe8\xeb\x00\xf0\xc6\x42\xbc\x91\x82\x80\x88\xe7\x69\xbc\xd3\x15\x13\xb1\x2f\x90\xc0\x9c\x40\xc6\xa8\xd4\x10\x38\xb6\x28\xff\xfc\xe8\x42\x60\xf1\x91\xfc\xc4\x91\x00\xab\xcb\x3b\xee\x49\xf3\x47\x4f\x9c\x38\x9c\xef\xcc\x92\xc6\x5c\xc5\x54\xe0\x79\xad\x20\x0a\x17\xea\xef\x7f\xf9\x08\x94\x2d\x42\xc0\xc9\x27\x70\x1b\x94\x69\xdc\x2b\x04\xde\xed\xed\x17\x5e\x51\x03\xd5\x6b\x0d\x8a\xce\x88\x8b\x0d\xf5\x54\x18\x91\x47\xd8\xa7\x07\xaf\x97\x05\x6f\x8b\x39\xb1\x53\xb7\x5d\x7a\x42\xa2\x9f\xf6\x79\xc6\x86\xe7\x33\xee\xce\xb6\x0f\xdf\x47\x1e\x1c\xeb\x3e\x81\x71\xa1\x0c\x3d\x3b\x2c\x05\xa3\xf3\x7d\x32\xc0\x55\xb4\x69\xe6\x74\xac\x0c\x1b\x05\x8e\x00\xa5\x9e\xad\x4c\x60\x40\xc6\x62\x31\xdf\xca\x94\x8b\x8c\xfd\x09\x02\x87\xdd\x73\x00\x51\x7c\x27\x3e\xb5\x88\xf2\x12\x2b\xbb\xd8\xe8\xda\xe5\xad\x73\x2b\x36\x38\x45\xaa\xd6\xc5\x22\x46\x14\x52\x92\xb7\x4f\xa1\xb8\x3b\x45\xe4\x25\x7f\x0a\xb9\x7b\x0a\x25\x33\xf1\xb8\x4f\x2b\xae\x7a\xf3\xbd\x5c\x5d\x9f\xc7\x3d\x8e\x96\x9c\xac\x2e\x99\x5b\xbf\x15\x52\x45\xd0\x5a\x52\xb8\x45\xe6\xef\x3b\xe7\x2b\x03\x02\xd4\x8c\xcb\x8a\xc0\x93\x93\x04\xec\x0b\xac\x1e\x80\x05\x6e\x9f\x67\xb9\x77\x20\xf0\xca\x33\x3a\xe6\x1b\xaf\xc7\x5c\x6c\xf3\xe8\x01\xfc\xf9\x97\x37\xe9\x34\x70\x83\x61\x2c\x10\xbb\x23\x60\x65\x15\xdc\x62\xae\x70\x89\x91\x2a\x18\x3e\xd6\x22\x83\x93\xd0\x91\x77\x60\x70\x6e\x8d\xec\x15\x1d\x71\xb6\x78\xb1\xe3\x98\x66\xa4\x0a\x7f\xdd\x7d\x39\x47\x03\x3b\x69\x3f\x81\x53\x2e\xdd\x14\x71\x29\x6b\x24\xec\x51\x19\x30\x70\x3d\x18\xff\x1f\xa5\x76\xa9\xc2\x49\xb3\x99\x08\x10\x55\x66\x3f\xe0\xe4\x4f\x8c\xfe\x2f\x37\x3f\xc0\xe6\xe6\x0a\x35\x04\xb0\xe0\x28\xf0\x94\x96\x89\xca\xc2\x7e\xa2\xc2\x4b\x05\x71\x97\x78\x7b\x4b\xde\x03\xea\x0e\x3c\x3d\xbb\x98\x55\xa1\xa6\xab\x12\x20\xbd\x93\xb1\x28\xa0\x3c\x09\x0e\x29\x87\xa8\x55\x0e\xd3\xf4\x7c\x43\x63\xac\x1b\x57\x7e\x2b\xb2\x04\x25\xed\x36\xdc\x0d\x8a\x24\x87\xef\x1d\x06\x6c\x8f\xf7\x00\xc2\xbf\x2a\x41\xb0\xb6\xef\x0b\xdb\x35\x88\x6f\x5e\x13\x79\xcb\x52\xc3\xbf\x7d\xc5\x0b\x57\xef\x61\xc7\xac\x31\x43\xb7\x77\xa7\x02\x06\x54\x8f\x35\xec\x7d\x00\x89\xcc\x49\x35\xbc\xdb\xf8\x14\x55\x56\xd0\x83\x0b\x5f\xb0\x82\x1f\x40\x51\x55\xc9\xbd\x05\x65\xda\xd3\xfb\xdd\x97\x4b\x3a\x71\xe2\x90\x97\xd5\x71\x12\xae\xfc\x47\x69\xc2\x2f\xb8\x0d\x8c\xc5\xc5\x17\xe7\x9f\xc0\x5b\x02\x79\x18\xc3\x95\x84\x74\x41\xc3\xad\xd7\x26\x7b\xd2\x18\xf1\xd5\x7d\x1a\xc7\xa3\x53\x8f\x83\x7f\x78\xd6\xee\xd6\x65\xa4\x19\xa3\x58\xfc\xdd\x00\x03\xab\x1f\xd4\xa6\xf6\xa7\x07\xde\x8e\x46\x18\x2d\x0c\x3f\x3a\x96\x6e\x49\x06\xf0\x46\xa1\xeb\x50\xf9\xbc\x90\xc5\x21\xf3\x00\xfe\x8e\xe9\x12\xbf\xd6\xe1\x0b\x73\x1b\xc6\x84\xed\x2b\xec\xfe\x0e\xdf\xdd\xdf\x78\xc1\x1d\xf5\x1a\x6c\xfe\x75\xe3\xc9\x02\xef\x5e\xde\x6e\x82\x9f\xad\x0a\xff\x3b\x66\xf4\x74\xe8\xd6\xd2\xc7\x97\x1b\x3f\xf0\x55\xf6\x6a\xc5\x14\x3e\xb6\x58\x17\xe0\xff\x15\x9b\xb5\x44\xfa\x19\x56\xfb\x8b\xfb\x96\x2e\x3f\x00\x6e\x48\x92\xc6\x4b\xba\xf3\x19\x2a\x8b\xe7\x60\xe3\xb7\xb0\x98\xc1\xbc\x2b\x1b\x80\xbb\xcc\x0f\x68\x04\x1e\x74\x57\x35\x04\xab\xc4\xc5\xb6\x60\xc1\x3c\x58\x67\xd9\xcd\xb7\x9f\xda\x64\xac\x69\xb3\xbf\xed\xdc\x03\xa7\xfb\xc5\xfd\xa8\xcd\xb4\xa5\x37\x33\x96\xe4\x52\xda\x75\x0d\x6c\xe0\x8d\x89\x9d\x69\x5d\x67\x22\x67\x3f\xb2\x69\xb9\x82\x41\x3f\xa0\x5d\x7d\xec\x54\x9c\x80\xce\x25\x87\xe2\x00\xfd\x14\x67\x62\xc4\x30\x70\x79\x57\x22\x00\x5f\xc1\x0a\xee\x1f\x40\x58\x57\x85\xf0\xbd\xf1\xb9\xe2\x07\x10\x1e\xf5\x9b\xe1\x7b\x60\xd8\xc3\x83\xd9\xdb\x18\x63\xa9\xe3\x9c\xff\x3e\x10\x07\x1e\x88\xeb\xe8\x88\x66\x60\xbf\x07\x60\x52\x11\x7c\x91\xb4\x5b\x57\x7c\xc0\x38\xde\x1a\x3f\x87\xdb\x98\x7c\x1f\x51\x0f\xad\xd7\x60\x1e\x8f\x71\x83\x7f\xff\xdb\x38\x1d\x1c\x8c\xd3\xda\x57\x70\xc4\x6a\xed\xc4\x39\x8b\xd7\x35\x93\x06\xff\x3a\x37\x85\x7f\x00\xd1\xc4\x39\x8a\xd6\xf4\xfb\x48\xb1\x65\xcd\xc7\xbf\x85\xf2\x1f\x7f\x9c\xa4\x39\xf3\xfa\x7f\x9d\xcd\xb2\x07\xbc\x0f\xf8\x86\x52\x17\x73\x8e\x11\xe1\xbf\x56\xd0\xcb\xb6\x07\x5f\x0e\x8e\x79\x3d\x00\xe3\x3a\xd9\xef\x6c\x30\x97\x3a\x22\x57\x4c\xcb\x63\xd3\xd6\xf4\xc7\x30\x63\xf0\x04\xfe\x8e\xe1\x13\x06\xb7\x46\x97\x63\xc5\x0a\xef\x6d\x3d\x1b\x89\x76\x00\xef\xdd\xe3\xda\xed\xee\x0a\x81\xa7\xa3\xef\x3b\xf6\x5b\xf7\x16\x01\xd3\x19\x7a\x4a\x5a\x6d\xca\xc1\x6d\x45\x00\x0d\x64\x31\x15\x9f\x97\x40\xf0\xf6\x0e\x98\x9e\x1b\x7d\x39\xaf\x21\xf3\x74\xbc\x47\x47\x16\x1f\x38\x66\xe9\x91\x18\x77\x9b\x1e\x61\x70\x34\xf2\x04\x0a\x1c\x05\x36\x98\x7a\x02\xbf\x78\x12\xbe\xdc\x7c\xd0\xb1\x39\xd0\x58\x5f\x06\x81\x2f\xc1\xf9\x16\x7a\xc3\x04\x3c\x58\x4f\xa5\xbd\xe8\x13\xeb\x76\xc0\xe7\x8c\x3b\x3c\x09\x08\x5d\xeb\x09\x2f\x5a\xdf\xfd\xb7\x4d\x6d\x2e\x19\xa9\x48\xae\x60\x85\xd4\x48\x04\x4f\x46\xf8\xd8\xc2\x24\x99\x31\x2c\xec\xeb\xbb\x5b\x4b\x38\x07\x32\xa6\xed\xfd\xf9\xd7\x97\x9b\xcf\x0d\xa5\x30\xc4\x0b\x03\x9e\xc0\x7f\xe3\xa7\xbf\x7f\xfb\xea\x44\x7c\xdf\xff\xdb\x4d\x0d\x98\x5c\x18\xa3\xea\x17\x26\x68\xa8\x8e\x43\x06\x66\xee\x51\x33\x16\xa7\xf8\x83\x76\xd6\x18\x44\x57\x05\x7f\x36\xfe\x88\xa7\xf2\x00\xc2\x38\x3f\xec\xcf\xb4\x1c\x58\xc2\x93\xfc\xfe\xe5\x26\x78\x20\x87\xaf\x77\xf2\x4b\xe8\x52\x07\xbe\x09\x4a\x66\xc1\x05\x50\x53\xad\x1a\xb9\x30\x75\xa2\x91\x8b\xbf\x7f\xfb\x8a\x6f\x72\xc2\x87\xfd\xfc\x1a\xb1\x49\xff\x72\x6b\x16\x30\xae\x8e\x61\x20\xba\x0b\xc2\x6b\x2b\xd0\x00\x0d\x9e\xea\xd8\x5a\x34\x40\xfc\x8a\xf0\xa8\xd2\xbe\x5b\x2a\x18\xc8\x56\xa8\x46\x2e\x4e\xf4\xe9\xd5\x6a\x50\xae\xc7\xc8\x2e\x34\xf3\x53\xa1\xac\x3d\x8c\x91\x27\x90\x0a\xc0\x71\x92\x62\x18\xaf\x19\x9a\x09\xc2\xcc\xaa\xb2\xe8\x58\x14\xd0\x64\x4b\x2f\x27\x90\x5e\x8f\x7c\x4a\xea\xfd\xc6\xf3\xea\xd8\x0a\xc9\x30\xea\x25\x63\xc1\xf9\x8e\xb5\x9c\x01\x36\xcd\x05\x67\x9a\xf6\x82\x9f\xfe\xfe\xed\x2b\xfe\x75\xde\x58\x2c\xf0\xab\xac\xc5\x84\xbd\x6c\x2e\x26\xcc\x45\x7b\xc1\x20\x97\x6d\x05\x43\x7c\x60\x2c\x3f\xc8\x56\x2c\x91\x5c\xc6\x72\x8a\xe3\xfb\x6d\xc5\xa4\xf2\x09\x63\x39\x63\x38\x8e\x59\x58\x33\x17\x8f\x57\x3d\x75\xfe\xfe\x3a\xc5\x35\x1f\x34\xe7\x01\x8f\x4f\x20\x71\xfd\xec\xd5\xf3\x6a\xe1\x33\x2d\xcf\x7a\xf9\xfb\xb7\xaf\xd6\xd3\x05\x1f\x6e\x41\x04\xdb\x15\xb6\x28\x07\xe0\xfe\x26\xd0\x9c\xc2\x96\xc0\x27\x06\x63\x5b\xd3\xf1\x6b\x03\x27\x20\xb6\x35\x81\xc8\x19\x8d\xfc\x07\x48\xdd\x5d\xf4\xf6\x46\x55\xd8\x3d\x9b\x07\xc5\xa9\x22\x2f\xda\x8d\x69\x35\x01\x1d\x9f\x69\x42\x16\xea\x13\x2b\xf2\xdb\x90\xcf\x66\x4e\x07\x79\x7f\x4a\x70\x0b\x36\x3c\x8a\x55\x48\x8d\x1c\x40\xed\x38\x3b\xb6\x1c\xc0\x3d\xf0\x43\x18\x7c\xdf\xfd\x75\xe3\xa7\x71\x1c\xf6\xc9\xba\x64\xc4\x5c\x9c\xc5\x11\xcf\xc0\xc1\x30\xcd\xdf\x24\xb8\xd3\x86\x3c\xbd\xba\xbd\xf5\x45\xaf\x01\xf8\xed\x36\xfc\xab\x79\xf9\x5c\xf8\x2e\xc6\xf1\x0c\xbc\xf5\x48\x85\xb3\x03\x56\xae\xc2\x77\x31\xbc\x67\xc1\x0b\x6b\xaf\xbb\xe0\xd1\x0b\x78\x32\x47\x8f\xee\x11\x4d\x10\xec\x89\xe1\x19\x9a\x78\x70\xf0\xfc\x19\xf7\xcc\x24\xac\x8a\x74\xe5\x27\xfe\xba\x09\xae\x01\x4c\xc1\x5e\xd7\x02\x4f\x47\x41\xec\xb5\xaf\xb0\x3d\x88\x3c\x82\x4b\x50\xdb\xca\xea\x0a\x3c\x39\xd5\xd0\x36\x53\x6e\x9d\xd2\xe1\x3b\xcc\x91\x41\xfe\x38\xc6\xb4\x30\x90\x7b\x59\xd7\x1e\x4e\x1b\x92\xa8\xa8\xf2\x06\x32\x4d\x2b\xdf\x18\xe6\x7a\x85\x7a\xbf\x0f\xd2\x81\x1f\x11\xe2\x48\x05\x8f\x63\x19\x59\x0b\x5f\x2c\x6f\xe9\xc8\x5f\xde\xfc\x86\x2a\xf8\x0a\x78\x89\xc3\xc7\xb0\x1f\x40\x58\x93\x4f\xa6\xb1\x00\x20\x51\x96\x35\xee\x1a\x46\x15\x6e\x8f\x78\x3a\x80\x94\x73\x31\x53\x00\x0e\xa3\x6b\xa5\x61\x51\x13\x48\x94\x2c\x91\xc8\x3b\x04\xb6\xff\x20\x45\xe5\xa5\x45\xd3\x98\x5d\x3e\x80\x64\x2a\x7e\x7f\x06\xa4\x2c\x4b\x48\x23\x25\xfc\x35\xf9\x58\x22\xef\x03\x3a\x91\x4d\x24\x77\x63\x28\xc8\x34\xaf\xed\x1f\x40\x22\x9d\xf5\xe7\x23\x59\xd8\xe0\x4f\xf0\x87\xfd\x3c\x9e\xf8\x2f\x7c\x75\x26\xd2\x20\xfe\xac\x7e\x2c\x95\x39\xc1\xa3\x91\x14\x2f\xf0\x07\xe3\x76\xa6\x20\xf9\x1c\x0d\xe1\x4f\x37\xf8\x4b\x03\x80\xe7\x22\x46\x59\xf4\x00\xf0\xea\xea\x29\x84\xae\x30\xa4\x86\xa3\x1d\xc6\xf7\x58\x30\xd4\x65\xd9\x7d\xaf\x86\x87\x0e\xa8\x39\x73\xf4\x1d\xc4\xb1\x65\x3e\xe1\x5f\x93\x79\x32\x97\xce\x84\x2f\x93\x03\xe6\xb0\xf3\x22\xa2\x78\x3c\x47\xb1\xec\xc7\x88\x70\x1f\x7e\x19\x53\x22\x47\x26\xa9\xfc\xc7\x98\x5c\xfd\xd1\x45\x7c\x2c\x4b\x27\xe2\xb9\x13\x7c\x9e\x77\xb7\xb3\x71\x66\xa4\x56\x03\x36\xdd\x46\x4c\x96\x6e\xc3\x1e\x4b\x70\x9c\xcf\x3d\x1e\x7c\xaa\xa4\x88\x4e\x1c\xb2\xe5\xb9\xa0\x8a\x8f\x7e\xe0\xce\xed\xc9\x06\x8d\x1d\x8d\x02\x10\xc0\x4a\xb3\xee\x58\x35\x4e\x3d\xbb\x1d\x2c\x70\x9c\x5f\x8c\xd4\x34\xf5\x36\x7c\x5c\xb2\x97\xe4\x6d\xf8\x1e\x9c\xe0\xbc\x8b\xd1\x08\xdd\x86\xb7\x3c\xa3\x71\xe1\x7b\xf0\xdf\xbf\x7d\x3d\x32\xf1\xfe\xfb\x7f\xdf\x7d\xb9\x46\x5e\x1a\xfa\x24\x7e\x71\xf0\x57\x64\x09\xc7\xd6\x4e\xbb\xa0\x0f\x59\xc5\x0d\xc0\xc7\x5d\x38\x11\x8f\xff\x1e\xf6\xf0\x74\xa9\xb3\x3a\xed\xd8\xce\x48\x60\xf3\x0e\x6f\x0d\xa2\x5f\x6e\x4e\x3b\x7b\xc7\xaa\x18\x88\x6f\x71\xda\xff\xa8\xce\xd7\xdf\xa1\xba\x28\x5e\x8c\x7a\xb4\x65\xcd\xb8\xe6\xf6\x6c\xe0\x23\xf4\xc8\x25\x9e\x3b\xb2\xac\xa0\x18\xa8\xc8\x52\x58\x03\x78\x57\x34\xd8\x72\x50\x85\x40\xe3\x48\x0d\xf0\x08\x6f\x36\x49\x3c\x87\x2e\x12\xf2\x6c\xc0\x3d\x13\x62\x09\xfa\x18\xd5\xa7\xa3\x2c\x78\x08\x3a\xd0\xb0\x93\xbf\xbf\x18\x79\xb9\x18\x53\xf1\x7c\x66\xc9\x53\x3d\xce\xb8\xec\xef\x18\xcd\xe9\xd2\xca\x13\xb0\x4b\xba\x6b\xe2\x7c\xf4\x49\xe4\x77\xbc\x74\xfb\xf5\x7c\x0c\x2e\x60\x2f\x98\xb1\x61\xcb\xcb\x08\x9e\x16\x18\xc9\xc6\xc6\xc0\x70\x31\x8c\xa3\xd6\xae\x84\x52\xd8\x0b\xef\xb0\x6e\xee\x3a\x8b\x22\x9d\xa6\x21\x42\xe1\x2f\x37\x27\x13\x30\x1f\xea\xb2\x1f\x75\xe5\x03\xd4\xf6\x81\x66\x0f\xea\x9b\x73\xd0\x0c\x29\x2d\xa0\xea\x02\x76\xea\x06\x80\x80\x2d\x6e\x24\x7e\xf1\xd2\xc7\xde\x0f\xdf\x55\x67\x84\xb3\xc2\x03\xbc\x7f\xed\xc1\xd8\x55\x47\xba\xa2\xe1\x11\x10\x26\x12\xf1\x78\xf8\x2f\x37\x57\x58\x52\xd2\x1d\x9c\xf6\x0b\x66\xa0\x35\x27\x8f\x76\x88\xdc\x8d\xda\x0e\x69\x1f\xb7\xaf\xdd\x9d\x13\xfa\x48\x6a\x0b\xc9\x95\x04\x11\x3a\x9d\x74\xd8\x42\x18\xbf\x63\xb4\x8c\xbf\x51\x74\x5a\xe8\x03\xb5\x9a\x85\x8f\x17\xbb\x7d\xb9\xf1\x03\xbf\x5f\xd5\x66\x99\x33\xed\xd5\xff\x49\xa6\x4f\xb7\x55\x4c\xe8\x01\x74\xa8\x25\xa4\xb5\x1b\x5f\xd5\x7f\xd4\x2c\xec\xfb\xe8\x5d\xf9\xa6\x1d\x98\x4b\x38\x65\x19\xdb\xea\x71\x75\x87\xf8\xff\x6e\xff\x8b\x89\xdc\xfd\x17\x22\x62\x70\x07\xe9\x63\xb3\xb5\x97\x7c\xfe\x8c\xff\x75\xe7\x37\x0c\x17\xaa\x67\x90\x2e\x14\xfc\xb5\xf5\x81\x19\x7b\x9a\x93\x07\x57\xaa\x50\xf8\x44\x03\x3a\x83\x2c\xf9\x11\x32\xbc\x91\xef\x2a\x4c\x89\x42\xe1\xd3\x2e\xe3\x62\x31\xfb\x46\x70\x6f\xc1\xa0\xf6\xee\xfd\x20\xd5\x2d\xdc\x40\xc9\xd7\xd6\x7f\x33\x13\x63\xe6\xd6\x60\xb3\x8b\xff\x0a\xc2\x9a\x4a\x4a\x88\x95\x55\x31\xfc\x00\xc2\x88\x26\x05\x78\x9b\xbc\x0b\xbb\x3a\x44\x0f\x19\x5d\xfa\x91\x84\x12\xe7\x09\x05\x7c\x40\x2b\x88\x16\x36\x5c\x67\x43\x29\x78\x3a\xa5\x2d\xc8\x08\x22\xed\x36\x1c\xf3\x7d\xc7\xe2\xb8\x0d\xd5\x3b\xb0\xf9\x88\xf9\xa8\x79\x89\x65\xf8\x01\xdc\x5a\x90\x18\xf1\x14\x44\x8f\x6c\x58\x57\xe6\xdd\xde\xc5\x04\xc8\x6a\x77\x80\x70\x65\x19\x03\xbe\xdb\x3b\x6b\x0c\x09\x22\x20\xfc\xbb\xe1\x14\xdd\xc8\x66\xc1\xc8\x34\x59\xf1\xe2\x32\xaf\x83\xf1\x22\x3b\xab\xcf\x80\x6f\x7f\x05\xe9\xd3\xe2\x02\xaf\x85\x49\x5a\x05\xb2\xa4\x2e\x68\xde\xb1\x1c\xd6\xb8\x88\xef\xb6\xb7\xbd\x98\xa1\xf5\xd0\xaf\x47\xd5\x1a\xc8\x43\x9e\x42\x9e\x02\xe6\xaa\x5f\x38\x66\x24\x46\xcd\x55\xe1\x3b\xe3\xfe\x76\x97\x77\xd1\x55\xe1\x63\x0c\xae\xea\x14\x78\x69\x15\xbe\xb3\xc6\xb4\x78\x37\x7c\xf8\xfe\x18\x2a\x74\x01\xe2\x3b\x59\x3e\x46\xec\x33\x16\x07\x31\x52\xe9\x4b\x78\x2d\x28\x52\xd0\x3c\x50\x97\x65\x31\xde\x6e\xc3\x78\x44\x1a\x3e\x5f\x77\xd6\x27\x04\x7e\x42\xc5\x31\x2e\xcc\xde\x5a\xc3\x55\xad\x1a\x4b\x5d\x76\x47\xc7\x0b\xf0\x36\x7c\xcd\x25\x00\x97\xcf\xff\x7b\x9b\x1c\x8e\xff\x8c\x75\xe8\x8b\x15\xe2\xa8\x8f\xbb\x13\x73\x0f\x6b\x90\xb5\x3e\xec\x5a\x28\x47\x1e\x40\x97\xf2\xf0\x5f\x15\xe2\xcf\xfe\x3e\xe0\xbd\x3f\x31\xf3\xd9\x9b\x8f\x9d\x39\x4f\xf7\x8d\x9c\x9a\x84\x4c\x40\x5f\xa2\xab\xc0\xfb\x5d\xec\x37\x23\x14\x78\x1b\xf6\x68\x0f\xc4\x4e\x65\xf5\x8a\x8a\x35\x8a\xbf\x56\x70\x46\xa7\x66\x96\xa5\x4b\xe3\x05\xdf\xb6\xaf\xc1\xa3\x1e\x8d\xb7\xef\xd0\x9f\x51\xde\xad\x3d\x23\x01\x8f\x52\xff\xfc\xeb\x1a\x0d\x1a\xe0\xd7\xe9\xd0\x04\xfd\xb4\x16\x8d\xe2\xa7\xda\xc3\x1f\x55\x08\xd4\x1d\xce\xb0\x34\x47\x2a\xfc\x53\xc8\xf8\x3c\x83\xa5\x35\x52\xe1\xbf\x43\x67\xa4\xc2\xbb\x35\x46\x2a\xfc\x35\x9a\xc2\xdf\x7d\xb8\x4a\x4f\x18\xf0\xd3\x5a\x22\x15\xfe\x54\x47\xc7\xbd\xfc\xc1\xaa\x72\xe5\x5b\x1a\x3b\xa6\xb8\xef\x53\x76\xf4\x77\x4c\xfa\x0e\x35\x1e\x91\xb8\xb5\x79\x4c\xbd\x46\xa9\x47\xe8\xeb\x74\xeb\x82\xff\xb4\x8a\x8f\x38\x4e\x35\x6d\xdd\xdd\x1b\xac\x66\x3b\xd3\xd2\xb1\xf5\x6a\xdf\xc7\x7b\x6c\xd3\xd6\xfb\x77\xa8\xd6\xc2\xe0\xd6\xab\x95\x74\x8d\x52\x2d\xd0\xeb\x34\x6a\x03\x7f\x5a\x9d\x16\x82\x53\x5d\xd2\x48\x09\xd6\x23\xce\xb0\x74\x48\x23\xc5\xba\x30\xd7\xd2\x1d\x8d\x94\xef\xd0\x1b\x8d\x14\xb7\xce\x68\xa4\x5c\xa3\x2f\x7c\x61\xeb\x55\xba\xc2\x80\x9f\xd6\x13\x8d\x94\x00\x1d\x19\x57\x62\x9d\xe9\x3d\xec\x4c\x5b\x57\xe6\xab\x7d\x79\xda\xd1\xde\xac\xf7\xef\xd1\x9b\x75\x9d\x9f\x5b\x77\x66\xd2\x55\xfa\x33\x41\xaf\xd4\xa1\x05\xfc\x79\x3d\x9a\x08\x4e\x75\x69\xdd\x41\x79\x46\x99\x4e\xae\xa5\x4d\xfb\xdd\xb9\xd3\x13\x39\xa7\xa0\xcd\xdb\x1c\xdd\x73\x0b\x7c\xe7\xc3\x99\x5b\x1e\xed\x4a\xb0\xb1\x7c\x47\x2d\xd8\x28\xdc\x26\xac\x23\xa8\x1a\x37\xd1\xda\x17\xd1\x7a\xd5\x0b\x40\x10\x53\x6e\x04\x41\xf9\xd7\x54\xaa\xcd\xcc\x75\xb5\xea\x40\x7f\xba\x5a\x6d\x0c\xe1\x0b\x23\xeb\x1f\x36\xcf\xd8\xe0\xef\x67\x19\x47\xe8\xad\x33\xe3\xe7\x67\x1a\x57\xe2\x83\xdb\xa8\x4a\x6e\x9d\xa1\xe2\x47\x58\x2d\xb8\xeb\x26\x2f\x0e\x76\xfb\xcb\x73\x1f\x32\x6d\x5f\x4f\x7b\x25\x6e\x23\x64\x60\x9c\x20\xfc\x10\xf3\x11\xf4\x03\xfc\xe7\x66\x41\xd7\x07\xde\xcc\x01\xe3\xf9\x48\xb9\xe7\x3b\x65\x9f\x0e\xbd\x59\x03\x68\xcf\x2e\xc3\x8f\x79\xc3\xc3\xb4\xf3\x9c\xb9\x3e\x36\xf6\x69\xbe\x8c\x41\xaa\x37\x22\xf8\x31\x5b\xae\xa1\xcd\x79\xee\x4e\xbf\xee\xf2\x69\x26\x8f\xf4\xbe\x9d\x57\x7b\xdc\x70\x9e\x51\xdf\x17\x32\x3e\xcd\xa5\x45\xe9\x9b\x2b\x19\xf7\xd8\xe7\xb9\x73\x7d\x20\xe0\xd3\x9c\x19\xe3\x15\xaf\xe2\xae\x0e\xfb\x5a\xf7\xbe\xdf\x3a\xd7\xc2\x83\xaf\xfe\xa8\xad\x95\x63\x2e\x55\x70\xfc\x82\x0b\x7f\x57\xe0\xd6\x83\x4e\x84\x0c\xaf\x8b\x9f\x59\xfe\x38\x87\x51\x90\xb7\x1f\xa0\x3b\x8d\xdf\xde\x9c\x03\x75\xbe\xbd\x18\xfe\x94\xef\xb1\x07\x19\x17\xaa\xdf\x7b\xb3\xf2\xe7\x4d\xc0\x1e\x7a\x7d\xa3\x71\x3a\xfd\xe5\x79\x16\xed\x21\xc3\x77\xf3\x68\xd3\xb2\x98\xbc\xbf\xb9\x3c\xf8\x30\x57\x1d\xaf\x17\xc5\xee\x34\xcd\xf8\xca\x79\x79\xac\x03\x19\x9e\x4b\x59\x3f\x2d\x93\x45\xd4\xa7\xf7\x0b\xad\x2f\xf8\x62\x53\x17\x80\xb9\x54\x62\x5d\x44\xca\x4b\xb4\x0a\x49\x04\x91\xfd\xcd\x8f\x73\x96\x6d\x5d\xdf\x72\x79\x09\xd2\x42\xca\xc0\x6f\x42\xfa\x41\xeb\xb3\x90\xe2\xf3\x45\xe0\xe9\x09\x84\x9a\x32\x6d\x6c\x3a\x08\x5d\xc6\x7a\x55\x23\xfc\xe6\x56\xe7\xba\x57\xe8\xc3\x13\x68\x3f\x65\xb9\xcd\xe2\xce\x64\x8e\x96\x25\xa4\xd9\x47\xef\xf1\x2e\xbb\xaf\xb1\x77\x6b\x97\xae\x99\x65\xed\xbe\xfb\x3b\x06\x77\x1a\x94\x98\xdb\xc0\x3b\x15\xf0\xd9\x17\x5a\x57\x55\x28\x69\x7d\x59\xc7\x76\xbc\xe5\x25\x46\xde\xc6\x04\x4b\xd3\xc6\x7e\x78\x27\xc0\x6f\x62\x56\x31\xa4\x6a\xed\xa2\x1b\xeb\xd0\x28\xa9\x3a\xf3\x07\x23\xdb\x73\x62\x0c\xdf\x5b\x82\x5b\x5d\x98\x08\xdf\x03\x52\xe0\x49\x84\x9f\x71\x7b\x41\xd6\x6d\x4d\xe1\x7b\xe0\x68\xfa\xe1\xa3\xe3\x7f\x77\xf7\x8e\xbe\xec\xed\x02\xce\xd1\x7e\xfc\x61\xc0\xf7\xfb\x00\xca\x06\x20\x41\xed\xa3\xae\x2d\x42\x97\x88\x5a\x87\x7a\x8f\x3b\x7e\x03\x49\x9f\x6e\x08\x76\xf1\x72\x9a\xf9\x21\x73\xf8\xd0\x33\xba\x86\xaf\xe3\xe1\xf8\xef\xd3\x86\x75\x70\xf4\x1a\x92\xae\x63\xcb\xdf\x43\xd4\x76\xa1\x1f\xd0\x3b\x1e\x7d\xfc\x0e\x5a\xc6\x66\xa1\x8b\xb4\x8e\x47\x8a\x2e\x92\xb9\xff\xf1\xb5\x8d\xa7\xa1\x97\xab\x1a\x2f\x9b\xa3\x9f\xc4\xdb\xbd\x7d\x1d\x8a\xc1\xbf\xf1\x7c\x86\xdd\xff\xb8\xc8\xa3\x67\x73\xd2\x9d\xe5\xa3\x00\xf8\xcb\xe3\xab\x36\xa4\x0a\x48\x45\x01\x4f\x27\xb1\x06\x7c\x5c\x28\xfc\x2b\xa9\x28\x47\x47\x69\xc4\x1d\x30\x57\x57\xba\x4e\xc3\xdd\xa8\x0f\x96\x57\xb2\xe8\x7e\x39\xb9\x7e\xc6\x75\x79\x8e\x31\xef\x03\x2c\xc9\x40\x7c\x69\x15\x8e\xa7\xc0\xdd\x53\x28\x9a\xb0\x6f\xcb\x61\x78\x52\x90\x17\xd6\x25\x38\x1c\xcf\x30\x50\x7a\x0a\xe1\x6d\x9c\xe6\x0d\x3b\xc7\xa0\x8b\xf5\xbd\xed\x93\x4b\x87\x0c\x02\x51\x13\x8d\x39\xe7\x8c\xee\x6c\xb8\x20\x48\xbc\xbe\x0a\x25\xfb\x16\x9c\x60\x18\xb3\x2b\x74\x81\x78\xaf\x0d\x3d\xc6\x1b\x42\xcf\xde\x1b\x21\x8f\x17\xde\x99\x57\xef\x98\xd3\x67\xe7\x52\x34\x63\xb5\x3a\x64\xa8\x3d\xca\xf0\x48\xe4\x1d\x74\x96\x02\x8c\xa3\x00\x4f\xa1\xb2\x01\xf7\x7c\x73\x7a\x71\xd6\xa9\x9a\x9e\xff\x30\x36\xcf\x7e\x39\xbd\x94\xca\x7b\xdb\x9d\xef\xe2\xa0\x60\xc1\x71\xa0\xc0\x2b\xb6\xfd\xe5\xf4\xd3\x2b\xc7\xac\x82\xae\xb0\x98\xc0\x3b\xdf\x41\xb1\x32\x7d\xeb\xac\x21\x60\x7c\x1e\xc5\x75\xd3\xa9\xe7\x6b\x38\x1f\xb2\x77\xf2\x61\xf7\x0f\xf4\x6d\xdf\x15\xe8\x8c\xfe\x83\x75\xff\x6c\xe8\xfb\x03\x75\xb9\x5e\x9c\x47\xeb\xe1\xc7\x9a\xbc\x3b\x18\x66\x89\xfa\xff\xec\xfd\x7f\xcc\xde\x5d\x20\xc7\xa8\xd3\xc9\x75\x5c\x01\x80\xd6\x7a\xe4\x47\x60\xc7\x10\xc9\x35\xd0\x56\xa8\xe2\x1a\x50\x6b\xd5\xe4\x43\xb0\xe3\x62\xc1\x47\xa0\xf6\x34\x2f\x08\x96\x4b\x3d\xf7\xad\xe8\x23\xb0\xe6\x61\xbe\x8f\xff\xf8\xef\xad\x3c\x9d\xda\x85\x9e\x3d\x57\xd3\x7d\xda\x03\x7c\xe8\xa2\xfc\x57\x86\x9e\x84\x7b\xf1\xf7\xaa\x11\xaf\x01\xdc\xb1\x7a\x3c\xd2\xe7\xb0\x07\x06\x7f\xad\x6f\x62\xf7\xc9\xad\xad\xb0\x1f\x47\xc9\x17\x08\x76\x91\xb2\x2b\xe9\xc7\xd0\x3a\x09\x0c\x5b\x94\x86\x4e\xba\x9f\xce\x3f\xc0\x3b\x3f\x12\xb8\x57\x7b\xbe\xb9\x79\x24\x38\x4d\x14\x9e\x6f\xfe\xff\x01\x00\x55\xd8\x45\x68\x5a\xbd\x00\x00")
 
 func staticReport_template_localHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -398,7 +463,47 @@ func staticReport_template_localHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/report_template_local.html", size: 29943, mode: os.FileMode(436), modTime: time.Unix(1627482574, 0)}
+	info := bindataFileInfo{name: "static/report_template_local.html", size: 48474, mode: os.FileMode(436), modTime: time.Unix(1792397207, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticSecretsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x95\x51\x77\xa2\x38\x14\xc7\xdf\xfb\x29\x72\x78\x29\x54\x10\x45\xed\xb6\x9c\x75\x99\xd4\xba\x8e\x63\x57\x39\xe2\x4e\x77\x86\x50\x37\xda\x2c\x70\xb4\xc0\x81\xd8\x8e\x12\xbe\xfb\x9e\x20\x3d\x5a\xc1\xfa\x80\xe4\x5e\xf2\xbb\xff\x9b\xdc\xe4\xda\x17\x00\xa4\x42\x80\x5f\x88\xa0\x03\x01\x3e\x5a\x00\x2e\x97\x24\x49\xc0\x88\x6c\xc1\xf0\x5e\x90\x81\x10\x61\x4a\x49\x1c\x70\x3f\x42\x0b\x51\x34\x74\x38\x1a\x42\x06\x2d\xfe\x18\x98\x90\xc1\xe1\x3d\x64\x70\x3a\x81\x0c\x8e\xf9\x70\xfc\x9d\xdb\x4c\x28\xd9\x0d\xe5\x16\x2a\x3f\x9d\xb4\x79\x9d\x49\x08\x2d\x38\x8d\x04\x34\x0e\xa3\xad\xa0\x83\x56\xbd\x91\xc9\xa5\xf0\x16\x59\xc6\x84\x1e\xa9\x38\x91\x20\x1a\xbe\x84\xdf\x92\x7a\xda\x90\xb5\x46\x66\x88\x86\x9e\xe4\x33\x58\x14\xfb\xaf\x98\x12\xc9\x78\x77\xad\xc8\x16\xa1\xc7\xb4\x21\x77\x32\x5b\xef\x3a\x08\x25\x57\x36\x12\x2e\xff\x75\x0c\x91\x0b\xc3\xca\x0e\x2a\x3f\xd5\x9a\x93\xb6\x1b\x15\xea\xda\x25\x75\x83\x30\x74\xd7\x04\x40\x73\x58\x21\x8b\xaf\x0c\x1c\xee\x70\x91\x32\x56\x76\x73\x84\x14\x27\x6d\x75\x32\x49\xa8\x06\x4d\xe0\x86\x7a\xa0\xb7\xf6\x49\x40\x8b\xb4\x2b\xa0\x83\x49\xcf\x32\xff\x51\x4e\xb9\xda\x4d\x99\x6b\xad\xf1\x72\x05\x66\xe1\x8a\x04\x15\xa0\x5f\xe1\x2f\x7b\x81\xa3\x38\x71\x8e\x60\xb9\xc6\x66\x43\xfe\x4d\x3b\x87\x7b\x24\x0b\x2f\x0c\x57\x27\x40\xd1\xa3\x34\x4a\x74\x55\xe5\xbe\x04\xa1\x7a\xc2\x63\x23\x54\x5f\x86\x2f\x6a\x42\xe2\x57\x7f\x49\x12\x75\x56\x04\x72\xd2\x1b\xb9\xa9\x65\xea\xdd\xc9\xb8\x18\x62\x65\xe7\xa4\x5a\xbb\xac\x60\xe0\xd3\xaf\x9b\xc5\xd9\x8c\x44\x43\x77\xbd\x88\xb9\x5e\xc8\x5c\x6f\xc3\x5c\x2f\x61\xae\x17\x4b\xf3\x63\x6c\xeb\x3a\x63\xae\x4f\xbd\xcd\x62\x1e\x61\x7a\xe4\x9a\x3b\xe9\x8d\xb6\xdf\xf7\x72\xd4\x07\x7c\x3e\xaa\xbb\x8e\x30\x2d\xef\x47\xa3\x92\x65\xd1\xd8\x8f\x48\xb1\xbb\x67\xca\x86\x97\xf0\x8a\xc5\x2b\x69\xbe\xf6\x5f\xc9\xfc\x50\x9b\x7c\x51\xe4\xdb\xdb\x6a\x30\x09\x9e\x07\xb1\xff\xfc\x49\x39\x5a\x03\x84\xea\x25\x9d\x5a\x56\x61\x6d\xb7\x2a\x83\xfc\x85\xfd\xb5\xbb\x09\x3e\x89\xb1\x22\x5b\xe5\x58\x70\x4b\xab\x38\x4a\xad\x7a\xe7\x04\x3c\x7b\xf3\xd7\x7e\xf8\x99\xf6\xd1\x9e\xfa\x1f\x54\xfe\x3c\x4b\xd5\x4e\xa8\x41\xf4\x72\x76\xd7\x82\xe8\x65\x6e\xef\x17\xa2\xa1\xdc\xe6\x75\x51\x95\x31\xdc\x6d\x62\x02\x2c\x1a\xc6\xd8\x25\xfc\x12\x0a\x37\x01\xad\xd0\x58\x78\x46\x64\xdb\x15\x0f\xd8\x9a\xea\xa4\x37\xd7\x59\xb7\x5b\xaa\x64\x73\x7f\x39\x55\x90\x14\xfe\xbb\xeb\x0f\x86\x63\x20\x1a\xfa\xd4\x82\x80\xdd\xf3\x47\xbf\x07\xd8\xc4\xec\x8f\x2d\xeb\x2b\x60\xe6\xc0\x04\xac\x3f\xee\x4d\x7f\x98\xb3\xfe\x3d\x90\x0c\x73\x3a\xfc\x0e\x67\x7d\x30\xea\xff\x10\x0d\x1d\xdc\x3d\x4c\x7a\x23\xc9\xe0\x28\x45\x34\x74\x84\x10\xb2\xe3\xc0\x61\x36\x42\x31\x42\x81\x23\xd5\x44\x43\x2f\x74\xf2\x0d\xaf\xe9\xc0\x7e\xda\xfb\xf8\xb7\xce\x55\xf5\x24\xe9\xea\x43\x72\x5d\xbe\x13\x72\xf9\x98\x7e\xb3\x26\x63\xf0\x48\xce\x1f\x19\xb2\xfd\x76\xe0\xcc\xdf\xef\x1c\x5e\x88\xe7\x3d\x55\xe6\xf2\xb2\xe2\x24\x79\x0b\xe3\x67\xe0\x07\xe0\xef\xe9\x43\x39\xb4\x5d\x14\x66\xf1\xcf\xd3\xa8\xef\xa3\xc8\xcd\x4e\xa6\xab\xaa\xfd\xa4\x22\x94\xe8\x5f\x90\x70\xf9\xfb\x1f\x4e\xda\x94\xaf\xdb\x99\x2e\x9e\x58\x5b\xdc\x2a\x7d\x39\x40\x72\x46\xed\x63\x3d\x6a\xa5\x2a\x1f\x90\x80\xc4\xfe\xb2\xfa\x6e\xe7\x7d\x4c\x34\x74\x1c\xf9\x76\x9e\xa0\xb1\x22\x5b\x76\x18\x15\x4d\x0d\xe7\x6d\xb0\xb0\x51\x5e\xdb\x0c\x6f\xa8\xf7\xc1\xb0\xcc\x3b\xc8\xc7\x79\xfb\xe9\x85\x8d\x93\xa3\x62\xa5\xf6\x2f\xcf\x12\xef\x84\x8e\x91\xf7\xc4\xa3\xde\xe8\x88\xf6\x13\x12\x2e\x11\x4a\x9c\xb4\xa9\xc9\x4d\xde\x65\xf2\x2f\x4f\x8f\x5e\x27\xbb\x70\x2e\xfe\x1f\x00\xae\xf0\x93\xe3\x3c\x08\x00\x00")
+
+func staticSecretsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticSecretsJson,
+		"static/secrets.json",
+	)
+}
+
+func staticSecretsJson() (*asset, error) {
+	bytes, err := staticSecretsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/secrets.json", size: 2108, mode: os.FileMode(436), modTime: time.Unix(1792395836, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticWafsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x7d\x53\xdb\x38\x1a\xff\xbf\x9f\x42\x9b\xed\xec\x91\x80\x52\x28\xb0\xb3\xcd\x72\x70\x21\x21\x90\xd9\x42\x3b\x31\x3d\x66\x8f\xa4\x1e\x59\x7a\x1c\xeb\x62\x4b\xa9\x24\x27\x84\xba\xdf\xfd\x46\xb2\x13\x88\x1d\xae\xe1\x9f\x44\x6f\xcf\xef\x79\x7f\xf1\xfd\x1b\x84\xbe\xd7\x04\x49\xa0\xd6\x42\xb5\x4e\x2c\x53\x16\xc6\x44\x41\x6d\x0f\xd5\x28\x31\x30\x96\x6a\xe1\x6e\xba\x37\xf6\x28\x02\xc2\x40\xd9\x83\x9d\x33\x9e\xd4\xbf\xee\x9c\xb5\x68\x88\x15\x59\x64\x34\xc4\x94\xd0\x08\xb0\x36\xc4\xa4\xba\xde\x42\xcd\x46\xf6\x55\x83\x9a\x81\x6a\x21\xba\x02\x6e\x36\x2c\x0e\x95\x72\xc2\x1d\x4b\x0b\xe1\xfb\x34\x64\x29\x67\x99\x5d\xc4\x81\xfb\x53\x29\x67\xf5\xb7\xb5\x1f\x7b\x5b\x0a\x78\xd7\xee\x6d\x06\xf6\x83\x24\xa3\xa1\x4f\x63\x20\x8a\x08\x0a\x6e\x13\xc5\x7e\xb3\x51\x7f\x6b\x29\x02\xc9\x16\x85\x42\xf5\xb6\x31\x20\x0c\x97\x02\x0d\xe0\x5b\xca\x15\xb0\x5f\xd0\x70\x98\xa1\x27\xb6\xd9\x09\xe3\xb3\xfb\xaf\xa7\xa3\x5d\xce\xfe\x39\xac\xd1\x10\x83\x52\x52\x61\x06\x86\xf0\x58\x0f\x6b\xd6\x10\x81\x92\x73\x0d\x0a\xcf\x40\xf1\x90\x53\x62\x11\x33\x1a\x91\x38\x06\x31\x06\x3d\x1c\x36\x9f\xec\x61\x37\x32\xb1\x82\xe4\x86\xab\xb5\xd0\xfd\xd1\xfe\xe1\x1e\x3a\x7a\xff\x61\x0f\x1d\xef\x1f\x8e\x4a\x26\x68\x4f\x48\x42\xf8\xf6\xfe\x79\xc0\xc4\x51\xe0\x7b\x82\x1f\xf1\x68\x37\x2b\xb6\x63\x25\x96\xcb\xe7\x8e\xcb\x56\xef\x8d\x22\x42\x87\x52\x25\xc0\x4a\xde\xdc\x39\x6b\xe5\x52\x5c\x5e\x49\x6d\xb2\x7c\x7d\x03\xc6\x33\x52\x91\x31\xd4\x9b\x8d\xda\x56\x42\x6f\xf6\x19\x09\xe8\x24\x23\x13\x3f\x48\x34\xcd\x82\xc4\xd7\x8f\xee\x77\x66\x7f\x13\x5e\x71\x9a\xae\x9f\x18\x6e\x62\x38\x6d\x53\x0a\x5a\xa3\x2e\x08\x0e\xec\xe4\x5d\x7e\xd8\x6c\xfc\x2d\x53\xc4\xa4\xf8\x87\x41\x11\x99\x01\x9a\x82\x4a\xb8\xd6\xd6\xc7\x46\x22\xe2\x68\x9a\x8d\x01\x84\xa0\x40\x50\xf8\xed\xd7\xc3\xf7\x7f\xfe\xf6\xeb\xe1\xf1\x9f\xf7\xfb\xf8\x03\xc1\x61\x73\xb4\x5b\x71\x4e\xd9\x25\x3d\xa2\x4d\xbc\x78\x8d\x4b\x42\x47\x81\x15\x7c\x4b\x41\x1b\xcc\x59\x56\x9c\x30\x08\xd2\x31\x66\x7c\x0c\xda\x2c\xcf\x14\x68\x43\x94\x59\xe6\xd4\x03\x76\x59\xc5\x70\xb0\x68\xa1\xdc\x75\xd6\xb5\xfb\xf8\x03\x1e\xed\xe2\xfb\x36\xfe\xcf\xe8\xfb\xe1\x8f\x66\xa3\x64\xa7\x7a\x2e\x25\x72\xf1\xda\x42\xa9\x98\x08\x39\x17\x88\xc9\x84\x70\xb1\x31\xcf\x7a\x4a\x0a\xf3\x1a\xad\x48\xf2\x88\x69\x68\xb5\x59\xad\xa7\x72\x5a\x88\x3d\xe3\xc4\x2e\x86\xc3\x9d\x27\xf0\xe1\xb0\x9e\x6b\xe4\xd4\xb0\xd7\x28\x54\x32\x29\xca\x85\x7d\xb1\x21\x94\xee\x3c\xb4\x0c\x9c\x6a\x2c\x95\xe5\x72\x82\x08\x3c\x27\xe1\x32\xfe\x2d\x97\x52\xd4\x91\xb9\x76\x2f\x8c\x9c\x80\xd8\x10\x5f\xd1\xc1\xe9\xd1\xfe\x21\xba\x18\x0c\x3e\x0d\x4e\xde\x45\x07\xa7\x36\x60\x9c\xe7\x50\x10\x4b\x3a\x01\xd6\x6c\x5c\x82\x00\x45\x0c\x30\x14\x2c\x9e\xc9\xff\xd3\xd8\xe9\x27\x53\x50\x33\xb2\xb5\x3a\x9c\x8b\x50\x2e\x03\x81\x32\xd1\x42\x3b\x67\x2d\x9e\x83\x64\x5c\x50\x32\xd5\x69\x4c\xea\x9b\xca\xec\x8c\x6b\xce\x7c\xf7\xc6\xb7\xd1\x3d\xda\xcd\x09\x7c\x0d\xda\x1d\xf8\xa3\xdd\x4c\xc4\x01\x5f\x6d\x14\x80\x86\x3f\x8e\xaa\x85\xb2\xbf\x64\x84\xb8\xa0\x9c\x81\x30\xa8\xdf\xcd\xfc\xd5\xb1\x3f\x00\x2d\x53\x45\x21\x5b\x1a\x2a\x15\x3a\x75\xc9\x16\xa6\xf1\x70\xd8\x44\xab\xa7\x65\xff\xf6\x8e\xd1\x79\xff\x12\xf7\x3f\xa3\xb6\x77\xbd\xb5\x59\xe6\x04\x2f\x0d\x53\x52\xfc\xd6\x2b\x32\x79\xf4\xfd\xf7\xbd\x3f\x7e\xec\x9c\xb5\xec\xde\xa6\x48\xfd\xac\xa2\xd7\x6d\x04\xa8\x48\x4a\x60\xe8\xcb\xe0\x23\x9a\x13\x8d\x14\xfc\x17\xa8\x01\x66\xe5\xfe\x1c\x03\xd1\x80\xa8\x14\x3a\x8d\x0d\x9a\x73\x13\xa1\x85\x4c\x15\x22\x2c\xe1\x82\x6b\xa3\x88\x91\xea\x45\xa5\xca\x0a\x7d\x94\x84\xa1\x73\x12\xdb\xce\xa4\x36\xa9\xb6\xac\xb9\xd6\x26\x67\xfd\xcf\x9b\xfc\x7a\xde\xbf\xe4\x53\xcf\xbd\x6b\x36\xb2\xde\xb1\xef\xdd\xda\xdf\xab\x5b\x5f\x47\x8a\x8b\x09\xb0\xec\x23\xd1\xe6\x7a\x70\xe5\x7b\xe0\x4a\x5f\x76\x3d\xb8\x2a\x96\xd5\x1e\xeb\xa5\x34\x55\x7c\x4b\xcb\xbb\xbc\xd7\x8e\x22\xcf\xfb\x62\xed\x12\xfa\x69\xeb\xd2\xa4\xd4\x45\x72\x3e\xd5\x3a\x95\x9f\xa3\x3b\x08\x3c\x6e\x00\xf5\xb8\x82\x39\x89\x63\x84\xd1\x5a\x85\xcf\x5c\x92\x4d\x95\x7c\x58\xfc\x2b\x67\x3a\x1c\x36\x05\x98\xb2\x3a\xed\xc7\x54\x01\x72\xb5\x0c\x75\xa5\x54\xaf\x2a\x68\x96\x16\x2b\x08\xb3\x07\x1c\x32\x1c\x01\x89\x4d\x34\x55\x32\x00\xa7\xcb\x66\x56\xed\xe9\x34\x2e\x9a\x3e\xba\x24\x06\xe6\x64\xb1\xa5\x31\x97\x96\xb9\xe6\x54\x49\x2d\x43\x83\x1d\x22\x7e\x86\x88\x0b\xc4\xaa\xd9\x4e\x28\x08\x03\xea\x74\x0b\xe2\x77\xb3\xf7\x27\xef\x8a\xe7\x3f\x2d\x50\x97\x52\x8e\x63\xc8\x47\xa0\x2d\x6d\xe7\x4a\xfd\xc1\x70\xd8\x3c\x40\x63\x47\xfd\xd6\x16\x2b\xbb\x5c\x2b\xc2\x25\x46\xff\x06\x45\x21\xde\x92\x85\x73\xcf\xcc\x51\xe4\x71\x57\xac\x5d\xdc\x95\x02\x2d\x07\xae\x32\xbc\x01\x13\xf3\x70\xdb\xbe\xfd\x80\x45\xf8\xac\x61\xaf\xb3\x28\xa0\xaa\x3c\xfe\x82\x45\x81\xb7\x05\x8b\x25\xda\x04\x16\x94\x09\x0c\x62\xcc\x05\x54\x31\xcf\x53\x21\x5e\x81\x6a\xa1\xa6\x69\x1c\x3f\x4a\x01\xeb\x52\x2f\x81\xaa\x2c\x3c\x43\xe8\xe4\x33\x31\xd1\x96\x3c\x1e\x70\x34\x5f\xc7\x5e\x21\x54\xc1\x2f\xd8\x98\xcb\x2d\x81\x9d\x9f\x81\x62\x9a\x6a\x23\x93\x7c\xd8\xce\x1e\x30\xb0\x31\x4e\x54\xc9\xcf\x17\x9d\x36\xa5\xeb\x07\xde\x86\x38\xbb\x96\xcc\x03\x5b\x2f\xcc\x6b\xf3\xb2\xd9\x48\x24\xf3\x75\x41\x5c\x4d\xc1\xdb\x88\xeb\x7c\xbe\x72\x6d\x63\xfc\x7c\x24\xb8\x96\xcc\x5f\xb2\xcd\x9e\x6f\x2a\x09\xb8\x87\x8e\xf6\x7f\xb7\xa3\xff\x41\x39\x15\xcf\x89\x52\x84\xa6\xec\xa5\x69\x61\xbd\x2d\x04\xf6\xb5\x4f\x65\x6a\xf3\xdc\xf6\x78\x57\xf9\xcf\x6f\xfa\xbe\x7f\xde\x1e\x0c\xda\x9d\x2f\xdd\xb6\xff\xf1\xdc\xef\x7c\xfa\xf4\x57\xff\xc2\x5d\x4c\x41\x69\xae\x8d\x1d\x84\xab\x3d\xdf\x0e\xd2\x6e\x84\x0e\x00\xc4\xd3\xe0\xb3\x26\xd4\xff\xad\x24\x3d\xa9\x0c\xbf\x83\x60\x2b\xe9\x7b\x9f\x06\xb7\xfd\xbb\x76\xcf\xeb\x77\xb3\x5c\xaf\x42\x83\x83\xaa\x64\x79\xef\x43\x5f\x04\x99\x11\x1e\x93\x20\x86\x5f\xec\xa0\xd9\x0c\xc7\xcc\xe7\x54\x8a\xec\x39\xe7\x92\xad\x8f\xf7\xf7\xcb\x72\x76\xb8\x51\xfc\x01\xb5\xbb\x9d\x57\x77\x6b\x57\xf9\x6e\x3c\xdc\x69\x77\xae\x2e\x6c\x28\x52\x01\x52\x50\xfb\x01\x68\xc3\x27\xfb\x2a\x04\x95\xb0\xda\x57\xd5\xbe\xf1\x3a\x7e\x73\x37\xa3\x4e\x04\x5f\x68\x7f\xe3\x57\xf0\x8b\x02\x56\x4d\x29\xb4\x4f\xc2\x8a\xc5\x6e\x3c\x74\x6b\x3f\xef\x88\x13\xc5\xce\x6e\xed\xe9\xb4\x77\x87\x8a\xa1\x00\xf5\xbb\x3f\xf5\xe6\x9d\x54\x2c\xb4\x91\xf2\x82\x0c\xcf\xd9\xad\x0d\xc7\x2b\xc2\xec\x6f\x37\x37\xe5\x4d\xdd\x48\x64\x6c\xfa\x68\xdb\xf3\x23\xa2\xf3\x28\x8b\x79\xc2\x0b\x32\x13\x41\x7e\x29\xe7\x02\xd4\x46\x4f\x56\x64\xec\x76\xa5\x87\x2f\x53\xa2\xb6\xed\x5c\xcb\x4c\x67\x4c\x6a\x3c\xb6\x84\x15\x27\xf9\x3e\x63\xe3\x62\x40\x7e\x5b\xfb\xf1\x66\xf4\xe6\x7f\x03\x00\xc2\xa9\xe9\x9a\x44\x11\x00\x00")
+
+func staticWafsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticWafsJson,
+		"static/wafs.json",
+	)
+}
+
+func staticWafsJson() (*asset, error) {
+	bytes, err := staticWafsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/wafs.json", size: 4420, mode: os.FileMode(436), modTime: time.Unix(1792397042, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -456,6 +561,8 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"static/build_filelist.sh": staticBuild_filelistSh,
+	"static/exposures.json": staticExposuresJson,
+	"static/favicons.json": staticFaviconsJson,
 	"static/filelist.txt": staticFilelistTxt,
 	"static/get_files.sh": staticGet_filesSh,
 	"static/js_local_files/bootstrap.min.css": staticJs_local_filesBootstrapMinCss,
@@ -469,8 +576,11 @@ var _bindata = map[string]func() (*asset, error){
 	"static/js_local_files/vue-router.min.js": staticJs_local_filesVueRouterMinJs,
 	"static/js_local_files/vue.js": staticJs_local_filesVueJs,
 	"static/js_local_files/vue.min.js": staticJs_local_filesVueMinJs,
+	"static/page_classes.json": staticPage_classesJson,
 	"static/report_template.html": staticReport_templateHtml,
 	"static/report_template_local.html": staticReport_template_localHtml,
+	"static/secrets.json": staticSecretsJson,
+	"static/wafs.json": staticWafsJson,
 }
 
 // AssetDir returns the file names below a certain
@@ -515,6 +625,8 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"static": &bintree{nil, map[string]*bintree{
 		"build_filelist.sh": &bintree{staticBuild_filelistSh, map[string]*bintree{}},
+		"exposures.json": &bintree{staticExposuresJson, map[string]*bintree{}},
+		"favicons.json": &bintree{staticFaviconsJson, map[string]*bintree{}},
 		"filelist.txt": &bintree{staticFilelistTxt, map[string]*bintree{}},
 		"get_files.sh": &bintree{staticGet_filesSh, map[string]*bintree{}},
 		"js_local_files": &bintree{nil, map[string]*bintree{
@@ -530,8 +642,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"vue.js": &bintree{staticJs_local_filesVueJs, map[string]*bintree{}},
 			"vue.min.js": &bintree{staticJs_local_filesVueMinJs, map[string]*bintree{}},
 		}},
+		"page_classes.json": &bintree{staticPage_classesJson, map[string]*bintree{}},
 		"report_template.html": &bintree{staticReport_templateHtml, map[string]*bintree{}},
 		"report_template_local.html": &bintree{staticReport_template_localHtml, map[string]*bintree{}},
+		"secrets.json": &bintree{staticSecretsJson, map[string]*bintree{}},
+		"wafs.json": &bintree{staticWafsJson, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"bytes"
+
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/encoding"
+)
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// DetectCharset returns the name of the character set of a HTML body, as
+// declared by a byte order mark, the Content-Type header or a meta tag.
+// Bodies without a declaration are sniffed as UTF-8 or fall back to
+// windows-1252 like browsers do.
+func DetectCharset(body []byte, contentType string) string {
+	_, name, _ := charset.DetermineEncoding(body, contentType)
+	return name
+}
+
+// DecodeCharset converts body from the named character set to UTF-8. The
+// body is returned unchanged if the character set is unknown or the body
+// can't be decoded.
+func DecodeCharset(body []byte, name string) []byte {
+	e, _ := charset.Lookup(name)
+	if e == nil {
+		return body
+	}
+	if e != encoding.Nop {
+		decoded, err := e.NewDecoder().Bytes(body)
+		if err != nil {
+			return body
+		}
+		body = decoded
+	}
+	return bytes.TrimPrefix(body, utf8BOM)
+}
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
+)
+
+// PageClassRule labels pages as a class, like login form or directory
+// listing. All conditions given in a rule must match. Several rules can have
+// the same name to match a class in different ways.
+type PageClassRule struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Status   []int  `json:"status"`
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	Header   string `json:"header"`
+	Selector string `json:"selector"`
+	title    *regexp.Regexp
+	body     *regexp.Regexp
+	header   *regexp.Regexp
+}
+
+// LoadPageClassRules loads page classification rules in JSON format and
+// compiles their regular expressions.
+func LoadPageClassRules(data []byte) ([]*PageClassRule, error) {
+	var rules []*PageClassRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("Page class rule without name")
+		}
+		if rule.Title == "" && rule.Body == "" && rule.Header == "" && rule.Selector == "" {
+			return nil, fmt.Errorf("Page class rule %s has no title, body, header or selector condition", rule.Name)
+		}
+		if rule.Type == "" {
+			rule.Type = "secondary"
+		}
+		var err error
+		for _, p := range []struct {
+			pattern string
+			regex   **regexp.Regexp
+		}{
+			{rule.Title, &rule.title},
+			{rule.Body, &rule.body},
+			{rule.Header, &rule.header},
+		} {
+			if p.pattern == "" {
+				continue
+			}
+			if *p.regex, err = regexp.Compile(p.pattern); err != nil {
+				return nil, fmt.Errorf("Invalid pattern for %s: %s", rule.Name, err)
+			}
+		}
+		if rule.Selector != "" {
+			if _, err := cascadia.Compile(rule.Selector); err != nil {
+				return nil, fmt.Errorf("Invalid selector for %s: %s", rule.Name, err)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// ClassifyPage returns the first matching rule of every page class that
+// matches a response.
+func ClassifyPage(rules []*PageClassRule, status int, headers []Header, body []byte) []*PageClassRule {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+	title := strings.TrimSpace(doc.Find("title").First().Text())
+
+	var lines []string
+	for _, h := range headers {
+		lines = append(lines, fmt.Sprintf("%s: %s", h.Name, h.Value))
+	}
+	sort.Strings(lines)
+	header := strings.Join(lines, "\n")
+
+	var matched []*PageClassRule
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if seen[rule.Name] || !rule.match(status, title, header, body, doc) {
+			continue
+		}
+		seen[rule.Name] = true
+		matched = append(matched, rule)
+	}
+	return matched
+}
+
+func (r *PageClassRule) match(status int, title string, header string, body []byte, doc *goquery.Document) bool {
+	if len(r.Status) > 0 {
+		statusMatched := false
+		for _, code := range r.Status {
+			if code == status {
+				statusMatched = true
+				break
+			}
+		}
+		if !statusMatched {
+			return false
+		}
+	}
+	if r.title != nil && !r.title.MatchString(title) {
+		return false
+	}
+	if r.header != nil && !r.header.MatchString(header) {
+		return false
+	}
+	if r.body != nil && !r.body.Match(body) {
+		return false
+	}
+	if r.Selector != "" && doc.Find(r.Selector).Length() == 0 {
+		return false
+	}
+	return true
+}
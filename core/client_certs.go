@@ -0,0 +1,106 @@
+package core
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+type hostCertificate struct {
+	Pattern     string
+	Certificate *tls.Certificate
+}
+
+type ClientCertificates struct {
+	Default *tls.Certificate
+	Hosts   []hostCertificate
+}
+
+// ForHost returns the client certificate to present to host, preferring the
+// first matching host pattern over the default certificate.
+func (c *ClientCertificates) ForHost(host string) *tls.Certificate {
+	if c == nil {
+		return nil
+	}
+	host = strings.ToLower(host)
+	for _, h := range c.Hosts {
+		if matched, _ := path.Match(h.Pattern, host); matched {
+			return h.Certificate
+		}
+	}
+	return c.Default
+}
+
+func (c *ClientCertificates) Empty() bool {
+	return c == nil || (c.Default == nil && len(c.Hosts) == 0)
+}
+
+// LoadClientCertificate loads a client certificate from a PEM certificate and
+// key pair, or from a PKCS#12 bundle when no key file is given and the
+// certificate file is not PEM encoded.
+func LoadClientCertificate(certFile string, keyFile string, password string) (*tls.Certificate, error) {
+	certData, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyFile == "" && isPKCS12(certFile, certData) {
+		blocks, err := pkcs12.ToPEM(certData, password)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to decode PKCS#12 bundle %s: %s", certFile, err)
+		}
+		var pemData []byte
+		for _, b := range blocks {
+			pemData = append(pemData, pem.EncodeToMemory(b)...)
+		}
+		cert, err := tls.X509KeyPair(pemData, pemData)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load PKCS#12 bundle %s: %s", certFile, err)
+		}
+		return &cert, nil
+	}
+
+	keyData := certData
+	if keyFile != "" {
+		if keyData, err = ioutil.ReadFile(keyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	cert, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load client certificate %s: %s", certFile, err)
+	}
+	return &cert, nil
+}
+
+func isPKCS12(filename string, data []byte) bool {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".p12", ".pfx":
+		return true
+	}
+	block, _ := pem.Decode(data)
+	return block == nil
+}
+
+// ParseClientCertificateHost parses a host mapping in the format
+// <host pattern>=<cert file>[,<key file>]
+func ParseClientCertificateHost(s string) (pattern string, certFile string, keyFile string, err error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return "", "", "", fmt.Errorf("Invalid client certificate mapping: %s", s)
+	}
+	pattern = strings.ToLower(strings.TrimSpace(parts[0]))
+	files := strings.SplitN(parts[1], ",", 2)
+	certFile = strings.TrimSpace(files[0])
+	if len(files) > 1 {
+		keyFile = strings.TrimSpace(files[1])
+	}
+	return pattern, certFile, keyFile, nil
+}
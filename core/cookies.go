@@ -0,0 +1,124 @@
+package core
+
+import (
+	"encoding/csv"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sessionCookieName matches names of cookies that look like they hold a
+// session or authentication token.
+var sessionCookieName = regexp.MustCompile(`(?i)(sess|(^|[._-])sid$|token|auth|jwt|login|remember|csrf|xsrf|cfid|cftoken)`)
+
+// csrfCookieName matches names of CSRF token cookies, which are often read by
+// scripts and therefore can't be HttpOnly.
+var csrfCookieName = regexp.MustCompile(`(?i)(csrf|xsrf)`)
+
+// Cookie is a cookie set by a page and the missing protections found for it.
+// Sensitive cookies are cookies that look like they hold a session or
+// authentication token by their name.
+type Cookie struct {
+	Name      string   `json:"name"`
+	Domain    string   `json:"domain"`
+	Path      string   `json:"path"`
+	Expires   string   `json:"expires"`
+	MaxAge    int      `json:"maxAge"`
+	Secure    bool     `json:"secure"`
+	HttpOnly  bool     `json:"httpOnly"`
+	SameSite  string   `json:"sameSite"`
+	Sensitive bool     `json:"sensitive"`
+	Issues    []string `json:"issues"`
+}
+
+// ParseCookies parses the values of Set-Cookie headers of a response and
+// audits cookies that look like session cookies. The Secure attribute is only
+// expected over HTTPS.
+func ParseCookies(scheme string, values []string) []Cookie {
+	resp := http.Response{Header: http.Header{"Set-Cookie": values}}
+	var cookies []Cookie
+	for _, c := range resp.Cookies() {
+		cookie := Cookie{
+			Name:      c.Name,
+			Domain:    c.Domain,
+			Path:      c.Path,
+			MaxAge:    c.MaxAge,
+			Secure:    c.Secure,
+			HttpOnly:  c.HttpOnly,
+			Sensitive: sessionCookieName.MatchString(c.Name),
+		}
+		if !c.Expires.IsZero() {
+			cookie.Expires = c.Expires.UTC().Format(time.RFC3339)
+		}
+		switch c.SameSite {
+		case http.SameSiteLaxMode:
+			cookie.SameSite = "Lax"
+		case http.SameSiteStrictMode:
+			cookie.SameSite = "Strict"
+		case http.SameSiteNoneMode:
+			cookie.SameSite = "None"
+		}
+		cookie.audit(scheme)
+		cookies = append(cookies, cookie)
+	}
+	return cookies
+}
+
+func (c *Cookie) audit(scheme string) {
+	if c.SameSite == "None" && !c.Secure {
+		c.Issues = append(c.Issues, "SameSite=None without Secure is rejected by browsers")
+	}
+	if !c.Sensitive {
+		return
+	}
+	if scheme == "https" && !c.Secure {
+		c.Issues = append(c.Issues, "Missing Secure attribute")
+	}
+	if !c.HttpOnly && !csrfCookieName.MatchString(c.Name) {
+		c.Issues = append(c.Issues, "Missing HttpOnly attribute")
+	}
+	if c.SameSite == "" {
+		c.Issues = append(c.Issues, "Missing SameSite attribute")
+	}
+}
+
+// SaveCookiesToFile writes the cookies of all pages to a CSV file.
+func (s *Session) SaveCookiesToFile(filename string) error {
+	f, err := os.Create(s.GetFilePath(filename))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var urls []string
+	for url := range s.Pages {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	w := csv.NewWriter(f)
+	w.Write([]string{"url", "name", "domain", "path", "expires", "max_age", "secure", "http_only", "same_site", "sensitive", "issues"})
+	for _, url := range urls {
+		for _, c := range s.Pages[url].Cookies {
+			w.Write([]string{
+				url,
+				c.Name,
+				c.Domain,
+				c.Path,
+				c.Expires,
+				strconv.Itoa(c.MaxAge),
+				strconv.FormatBool(c.Secure),
+				strconv.FormatBool(c.HttpOnly),
+				c.SameSite,
+				strconv.FormatBool(c.Sensitive),
+				strings.Join(c.Issues, "; "),
+			})
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
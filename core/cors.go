@@ -0,0 +1,14 @@
+package core
+
+// CORSFinding is a crafted Origin that a page trusts in its CORS response
+// headers. Method is GET for simple requests and OPTIONS for preflight
+// requests.
+type CORSFinding struct {
+	Test             string `json:"test"`
+	Origin           string `json:"origin"`
+	Method           string `json:"method"`
+	AllowOrigin      string `json:"allowOrigin"`
+	AllowCredentials bool   `json:"allowCredentials"`
+	Severity         string `json:"severity"`
+	EvidencePath     string `json:"evidencePath"`
+}
@@ -0,0 +1,304 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// cspBypassHosts are hosts that are commonly allowed in script-src but serve
+// JSONP endpoints, AngularJS or arbitrary user content, which can be used to
+// run scripts despite the policy.
+var cspBypassHosts = []struct {
+	Host   string
+	Reason string
+}{
+	{"www.google.com", "JSONP endpoints"},
+	{"accounts.google.com", "JSONP endpoints"},
+	{"apis.google.com", "JSONP endpoints"},
+	{"www.googleapis.com", "JSONP endpoints"},
+	{"maps.googleapis.com", "JSONP endpoints"},
+	{"ajax.googleapis.com", "AngularJS and JSONP endpoints"},
+	{"www.gstatic.com", "AngularJS"},
+	{"www.googletagmanager.com", "custom scripts"},
+	{"www.google-analytics.com", "JSONP endpoints"},
+	{"cdnjs.cloudflare.com", "AngularJS"},
+	{"cdn.jsdelivr.net", "AngularJS and arbitrary npm and GitHub files"},
+	{"unpkg.com", "AngularJS and arbitrary npm files"},
+	{"code.jquery.com", "old jQuery versions"},
+	{"connect.facebook.net", "JSONP endpoints"},
+	{"graph.facebook.com", "JSONP endpoints"},
+	{"api.vk.com", "JSONP endpoints"},
+	{"mc.yandex.ru", "JSONP endpoints"},
+	{"*.s3.amazonaws.com", "arbitrary user content"},
+	{"*.cloudfront.net", "arbitrary user content"},
+	{"*.storage.googleapis.com", "arbitrary user content"},
+	{"*.blob.core.windows.net", "arbitrary user content"},
+	{"*.githubusercontent.com", "arbitrary user content"},
+	{"*.github.io", "arbitrary user content"},
+	{"*.herokuapp.com", "arbitrary user content"},
+	{"*.appspot.com", "arbitrary user content"},
+	{"*.firebaseapp.com", "arbitrary user content"},
+	{"*.azurewebsites.net", "arbitrary user content"},
+}
+
+// CSPFinding is a weakness found in a Content-Security-Policy.
+type CSPFinding struct {
+	Directive   string `json:"directive"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
+
+// ContentSecurityPolicy is a parsed Content-Security-Policy header and the
+// weaknesses found in it. Directive names are lowercase; source lists keep
+// their original case.
+type ContentSecurityPolicy struct {
+	Policy     string              `json:"policy"`
+	ReportOnly bool                `json:"reportOnly"`
+	Directives map[string][]string `json:"directives"`
+	Findings   []CSPFinding        `json:"findings"`
+}
+
+// ParseCSP parses a Content-Security-Policy header value and analyzes it for
+// weaknesses. When the value holds several comma separated policies, only the
+// first one is parsed.
+func ParseCSP(value string, reportOnly bool) *ContentSecurityPolicy {
+	policy := strings.TrimSpace(strings.SplitN(value, ",", 2)[0])
+	csp := &ContentSecurityPolicy{
+		Policy:     policy,
+		ReportOnly: reportOnly,
+		Directives: make(map[string][]string),
+	}
+	for _, directive := range strings.Split(policy, ";") {
+		fields := strings.Fields(directive)
+		if len(fields) == 0 {
+			continue
+		}
+		name := strings.ToLower(fields[0])
+		// Browsers ignore repeated directives.
+		if _, ok := csp.Directives[name]; ok {
+			continue
+		}
+		csp.Directives[name] = fields[1:]
+	}
+	csp.analyze()
+	return csp
+}
+
+// FindCSP parses the Content-Security-Policy headers in headers, or the
+// Content-Security-Policy-Report-Only headers when the page has no enforced
+// policy. It returns nil if the page has neither.
+//
+// Browsers enforce every policy a page sends, so a weakness only matters if
+// all policies have it. The strictest policy is returned.
+func FindCSP(headers []Header) *ContentSecurityPolicy {
+	if policies := cspPolicies(headers, "Content-Security-Policy"); len(policies) > 0 {
+		return strictestCSP(policies, false)
+	}
+	if policies := cspPolicies(headers, "Content-Security-Policy-Report-Only"); len(policies) > 0 {
+		return strictestCSP(policies, true)
+	}
+	return nil
+}
+
+// cspPolicies returns the policies in all headers with the given name. A
+// single header can hold several comma separated policies.
+func cspPolicies(headers []Header, name string) []string {
+	var policies []string
+	for _, h := range headers {
+		if !strings.EqualFold(h.Name, name) {
+			continue
+		}
+		for _, policy := range strings.Split(h.Value, ",") {
+			if policy = strings.TrimSpace(policy); policy != "" {
+				policies = append(policies, policy)
+			}
+		}
+	}
+	return policies
+}
+
+// strictestCSP parses policies and returns the one with the fewest high
+// severity findings, then the fewest findings overall.
+func strictestCSP(policies []string, reportOnly bool) *ContentSecurityPolicy {
+	var strictest *ContentSecurityPolicy
+	for _, policy := range policies {
+		csp := ParseCSP(policy, reportOnly)
+		if strictest == nil || csp.highFindings() < strictest.highFindings() ||
+			(csp.highFindings() == strictest.highFindings() && len(csp.Findings) < len(strictest.Findings)) {
+			strictest = csp
+		}
+	}
+	return strictest
+}
+
+// Weak returns true if the policy has findings that make it ineffective
+// against cross-site scripting.
+func (c *ContentSecurityPolicy) Weak() bool {
+	return c.highFindings() > 0
+}
+
+func (c *ContentSecurityPolicy) highFindings() int {
+	count := 0
+	for _, finding := range c.Findings {
+		if finding.Severity == SeverityHigh {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *ContentSecurityPolicy) Summary() string {
+	if len(c.Findings) == 0 {
+		return "No Content-Security-Policy weaknesses found"
+	}
+	return fmt.Sprintf("Content-Security-Policy has %s", pluralize(len(c.Findings), "weakness", "weaknesses"))
+}
+
+// effective returns the source list that applies to a directive, falling back
+// to default-src for fetch directives.
+func (c *ContentSecurityPolicy) effective(directive string) (string, []string, bool) {
+	if sources, ok := c.Directives[directive]; ok {
+		return directive, sources, true
+	}
+	if sources, ok := c.Directives["default-src"]; ok {
+		return "default-src", sources, true
+	}
+	return directive, nil, false
+}
+
+func (c *ContentSecurityPolicy) addFinding(directive string, severity string, format string, args ...interface{}) {
+	c.Findings = append(c.Findings, CSPFinding{
+		Directive:   directive,
+		Severity:    severity,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *ContentSecurityPolicy) analyze() {
+	if c.ReportOnly {
+		c.addFinding("", SeverityMedium, "Policy is report-only and not enforced")
+	}
+
+	directive, sources, found := c.effective("script-src")
+	if !found {
+		c.addFinding("script-src", SeverityHigh, "Neither script-src nor default-src is set, scripts can be loaded from anywhere")
+	} else {
+		c.analyzeScriptSources(directive, sources)
+	}
+
+	directive, sources, found = c.effective("object-src")
+	if !found {
+		c.addFinding("object-src", SeverityMedium, "Neither object-src nor default-src is set, plugins can be loaded from anywhere")
+	} else if !isNone(sources) {
+		if wildcard := wildcardSource(sources); wildcard != "" {
+			c.addFinding(directive, SeverityHigh, "Wildcard source %s allows plugins from any host", wildcard)
+		} else if directive == "default-src" {
+			c.addFinding("object-src", SeverityLow, "object-src is not set to 'none'")
+		}
+	}
+
+	if _, ok := c.Directives["base-uri"]; !ok {
+		c.addFinding("base-uri", SeverityMedium, "base-uri is not set, injected <base> tags can redirect relative script URLs")
+	}
+
+	if wildcard := wildcardSource(c.Directives["frame-ancestors"]); wildcard != "" {
+		c.addFinding("frame-ancestors", SeverityMedium, "Wildcard source %s allows framing by any site", wildcard)
+	}
+}
+
+func (c *ContentSecurityPolicy) analyzeScriptSources(directive string, sources []string) {
+	if isNone(sources) {
+		return
+	}
+	nonceOrHash, strictDynamic := false, false
+	for _, source := range sources {
+		lower := strings.ToLower(source)
+		if strings.HasPrefix(lower, "'nonce-") || strings.HasPrefix(lower, "'sha256-") || strings.HasPrefix(lower, "'sha384-") || strings.HasPrefix(lower, "'sha512-") {
+			nonceOrHash = true
+		}
+		if lower == "'strict-dynamic'" {
+			strictDynamic = true
+		}
+	}
+
+	for _, source := range sources {
+		switch strings.ToLower(source) {
+		case "'unsafe-inline'":
+			// 'unsafe-inline' is ignored by browsers when a nonce or hash is present.
+			if !nonceOrHash {
+				c.addFinding(directive, SeverityHigh, "'unsafe-inline' allows inline scripts and event handlers")
+			}
+		case "'unsafe-eval'":
+			c.addFinding(directive, SeverityMedium, "'unsafe-eval' allows eval() and similar functions")
+		}
+	}
+
+	// Host sources are ignored by browsers when 'strict-dynamic' is present.
+	if strictDynamic && nonceOrHash {
+		return
+	}
+	if wildcard := wildcardSource(sources); wildcard != "" {
+		c.addFinding(directive, SeverityHigh, "Wildcard source %s allows scripts from any host", wildcard)
+	}
+	for _, source := range sources {
+		host := sourceHost(source)
+		if host == "" {
+			continue
+		}
+		for _, bypass := range cspBypassHosts {
+			if hostMatchesSource(host, bypass.Host) {
+				c.addFinding(directive, SeverityHigh, "%s hosts %s that can bypass the policy", source, bypass.Reason)
+				break
+			}
+		}
+	}
+}
+
+func isNone(sources []string) bool {
+	return len(sources) == 1 && strings.ToLower(sources[0]) == "'none'"
+}
+
+// wildcardSource returns the first source that matches any host.
+func wildcardSource(sources []string) string {
+	for _, source := range sources {
+		switch strings.ToLower(source) {
+		case "*", "http:", "https:", "data:", "blob:", "filesystem:", "http://*", "https://*":
+			return source
+		}
+	}
+	return ""
+}
+
+// sourceHost returns the host part of a host source expression, like
+// *.example.com for https://*.example.com:443/path.
+func sourceHost(source string) string {
+	if strings.HasPrefix(source, "'") || strings.HasSuffix(source, ":") {
+		return ""
+	}
+	host := strings.ToLower(source)
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexAny(host, "/:"); i >= 0 {
+		host = host[:i]
+	}
+	if host == "*" {
+		return ""
+	}
+	return host
+}
+
+// hostMatchesSource returns true if the host source allows scripts from the
+// bypass host, or the other way around for wildcard bypass hosts.
+func hostMatchesSource(host string, bypass string) bool {
+	if host == bypass {
+		return true
+	}
+	if strings.HasPrefix(host, "*.") && strings.HasSuffix(strings.TrimPrefix(bypass, "*"), host[1:]) {
+		return true
+	}
+	if strings.HasPrefix(bypass, "*.") && strings.HasSuffix(host, bypass[1:]) {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	SeverityHigh   = "high"
+	SeverityMedium = "medium"
+	SeverityLow    = "low"
+	SeverityInfo   = "info"
+)
+
+// ExposureSignature describes a sensitive file or admin interface and how to
+// confirm that a response really is one.
+type ExposureSignature struct {
+	Name     string   `json:"name"`
+	Severity string   `json:"severity"`
+	Paths    []string `json:"paths"`
+	Status   []int    `json:"status"`
+	Body     string   `json:"body"`
+	Header   string   `json:"header"`
+	body     *regexp.Regexp
+	header   *regexp.Regexp
+}
+
+// Exposure is a confirmed hit of an exposure signature.
+type Exposure struct {
+	Name         string `json:"name"`
+	Severity     string `json:"severity"`
+	URL          string `json:"url"`
+	Status       int    `json:"status"`
+	Evidence     string `json:"evidence"`
+	EvidencePath string `json:"evidencePath"`
+}
+
+// LoadExposureSignatures loads exposure signatures in JSON format and
+// compiles their regular expressions.
+func LoadExposureSignatures(data []byte) ([]*ExposureSignature, error) {
+	var signatures []*ExposureSignature
+	if err := json.Unmarshal(data, &signatures); err != nil {
+		return nil, err
+	}
+	for _, sig := range signatures {
+		if sig.Body == "" && sig.Header == "" {
+			return nil, fmt.Errorf("Exposure signature %s has no body or header pattern", sig.Name)
+		}
+		if len(sig.Status) == 0 {
+			sig.Status = []int{http.StatusOK}
+		}
+		var err error
+		if sig.Body != "" {
+			if sig.body, err = regexp.Compile(sig.Body); err != nil {
+				return nil, fmt.Errorf("Invalid body pattern for %s: %s", sig.Name, err)
+			}
+		}
+		if sig.Header != "" {
+			if sig.header, err = regexp.Compile(sig.Header); err != nil {
+				return nil, fmt.Errorf("Invalid header pattern for %s: %s", sig.Name, err)
+			}
+		}
+	}
+	return signatures, nil
+}
+
+// Match confirms a response against the signature and returns an excerpt of
+// the matching content as evidence.
+func (s *ExposureSignature) Match(status int, header http.Header, body []byte) (string, bool) {
+	statusMatched := false
+	for _, code := range s.Status {
+		if code == status {
+			statusMatched = true
+			break
+		}
+	}
+	if !statusMatched {
+		return "", false
+	}
+
+	var evidence []string
+	if s.header != nil {
+		var lines []string
+		for name, values := range header {
+			for _, value := range values {
+				lines = append(lines, fmt.Sprintf("%s: %s", name, value))
+			}
+		}
+		sort.Strings(lines)
+		text := strings.Join(lines, "\n")
+		loc := s.header.FindStringIndex(text)
+		if loc == nil {
+			return "", false
+		}
+		evidence = append(evidence, excerpt([]byte(text), loc[0], loc[1]))
+	}
+	if s.body != nil {
+		loc := s.body.FindIndex(body)
+		if loc == nil {
+			return "", false
+		}
+		evidence = append(evidence, excerpt(body, loc[0], loc[1]))
+	}
+	return strings.Join(evidence, "\n"), true
+}
+
+// excerpt returns the matched part of data with some context around it,
+// with non-printable bytes escaped.
+func excerpt(data []byte, start int, end int) string {
+	const context = 80
+	const maxLength = 400
+	from := start - context
+	if from < 0 {
+		from = 0
+	}
+	to := end + context
+	if to > len(data) {
+		to = len(data)
+	}
+	if to-from > maxLength {
+		to = from + maxLength
+	}
+	return quote(data[from:to])
+}
+
+// SeverityTagType returns the report tag type for a severity.
+func SeverityTagType(severity string) string {
+	switch severity {
+	case SeverityHigh:
+		return "danger"
+	case SeverityMedium:
+		return "warning"
+	case SeverityLow:
+		return "info"
+	}
+	return "secondary"
+}
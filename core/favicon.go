@@ -0,0 +1,78 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/spaolacci/murmur3"
+)
+
+type Favicon struct {
+	URL  string `json:"url"`
+	Path string `json:"path"`
+	MMH3 int32  `json:"mmh3"`
+	MD5  string `json:"md5"`
+	Name string `json:"name"`
+}
+
+// FaviconHash returns the Shodan style hash of a favicon: the signed 32-bit
+// MurmurHash3 of the favicon data encoded as base64 with a line break after
+// every 76 characters.
+func FaviconHash(data []byte) int32 {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76])
+		b.WriteByte('\n')
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded)
+	b.WriteByte('\n')
+	return int32(murmur3.Sum32([]byte(b.String())))
+}
+
+type FaviconFingerprint struct {
+	Name string   `json:"name"`
+	MMH3 []int32  `json:"mmh3"`
+	MD5  []string `json:"md5"`
+}
+
+type FaviconDatabase struct {
+	mmh3 map[int32]string
+	md5  map[string]string
+}
+
+// LoadFaviconDatabase loads a list of favicon fingerprints in JSON format.
+func LoadFaviconDatabase(data []byte) (*FaviconDatabase, error) {
+	var fingerprints []FaviconFingerprint
+	if err := json.Unmarshal(data, &fingerprints); err != nil {
+		return nil, err
+	}
+
+	db := &FaviconDatabase{
+		mmh3: make(map[int32]string),
+		md5:  make(map[string]string),
+	}
+	for _, f := range fingerprints {
+		for _, h := range f.MMH3 {
+			db.mmh3[h] = f.Name
+		}
+		for _, h := range f.MD5 {
+			db.md5[strings.ToLower(h)] = f.Name
+		}
+	}
+	return db, nil
+}
+
+// Lookup returns the name of the product with a favicon matching either
+// hash, or an empty string if the favicon is unknown.
+func (d *FaviconDatabase) Lookup(mmh3 int32, md5 string) string {
+	if d == nil {
+		return ""
+	}
+	if name, ok := d.mmh3[mmh3]; ok {
+		return name
+	}
+	return d.md5[md5]
+}
@@ -0,0 +1,170 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+// expectedHeader is a security header that pages are expected to send, and
+// how much it counts towards the header audit score.
+type expectedHeader struct {
+	Name      string
+	Weight    int
+	HTTPSOnly bool
+	Check     func(value string, headers []Header) (ok bool, weakness string)
+}
+
+var expectedHeaders = []expectedHeader{
+	{"Strict-Transport-Security", 25, true, checkHSTS},
+	{"Content-Security-Policy", 25, false, checkCSP},
+	{"X-Frame-Options", 15, false, checkFrameOptions},
+	{"X-Content-Type-Options", 10, false, checkContentTypeOptions},
+	{"Referrer-Policy", 10, false, checkReferrerPolicy},
+	{"Permissions-Policy", 5, false, nil},
+	{"Cross-Origin-Opener-Policy", 4, false, nil},
+	{"Cross-Origin-Resource-Policy", 3, false, nil},
+	{"Cross-Origin-Embedder-Policy", 3, false, nil},
+}
+
+// HeaderAudit is the result of checking the response headers of a page for
+// the security headers it is expected to send. The score is the percentage of
+// the weight of the applicable headers that is present and valid.
+type HeaderAudit struct {
+	Score      int      `json:"score"`
+	Grade      string   `json:"grade"`
+	Missing    []string `json:"missing"`
+	Weaknesses []string `json:"weaknesses"`
+}
+
+// AuditHeaders checks headers for the expected security headers. HSTS is only
+// expected over HTTPS, as browsers ignore it over plain HTTP.
+func AuditHeaders(scheme string, headers []Header) *HeaderAudit {
+	audit := &HeaderAudit{}
+	total, earned := 0, 0
+	for _, expected := range expectedHeaders {
+		if expected.HTTPSOnly && scheme != "https" {
+			continue
+		}
+		total += expected.Weight
+
+		value, found := findHeader(headers, expected.Name)
+		if !found && expected.Name == "Permissions-Policy" {
+			value, found = findHeader(headers, "Feature-Policy")
+		}
+		if !found {
+			if expected.Name == "X-Frame-Options" && hasFrameAncestors(headers) {
+				earned += expected.Weight
+				continue
+			}
+			audit.Missing = append(audit.Missing, expected.Name)
+			continue
+		}
+		if expected.Check != nil {
+			if ok, weakness := expected.Check(value, headers); !ok {
+				audit.Weaknesses = append(audit.Weaknesses, expected.Name+": "+weakness)
+				continue
+			}
+		}
+		earned += expected.Weight
+	}
+
+	audit.Score = earned * 100 / total
+	audit.Grade = headerGrade(audit.Score)
+	return audit
+}
+
+func headerGrade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 75:
+		return "B"
+	case score >= 60:
+		return "C"
+	case score >= 40:
+		return "D"
+	case score >= 20:
+		return "E"
+	}
+	return "F"
+}
+
+func findHeader(headers []Header, name string) (string, bool) {
+	for _, h := range headers {
+		if strings.EqualFold(h.Name, name) {
+			return strings.TrimSpace(h.Value), true
+		}
+	}
+	return "", false
+}
+
+func hasFrameAncestors(headers []Header) bool {
+	for _, policy := range cspPolicies(headers, "Content-Security-Policy") {
+		if _, ok := ParseCSP(policy, false).Directives["frame-ancestors"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func checkHSTS(value string, headers []Header) (bool, string) {
+	for _, directive := range strings.Split(value, ";") {
+		parts := strings.SplitN(strings.TrimSpace(directive), "=", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "max-age") {
+			continue
+		}
+		maxAge, err := strconv.Atoi(strings.Trim(parts[1], `" `))
+		if err != nil {
+			return false, "invalid max-age"
+		}
+		if maxAge == 0 {
+			return false, "max-age=0 disables HSTS"
+		}
+		if maxAge < 15552000 {
+			return false, "max-age shorter than 180 days"
+		}
+		return true, ""
+	}
+	return false, "no max-age"
+}
+
+func checkCSP(value string, headers []Header) (bool, string) {
+	var weaknesses []string
+	for _, finding := range strictestCSP(cspPolicies(headers, "Content-Security-Policy"), false).Findings {
+		if finding.Severity == SeverityHigh {
+			weaknesses = append(weaknesses, finding.Description)
+		}
+	}
+	if len(weaknesses) > 0 {
+		return false, strings.Join(weaknesses, "; ")
+	}
+	return true, ""
+}
+
+func checkFrameOptions(value string, headers []Header) (bool, string) {
+	switch strings.ToUpper(value) {
+	case "DENY", "SAMEORIGIN":
+		return true, ""
+	}
+	if hasFrameAncestors(headers) {
+		return true, ""
+	}
+	return false, "invalid value " + value
+}
+
+func checkContentTypeOptions(value string, headers []Header) (bool, string) {
+	if strings.EqualFold(value, "nosniff") {
+		return true, ""
+	}
+	return false, "invalid value " + value
+}
+
+func checkReferrerPolicy(value string, headers []Header) (bool, string) {
+	// The last recognized policy in the list is used.
+	policies := strings.Split(value, ",")
+	policy := strings.ToLower(strings.TrimSpace(policies[len(policies)-1]))
+	if policy == "unsafe-url" || policy == "no-referrer-when-downgrade" {
+		return false, policy + " leaks full URLs to other origins"
+	}
+	return true, ""
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const maxEndpoints = 1000
+
+var (
+	endpointRegex         = regexp.MustCompile("[\"'`]((?:https?:)?//[a-zA-Z0-9.\\-]+(?::\\d+)?(?:/[^\"'`\\s<>\\\\]*)?|/[a-zA-Z0-9_\\-][^\"'`\\s<>\\\\]*|[a-zA-Z0-9_\\-]+/[a-zA-Z0-9_\\-/.]*\\.(?:php|asp|aspx|jsp|json|action|do|cgi|xml)(?:\\?[^\"'`\\s<>\\\\]*)?)[\"'`]")
+	sourceMappingURLRegex = regexp.MustCompile(`//[#@]\s*sourceMappingURL=(\S+)`)
+)
+
+// Script is a JavaScript file referenced by a page, or an inline script.
+type Script struct {
+	URL           string `json:"url"`
+	Inline        bool   `json:"inline"`
+	SHA256        string `json:"sha256"`
+	Path          string `json:"path"`
+	Size          int    `json:"size"`
+	SourceMap     string `json:"sourceMap"`
+	SourceMapPath string `json:"sourceMapPath"`
+}
+
+// JavaScript holds the scripts of a page and what was extracted from them.
+type JavaScript struct {
+	Scripts           []Script `json:"scripts"`
+	Endpoints         []string `json:"endpoints"`
+	ThirdPartyOrigins []string `json:"thirdPartyOrigins"`
+}
+
+// Summary returns a short description of the scripts of a page.
+func (j *JavaScript) Summary() string {
+	summary := pluralize(len(j.Scripts), "script", "scripts") + ", " + pluralize(len(j.Endpoints), "endpoint", "endpoints")
+	if len(j.ThirdPartyOrigins) > 0 {
+		summary += "; third-party script origins: " + strings.Join(j.ThirdPartyOrigins, ", ")
+	}
+	return summary
+}
+
+// ExtractScripts returns the URLs of the external scripts of an HTML body,
+// resolved against base or the <base> element, and the contents of its
+// inline scripts. Scripts with a type that is not JavaScript, like JSON data
+// or templates, are skipped.
+func ExtractScripts(body []byte, base *url.URL) (external []string, inline [][]byte, err error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	if href, ok := doc.Find("base[href]").First().Attr("href"); ok {
+		if u, err := base.Parse(strings.TrimSpace(href)); err == nil {
+			base = u
+		}
+	}
+
+	seen := make(map[string]bool)
+	doc.Find("script").Each(func(i int, s *goquery.Selection) {
+		if t, ok := s.Attr("type"); ok && !isJavaScriptType(t) {
+			return
+		}
+		if src, ok := s.Attr("src"); ok {
+			u, err := base.Parse(strings.TrimSpace(src))
+			if err != nil {
+				return
+			}
+			if link := NormalizeURL(u); link != "" && !seen[link] {
+				seen[link] = true
+				external = append(external, link)
+			}
+			return
+		}
+		if text := strings.TrimSpace(s.Text()); text != "" {
+			inline = append(inline, []byte(text))
+		}
+	})
+	return external, inline, nil
+}
+
+func isJavaScriptType(t string) bool {
+	t = strings.ToLower(strings.TrimSpace(t))
+	return t == "" || t == "module" || strings.Contains(t, "javascript") || strings.Contains(t, "ecmascript")
+}
+
+// ExtractEndpoints returns the quoted URLs and paths in a script that look
+// like API endpoints or pages, leaving out links to static files.
+func ExtractEndpoints(data []byte) []string {
+	var endpoints []string
+	seen := make(map[string]bool)
+	for _, match := range endpointRegex.FindAllSubmatch(data, -1) {
+		endpoint := string(match[1])
+		if len(endpoint) < 2 || len(endpoint) > 300 || seen[endpoint] {
+			continue
+		}
+		p := endpoint
+		if i := strings.IndexAny(p, "?#"); i >= 0 {
+			p = p[:i]
+		}
+		if staticExtensions[strings.ToLower(path.Ext(p))] {
+			continue
+		}
+		seen[endpoint] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+// MergeEndpoints adds endpoints to a sorted list of unique endpoints, keeping
+// at most 1000 of them.
+func MergeEndpoints(list []string, endpoints []string) []string {
+	seen := make(map[string]bool)
+	for _, e := range list {
+		seen[e] = true
+	}
+	for _, e := range endpoints {
+		if len(list) >= maxEndpoints {
+			break
+		}
+		if !seen[e] {
+			seen[e] = true
+			list = append(list, e)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// SourceMappingURL returns the URL of the source map in the last
+// sourceMappingURL comment of a script. Inline data: source maps are ignored.
+func SourceMappingURL(data []byte) string {
+	matches := sourceMappingURLRegex.FindAllSubmatch(data, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	u := string(matches[len(matches)-1][1])
+	if strings.HasPrefix(strings.ToLower(u), "data:") {
+		return ""
+	}
+	return u
+}
+
+// IsSourceMap reports whether data is a source map document.
+func IsSourceMap(data []byte) bool {
+	var sourceMap struct {
+		Version  int             `json:"version"`
+		Mappings *string         `json:"mappings"`
+		Sections json.RawMessage `json:"sections"`
+	}
+	if err := json.Unmarshal(data, &sourceMap); err != nil {
+		return false
+	}
+	return sourceMap.Version > 0 && (sourceMap.Mappings != nil || sourceMap.Sections != nil)
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"bytes"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// linkSelectors are the elements and attributes links are extracted from.
+var linkSelectors = []struct {
+	Selector  string
+	Attribute string
+}{
+	{"a[href]", "href"},
+	{"area[href]", "href"},
+	{"form[action]", "action"},
+	{"script[src]", "src"},
+	{"iframe[src]", "src"},
+	{"frame[src]", "src"},
+}
+
+// staticExtensions are file extensions of links that are not worth
+// requesting as pages.
+var staticExtensions = map[string]bool{
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".svg": true, ".ico": true, ".webp": true, ".bmp": true,
+	".css": true, ".woff": true, ".woff2": true, ".ttf": true, ".eot": true, ".otf": true,
+	".mp3": true, ".mp4": true, ".webm": true, ".avi": true, ".mov": true, ".wav": true,
+	".pdf": true, ".doc": true, ".docx": true, ".xls": true, ".xlsx": true, ".ppt": true, ".pptx": true,
+	".zip": true, ".gz": true, ".tar": true, ".rar": true, ".7z": true, ".exe": true, ".dmg": true, ".iso": true,
+}
+
+// ExtractLinks returns the normalized URLs of the anchors, forms, scripts and
+// frames in an HTML body, resolved against base or the <base> element.
+func ExtractLinks(body []byte, base *url.URL) ([]string, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if href, ok := doc.Find("base[href]").First().Attr("href"); ok {
+		if u, err := base.Parse(strings.TrimSpace(href)); err == nil {
+			base = u
+		}
+	}
+
+	var links []string
+	seen := make(map[string]bool)
+	for _, s := range linkSelectors {
+		doc.Find(s.Selector).Each(func(i int, sel *goquery.Selection) {
+			value, _ := sel.Attr(s.Attribute)
+			u, err := base.Parse(strings.TrimSpace(value))
+			if err != nil {
+				return
+			}
+			if s.Attribute == "href" && staticExtensions[strings.ToLower(path.Ext(u.Path))] {
+				return
+			}
+			link := NormalizeURL(u)
+			if link == "" || seen[link] {
+				return
+			}
+			seen[link] = true
+			links = append(links, link)
+		})
+	}
+	return links, nil
+}
+
+// NormalizeURL returns u without fragment and default port, with a lower case
+// host and a path of at least "/". It returns an empty string for URLs that
+// are not HTTP or HTTPS.
+func NormalizeURL(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+	if (scheme != "http" && scheme != "https") || u.Host == "" {
+		return ""
+	}
+	n := *u
+	n.Scheme = scheme
+	n.Host = strings.ToLower(u.Host)
+	if (scheme == "http" && strings.HasSuffix(n.Host, ":80")) || (scheme == "https" && strings.HasSuffix(n.Host, ":443")) {
+		n.Host = n.Host[:strings.LastIndex(n.Host, ":")]
+	}
+	if n.Path == "" {
+		n.Path = "/"
+	}
+	n.Fragment = ""
+	n.RawFragment = ""
+	n.User = nil
+	return n.String()
+}
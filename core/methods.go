@@ -0,0 +1,64 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HTTPMethods are the HTTP methods an origin advertises in response to an
+// OPTIONS request, and the risky methods that were confirmed to work by
+// testing them. Evidence maps tested methods to the saved request and
+// response.
+type HTTPMethods struct {
+	Allowed  []string          `json:"allowed"`
+	DAV      string            `json:"dav"`
+	TRACE    bool              `json:"trace"`
+	WebDAV   bool              `json:"webdav"`
+	PUT      bool              `json:"put"`
+	DELETE   bool              `json:"delete"`
+	Evidence map[string]string `json:"evidence"`
+}
+
+// ParseAllowHeader returns the methods listed in Allow or Public header
+// values, uppercased and sorted.
+func ParseAllowHeader(values []string) []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, value := range values {
+		for _, method := range strings.Split(value, ",") {
+			method = strings.ToUpper(strings.TrimSpace(method))
+			if method == "" || seen[method] {
+				continue
+			}
+			seen[method] = true
+			methods = append(methods, method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func (m *HTTPMethods) Summary() string {
+	var parts []string
+	if len(m.Allowed) > 0 {
+		parts = append(parts, fmt.Sprintf("Allowed methods: %s", strings.Join(m.Allowed, ", ")))
+	}
+	for _, confirmed := range []struct {
+		enabled bool
+		text    string
+	}{
+		{m.TRACE, "TRACE echoes requests"},
+		{m.WebDAV, "WebDAV is enabled"},
+		{m.PUT, "PUT can create files"},
+		{m.DELETE, "DELETE can remove files"},
+	} {
+		if confirmed.enabled {
+			parts = append(parts, confirmed.text)
+		}
+	}
+	if len(parts) == 0 {
+		return "No allowed methods advertised"
+	}
+	return strings.Join(parts, "; ")
+}
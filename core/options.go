@@ -10,6 +10,11 @@ type Options struct {
 	SessionPath       string
 	TemplatePath      string
 	Proxy             string
+	ProxyList         string
+	ProxyRotation     string
+	ClientCert        string
+	ClientKey         string
+	ClientCertPass    string
 	ChromePath        string
 	Ports             string
 	MatchCodes        string
@@ -17,21 +22,46 @@ type Options struct {
 	FilterString      string
 	ThumbnailSize     string
 	InputFile         string
+	Paths             string
+	SecretsRules      string
+	SecretsIgnore     string
+	PageClasses       string
+	VHostsWordlist    string
+	CDNRanges         string
 	Threads           int
 	Timeout           int
 	ScanTimeout       int
 	HTTPTimeout       int
+	MaxBodySize       int
+	PathsBudget       int
+	CrawlDepth        int
+	CrawlLimit        int
 	ScreenshotTimeout int
 	ScreenshotDelay   int
 	FollowRedirect    bool
 	FullPage          bool
+	ScreenshotMobile  bool
 	Nmap              bool
 	SaveBody          bool
+	ProxyCheck        bool
+	Favicons          bool
+	WellKnown         bool
+	WellKnownPublish  bool
+	Exposures         bool
+	APIDiscovery      bool
+	CollectJS         bool
+	CORS              bool
+	Methods           bool
+	MethodsWrite      bool
+	VHosts            bool
+	Secrets           bool
 	Silent            bool
 	Version           bool
 	Offline           bool
-	Similarity	  float64
+	Similarity        float64
 	HTTPHeaders       []string
+	ClientCertHosts   []string
+	UserAgentVariants []string
 }
 
 func (a *arrayFlags) String() string {
@@ -45,6 +75,8 @@ func (a *arrayFlags) Set(value string) error {
 
 func ParseOptions() (Options, error) {
 	var headers arrayFlags
+	var clientCertHosts arrayFlags
+	var userAgentVariants arrayFlags
 
 	opts := Options{}
 	headers = []string{}
@@ -53,32 +85,64 @@ func ParseOptions() (Options, error) {
 	flag.StringVar(&opts.OutDir, "out", ".", "Directory to write files to")
 	flag.StringVar(&opts.SessionPath, "session", "", "Load Aquatone session file and generate HTML report")
 	flag.StringVar(&opts.TemplatePath, "template-path", "", "Path to HTML template to use for report")
-	flag.StringVar(&opts.Proxy, "proxy", "", "Proxy to use for HTTP requests")
+	flag.StringVar(&opts.Proxy, "proxy", "", "Proxy to use for all connections (http:// or socks5://)")
+	flag.StringVar(&opts.ProxyList, "proxy-list", "", "File with proxies to rotate between (one proxy URL per line)")
+	flag.StringVar(&opts.ProxyRotation, "proxy-rotation", "round-robin", "Proxy rotation strategy: round-robin or sticky (same proxy for each host)")
+	flag.BoolVar(&opts.ProxyCheck, "proxy-check", true, "Check proxies before starting and drop unreachable ones")
+	flag.StringVar(&opts.ClientCert, "client-cert", "", "Client certificate to present to servers requesting one (PEM or PKCS#12)")
+	flag.StringVar(&opts.ClientKey, "client-key", "", "Private key for the PEM client certificate (can be omitted if the key is in the certificate file)")
+	flag.StringVar(&opts.ClientCertPass, "client-cert-password", "", "Password for PKCS#12 client certificates")
 	flag.StringVar(&opts.MatchCodes, "match-codes", "", "Filter hosts that do not return any of these HTTP status codes (seperated by commas)")
 	flag.StringVar(&opts.FilterCodes, "filter-codes", "", "Filter hosts that return any of these HTTP status codes (seperated by commas)")
 	flag.StringVar(&opts.FilterString, "filter-string", "", "Filter host thats have this string in the response body")
 	flag.StringVar(&opts.Ports, "ports", "80,443,8080,8443", "Ports to scan on hosts. Supported list aliases: small, medium, large, xlarge")
 	flag.StringVar(&opts.ThumbnailSize, "thumbnail-size", "", "Screenshot thumbnail size (format: width,height)")
 	flag.StringVar(&opts.InputFile, "input-file", "", "Input file to parse hosts (Nmap or Raw) rather than STDIN")
+	flag.StringVar(&opts.Paths, "paths", "", "Wordlist with paths to probe on every responsive origin")
+	flag.IntVar(&opts.PathsBudget, "paths-budget", 500, "Maximum number of path probe requests per host")
+	flag.IntVar(&opts.CrawlDepth, "crawl-depth", 0, "Follow same-origin links of responsive pages up to this depth (0 to disable crawling)")
+	flag.IntVar(&opts.CrawlLimit, "crawl-limit", 100, "Maximum number of crawled pages per host")
 	flag.IntVar(&opts.Threads, "threads", 0, "Number of concurrent threads (default number of logical CPUs)")
 	flag.IntVar(&opts.Timeout, "timeout", 0, "Generic timeout for everything. (specific timeouts will be ignored if set)")
 	flag.IntVar(&opts.ScanTimeout, "scan-timeout", 3*1000, "Timeout in milliseconds for port scans")
 	flag.IntVar(&opts.HTTPTimeout, "http-timeout", 15*1000, "Timeout in milliseconds for HTTP requests")
+	flag.IntVar(&opts.MaxBodySize, "max-body-size", 10*1024*1024, "Maximum size in bytes of response bodies to save, larger bodies are truncated (0 for no limit)")
 	flag.IntVar(&opts.ScreenshotTimeout, "screenshot-timeout", 40*1000, "Timeout in milliseconds for screenshots")
 	flag.IntVar(&opts.ScreenshotDelay, "screenshot-delay", 0, "Delay in milliseconds before taking screenshots")
 	flag.BoolVar(&opts.FullPage, "full-page", false, "Screenshot full web pages")
+	flag.BoolVar(&opts.ScreenshotMobile, "screenshot-mobile", false, "Also screenshot every page with mobile device emulation")
 	flag.BoolVar(&opts.Nmap, "nmap", false, "Parse input as Nmap/Masscan XML")
 	flag.BoolVar(&opts.FollowRedirect, "follow-redirect", false, "Follow HTTP redirects")
 	flag.BoolVar(&opts.SaveBody, "save-body", true, "Save response bodies to files")
+	flag.BoolVar(&opts.Favicons, "favicons", false, "Fetch and hash the favicon of every responsive page and tag known products")
+	flag.BoolVar(&opts.WellKnown, "well-known", false, "Fetch robots.txt, sitemap.xml, security.txt and openid-configuration once per origin")
+	flag.BoolVar(&opts.WellKnownPublish, "well-known-publish", false, "Request URLs on the same host found in robots.txt and sitemaps (requires -well-known)")
+	flag.BoolVar(&opts.Exposures, "exposures", false, "Check every responsive origin for exposed sensitive files and admin interfaces")
+	flag.BoolVar(&opts.APIDiscovery, "api-discovery", false, "Look for OpenAPI/Swagger documents and GraphQL endpoints on every responsive origin")
+	flag.BoolVar(&opts.CollectJS, "collect-js", false, "Download and analyze the scripts of every responsive page")
+	flag.BoolVar(&opts.CORS, "cors", false, "Probe every responsive page for CORS misconfigurations with crafted Origin headers")
+	flag.BoolVar(&opts.Methods, "methods", false, "Check allowed HTTP methods, TRACE and WebDAV once per origin")
+	flag.BoolVar(&opts.MethodsWrite, "methods-write", false, "Also test PUT and DELETE by uploading and deleting a random file (requires -methods)")
+	flag.StringVar(&opts.CDNRanges, "cdn-ranges", "", "File or directory of WAF and CDN IP range files named after their provider, like cloudflare.txt")
+	flag.BoolVar(&opts.VHosts, "vhosts", false, "Discover virtual hosts on IP targets by sending known hostnames in the Host header and SNI")
+	flag.StringVar(&opts.VHostsWordlist, "vhosts-wordlist", "", "File with hostnames or subdomain labels to also try as virtual hosts (requires -vhosts)")
+	flag.StringVar(&opts.PageClasses, "page-classes", "", "JSON file with page classification rules to use instead of the built-in ones")
+	flag.BoolVar(&opts.Secrets, "secrets", false, "Scan saved bodies, headers and scripts for secrets and credentials")
+	flag.StringVar(&opts.SecretsRules, "secrets-rules", "", "JSON file with additional secret rules (requires -secrets)")
+	flag.StringVar(&opts.SecretsIgnore, "secrets-ignore", "", "File with hashes of secrets to ignore, one per line (requires -secrets)")
 	flag.BoolVar(&opts.Silent, "silent", false, "Suppress all output except for errors")
 	flag.BoolVar(&opts.Version, "version", false, "Print current Aquatone version")
 	flag.BoolVar(&opts.Offline, "offline", false, "Use offline JS files to generate the template report (can be browsed without Internet)")
 	flag.Float64Var(&opts.Similarity, "similarity", 0.85, "Similarity rate for screenshots clustering")
 	flag.Var(&headers, "http-header", "Optional HTTP request header (can be used multiple times for multiple headers)")
+	flag.Var(&userAgentVariants, "user-agent-variant", "Also fetch pages with a User-Agent and compare them, given as mobile, crawler or name=User-Agent (can be used multiple times)")
+	flag.Var(&clientCertHosts, "client-cert-host", "Client certificate for hosts matching a pattern (format: pattern=cert[,key]) (can be used multiple times)")
 
 	flag.Parse()
 
 	opts.HTTPHeaders = headers
+	opts.ClientCertHosts = clientCertHosts
+	opts.UserAgentVariants = userAgentVariants
 	if opts.Timeout != 0 {
 		opts.ScanTimeout = opts.Timeout
 		opts.HTTPTimeout = opts.Timeout
@@ -40,6 +40,10 @@ func (h Header) decreasesSecurity() bool {
 		if h.Value == "*" {
 			return true
 		}
+	case "content-security-policy":
+		if ParseCSP(h.Value, false).Weak() {
+			return true
+		}
 	case "x-xss-protection":
 		if !strings.HasPrefix(h.Value, "1") {
 			return true
@@ -60,6 +64,10 @@ func (h Header) increasesSecurity() bool {
 		return true
 	case "referrer-policy":
 		return true
+	case "permissions-policy", "feature-policy":
+		return true
+	case "cross-origin-opener-policy", "cross-origin-embedder-policy", "cross-origin-resource-policy":
+		return true
 	case "public-key-pins":
 		return true
 	case "x-frame-options":
@@ -104,20 +112,46 @@ type Note struct {
 
 type Page struct {
 	sync.Mutex
-	UUID           string   `json:"uuid"`
-	URL            string   `json:"url"`
-	Hostname       string   `json:"hostname"`
-	Addrs          []string `json:"addrs"`
-	Status         string   `json:"status"`
-	PageTitle      string   `json:"pageTitle"`
-	PageStructure  []string `json:"-"`
-	HeadersPath    string   `json:"headersPath"`
-	BodyPath       string   `json:"bodyPath"`
-	ScreenshotPath string   `json:"screenshotPath"`
-	HasScreenshot  bool     `json:"hasScreenshot"`
-	Headers        []Header `json:"headers"`
-	Tags           []Tag    `json:"tags"`
-	Notes          []Note   `json:"notes"`
+	UUID                 string                 `json:"uuid"`
+	URL                  string                 `json:"url"`
+	Hostname             string                 `json:"hostname"`
+	Addrs                []string               `json:"addrs"`
+	Status               string                 `json:"status"`
+	PageTitle            string                 `json:"pageTitle"`
+	PageStructure        []string               `json:"-"`
+	HeadersPath          string                 `json:"headersPath"`
+	BodyPath             string                 `json:"bodyPath"`
+	TranscriptPath       string                 `json:"transcriptPath"`
+	RemoteAddr           string                 `json:"remoteAddr"`
+	Protocol             string                 `json:"protocol"`
+	Charset              string                 `json:"charset"`
+	Favicon              *Favicon               `json:"favicon"`
+	WellKnown            *WellKnown             `json:"wellKnown"`
+	Timing               *Timing                `json:"timing"`
+	Exposures            []Exposure             `json:"exposures"`
+	API                  *APISurface            `json:"api"`
+	Depth                int                    `json:"depth"`
+	FoundOn              string                 `json:"foundOn"`
+	Links                []string               `json:"links"`
+	JavaScript           *JavaScript            `json:"javascript"`
+	Secrets              []Secret               `json:"secrets"`
+	Classes              []string               `json:"classes"`
+	HeaderAudit          *HeaderAudit           `json:"headerAudit"`
+	CSP                  *ContentSecurityPolicy `json:"csp"`
+	Cookies              []Cookie               `json:"cookies"`
+	CORS                 []CORSFinding          `json:"cors"`
+	Methods              *HTTPMethods           `json:"methods"`
+	SchemeParity         *SchemeParity          `json:"schemeParity"`
+	Auth                 *Authentication        `json:"auth"`
+	WAF                  []WAFMatch             `json:"waf"`
+	UserAgentVariants    []UserAgentVariant     `json:"userAgentVariants"`
+	VirtualHost          *VirtualHost           `json:"virtualHost"`
+	ScreenshotPath       string                 `json:"screenshotPath"`
+	HasScreenshot        bool                   `json:"hasScreenshot"`
+	MobileScreenshotPath string                 `json:"mobileScreenshotPath"`
+	Headers              []Header               `json:"headers"`
+	Tags                 []Tag                  `json:"tags"`
+	Notes                []Note                 `json:"notes"`
 }
 
 func (p *Page) AddHeader(name string, value string) {
@@ -131,6 +165,18 @@ func (p *Page) AddHeader(name string, value string) {
 	p.Headers = append(p.Headers, header)
 }
 
+// GetHeader returns the value of the first response header with name.
+func (p *Page) GetHeader(name string) string {
+	p.Lock()
+	defer p.Unlock()
+	for _, header := range p.Headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
 func (p *Page) AddTag(text string, tagType string, link string) {
 	p.Lock()
 	defer p.Unlock()
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// hstsPreloadMaxAge is the minimum HSTS max-age accepted for the HSTS preload
+// list, one year.
+const hstsPreloadMaxAge = 31536000
+
+// SchemeParity compares the HTTP and HTTPS pages of the same host and path:
+// whether HTTP redirects to HTTPS, whether HTTPS sends an HSTS header that can
+// be preloaded, and, when HTTP is not redirected, how similar the content of
+// both pages is.
+type SchemeParity struct {
+	HTTPURL          string   `json:"httpUrl"`
+	HTTPSURL         string   `json:"httpsUrl"`
+	RedirectsToHTTPS bool     `json:"redirectsToHttps"`
+	RedirectStatus   int      `json:"redirectStatus"`
+	RedirectLocation string   `json:"redirectLocation"`
+	HSTS             string   `json:"hsts"`
+	HSTSPreloadable  bool     `json:"hstsPreloadable"`
+	HSTSIssues       []string `json:"hstsIssues"`
+	Similarity       float64  `json:"similarity"`
+	Findings         []string `json:"findings"`
+}
+
+// HSTSPreloadIssues returns the reasons an HSTS header value doesn't meet the
+// requirements of the HSTS preload list.
+func HSTSPreloadIssues(value string) []string {
+	if value == "" {
+		return []string{"No HSTS header"}
+	}
+	var issues []string
+	maxAge := -1
+	includeSubDomains, preload := false, false
+	for _, directive := range strings.Split(value, ";") {
+		parts := strings.SplitN(strings.TrimSpace(directive), "=", 2)
+		switch strings.ToLower(parts[0]) {
+		case "max-age":
+			if len(parts) == 2 {
+				if n, err := strconv.Atoi(strings.Trim(parts[1], `" `)); err == nil {
+					maxAge = n
+				}
+			}
+		case "includesubdomains":
+			includeSubDomains = true
+		case "preload":
+			preload = true
+		}
+	}
+	if maxAge < hstsPreloadMaxAge {
+		issues = append(issues, "max-age is shorter than one year")
+	}
+	if !includeSubDomains {
+		issues = append(issues, "includeSubDomains is missing")
+	}
+	if !preload {
+		issues = append(issues, "preload is missing")
+	}
+	return issues
+}
+
+func (p *SchemeParity) Summary() string {
+	if len(p.Findings) == 0 {
+		return fmt.Sprintf("HTTP redirects to HTTPS and HSTS can be preloaded (%s)", p.HSTS)
+	}
+	return strings.Join(p.Findings, "; ")
+}
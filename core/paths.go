@@ -0,0 +1,36 @@
+package core
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// ReadPathList reads a wordlist with one path per line. Paths are given a
+// leading slash, and duplicates, blank lines and comments are skipped.
+func ReadPathList(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var paths []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.HasPrefix(line, "/") {
+			line = "/" + line
+		}
+		if seen[line] {
+			continue
+		}
+		seen[line] = true
+		paths = append(paths, line)
+	}
+	return paths, scanner.Err()
+}
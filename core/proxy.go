@@ -0,0 +1,285 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+const (
+	ProxyRotationRoundRobin = "round-robin"
+	ProxyRotationSticky     = "sticky"
+)
+
+// proxyMaxFailures is the number of consecutive failed connections to a
+// proxy after which it is marked as dead.
+const proxyMaxFailures = 3
+
+type Proxy struct {
+	URL      *url.URL
+	dead     uint32
+	failures uint32
+}
+
+// String returns the proxy URL with its password redacted, for output only.
+// Connections must use URL.
+func (p *Proxy) String() string {
+	return p.URL.Redacted()
+}
+
+func (p *Proxy) IsDead() bool {
+	return atomic.LoadUint32(&p.dead) == 1
+}
+
+func (p *Proxy) MarkDead() {
+	atomic.StoreUint32(&p.dead, 1)
+}
+
+// MarkFailed records a failed connection to the proxy and marks it as dead
+// after proxyMaxFailures consecutive failures, so that a single timeout does
+// not take a working proxy out of rotation.
+func (p *Proxy) MarkFailed() {
+	if atomic.AddUint32(&p.failures, 1) >= proxyMaxFailures {
+		p.MarkDead()
+	}
+}
+
+// MarkAlive resets the consecutive failures of the proxy after a successful
+// connection through it.
+func (p *Proxy) MarkAlive() {
+	if p == nil {
+		return
+	}
+	atomic.StoreUint32(&p.failures, 0)
+}
+
+func (p *Proxy) IsSOCKS() bool {
+	return strings.HasPrefix(p.URL.Scheme, "socks5")
+}
+
+// DialTimeout connects to addr through the proxy, using SOCKS5 or an HTTP
+// CONNECT tunnel depending on the proxy scheme. The timeout covers both the
+// connection to the proxy and the handshake with it. The proxy is marked as
+// dead when the proxy itself can't be reached.
+func (p *Proxy) DialTimeout(network string, addr string, timeout time.Duration) (net.Conn, error) {
+	forward := &proxyForwardDialer{proxy: p, timeout: timeout}
+	if p.IsSOCKS() {
+		dialer, err := proxy.FromURL(p.URL, forward)
+		if err != nil {
+			return nil, err
+		}
+		if dialer, ok := dialer.(proxy.ContextDialer); ok {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			return dialer.DialContext(ctx, network, addr)
+		}
+		return dialer.Dial(network, addr)
+	}
+
+	conn, err := forward.Dial("tcp", p.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(timeout))
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if p.URL.User != nil {
+		password, _ := p.URL.User.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(p.URL.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("Proxy %s refused CONNECT to %s: %s", p.URL.Host, addr, resp.Status)
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
+// CheckError records a failed connection to the proxy when err shows that a
+// HTTP client was unable to connect to it. Errors reported by a SOCKS proxy
+// about the target, like an unreachable host, don't count as failures.
+func (p *Proxy) CheckError(err error) {
+	if p == nil || err == nil {
+		return
+	}
+	if strings.Contains(err.Error(), "proxyconnect") {
+		p.MarkFailed()
+		return
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "socks connect" {
+		// Network errors on the connection to the proxy are wrapped as
+		// net.OpError, replies from the proxy are plain errors.
+		var connErr *net.OpError
+		if errors.As(opErr.Err, &connErr) {
+			p.MarkFailed()
+		}
+	}
+}
+
+type proxyForwardDialer struct {
+	proxy   *Proxy
+	timeout time.Duration
+}
+
+func (d *proxyForwardDialer) Dial(network string, addr string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, addr)
+}
+
+func (d *proxyForwardDialer) DialContext(ctx context.Context, network string, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: d.timeout}
+	conn, err := dialer.DialContext(ctx, network, addr)
+	if err != nil {
+		d.proxy.MarkFailed()
+		return nil, err
+	}
+	d.proxy.MarkAlive()
+	return conn, nil
+}
+
+type ProxyPool struct {
+	sync.Mutex
+	Proxies  []*Proxy
+	Rotation string
+	counter  uint32
+	sticky   map[string]*Proxy
+}
+
+func NewProxyPool(proxyURLs []string, rotation string) (*ProxyPool, error) {
+	pool := &ProxyPool{
+		Rotation: rotation,
+		sticky:   make(map[string]*Proxy),
+	}
+	switch rotation {
+	case ProxyRotationRoundRobin, ProxyRotationSticky:
+	default:
+		return nil, fmt.Errorf("Invalid proxy rotation: %s", rotation)
+	}
+
+	for _, proxyURL := range proxyURLs {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("Invalid proxy URL: %s", proxyURL)
+		}
+		switch u.Scheme {
+		case "http", "socks5", "socks5h":
+		default:
+			return nil, fmt.Errorf("Unsupported proxy scheme: %s", proxyURL)
+		}
+		pool.Proxies = append(pool.Proxies, &Proxy{URL: u})
+	}
+	return pool, nil
+}
+
+// ReadProxyList reads proxy URLs from a file with one proxy per line.
+func ReadProxyList(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var proxies []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		proxies = append(proxies, line)
+	}
+	return proxies, scanner.Err()
+}
+
+func (p *ProxyPool) Enabled() bool {
+	return p != nil && len(p.Proxies) > 0
+}
+
+// Live returns the proxies that have not been marked as dead.
+func (p *ProxyPool) Live() []*Proxy {
+	var live []*Proxy
+	for _, proxy := range p.Proxies {
+		if !proxy.IsDead() {
+			live = append(live, proxy)
+		}
+	}
+	return live
+}
+
+// Next returns the proxy to use for a connection to host. It returns nil
+// when no proxies are configured, and an error when all of them are dead
+// so that callers never fall back to a direct connection.
+func (p *ProxyPool) Next(host string) (*Proxy, error) {
+	if !p.Enabled() {
+		return nil, nil
+	}
+	live := p.Live()
+	if len(live) == 0 {
+		return nil, fmt.Errorf("No live proxies left for connection to %s", host)
+	}
+
+	if p.Rotation == ProxyRotationSticky {
+		p.Lock()
+		defer p.Unlock()
+		if proxy, ok := p.sticky[host]; ok && !proxy.IsDead() {
+			return proxy, nil
+		}
+		proxy := live[len(p.sticky)%len(live)]
+		p.sticky[host] = proxy
+		return proxy, nil
+	}
+
+	n := atomic.AddUint32(&p.counter, 1)
+	return live[int(n-1)%len(live)], nil
+}
+
+// Check connects to every proxy in the pool and marks unreachable proxies
+// as dead.
+func (p *ProxyPool) Check(timeout time.Duration) {
+	var wg sync.WaitGroup
+	for _, proxy := range p.Proxies {
+		wg.Add(1)
+		go func(proxy *Proxy) {
+			defer wg.Done()
+			conn, err := net.DialTimeout("tcp", proxy.URL.Host, timeout)
+			if err != nil {
+				proxy.MarkDead()
+				return
+			}
+			conn.Close()
+		}(proxy)
+	}
+	wg.Wait()
+}
+
+type ProxyUsage struct {
+	Proxied uint32 `json:"proxied"`
+	Direct  uint32 `json:"direct"`
+}
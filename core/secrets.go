@@ -0,0 +1,203 @@
+package core
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SecretRule is a regular expression for a kind of secret. When the pattern
+// has a capture group, the first group is the secret, otherwise the whole
+// match is. Matches with a Shannon entropy below Entropy are ignored.
+type SecretRule struct {
+	Name    string  `json:"name"`
+	Pattern string  `json:"pattern"`
+	Entropy float64 `json:"entropy"`
+	regex   *regexp.Regexp
+}
+
+// Secret is a match of a secret rule in a saved file. The hash is the SHA-256
+// hash of the secret, which can be added to an ignore list to suppress known
+// false positives.
+type Secret struct {
+	Rule    string `json:"rule"`
+	File    string `json:"file"`
+	Offset  int    `json:"offset"`
+	Excerpt string `json:"excerpt"`
+	Hash    string `json:"hash"`
+}
+
+// LoadSecretRules loads secret rules in JSON format and compiles their
+// patterns.
+func LoadSecretRules(data []byte) ([]*SecretRule, error) {
+	var rules []*SecretRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, fmt.Errorf("Secret rule with pattern %q has no name", rule.Pattern)
+		}
+		var err error
+		if rule.regex, err = regexp.Compile(rule.Pattern); err != nil {
+			return nil, fmt.Errorf("Invalid pattern for %s: %s", rule.Name, err)
+		}
+	}
+	return rules, nil
+}
+
+// ReadSecretIgnoreList reads a file with one secret hash per line. Blank
+// lines and comments are skipped.
+func ReadSecretIgnoreList(filename string) (map[string]bool, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hashes := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hashes[strings.ToLower(strings.Fields(line)[0])] = true
+	}
+	return hashes, scanner.Err()
+}
+
+// ScanSecrets returns the matches of rules in data, which was read from file.
+// Secrets with a hash in ignore are left out, and so are matches overlapping
+// the match of an earlier rule, so that specific rules take precedence over
+// generic ones.
+func ScanSecrets(rules []*SecretRule, data []byte, file string, ignore map[string]bool) []Secret {
+	var secrets []Secret
+	var spans [][2]int
+	for _, rule := range rules {
+		for _, loc := range rule.regex.FindAllSubmatchIndex(data, -1) {
+			start, end := loc[0], loc[1]
+			if len(loc) > 2 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+			if start == end || overlaps(spans, start, end) {
+				continue
+			}
+			value := data[start:end]
+			if rule.Entropy > 0 && ShannonEntropy(value) < rule.Entropy {
+				continue
+			}
+			hash := fmt.Sprintf("%x", sha256.Sum256(value))
+			if ignore[hash] {
+				continue
+			}
+			spans = append(spans, [2]int{start, end})
+			secrets = append(secrets, Secret{
+				Rule:   rule.Name,
+				File:   file,
+				Offset: start,
+				Hash:   hash,
+			})
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	for i := range secrets {
+		secrets[i].Excerpt = redactedExcerpt(data, secrets[i].Offset, spans)
+	}
+	sort.SliceStable(secrets, func(i, j int) bool { return secrets[i].Offset < secrets[j].Offset })
+	return secrets
+}
+
+func overlaps(spans [][2]int, start int, end int) bool {
+	for _, span := range spans {
+		if start < span[1] && span[0] < end {
+			return true
+		}
+	}
+	return false
+}
+
+// ShannonEntropy returns the Shannon entropy of data in bits per byte.
+func ShannonEntropy(data []byte) float64 {
+	if len(data) == 0 {
+		return 0
+	}
+	var counts [256]int
+	for _, b := range data {
+		counts[b]++
+	}
+	entropy := 0.0
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / float64(len(data))
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// redactedExcerpt returns the secret at offset with some context around it.
+// All secrets in the excerpt are redacted, keeping only their first and last
+// characters.
+func redactedExcerpt(data []byte, offset int, spans [][2]int) string {
+	const context = 40
+	var end int
+	for _, span := range spans {
+		if span[0] == offset {
+			end = span[1]
+		}
+	}
+	from := offset - context
+	if from < 0 {
+		from = 0
+	}
+	to := end + context
+	if to > len(data) {
+		to = len(data)
+	}
+
+	var excerpt strings.Builder
+	pos := from
+	for _, span := range spans {
+		if span[1] <= pos || span[0] >= to {
+			continue
+		}
+		if span[0] > pos {
+			excerpt.WriteString(quote(data[pos:span[0]]))
+		}
+		if span[0] < pos || span[1] > to {
+			// Secrets cut by the edges of the excerpt are hidden entirely.
+			excerpt.WriteString(strings.Repeat("*", min(span[1], to)-max(span[0], pos)))
+		} else {
+			excerpt.WriteString(redact(string(data[span[0]:span[1]])))
+		}
+		pos = min(span[1], to)
+	}
+	if pos < to {
+		excerpt.WriteString(quote(data[pos:to]))
+	}
+	return excerpt.String()
+}
+
+func redact(secret string) string {
+	if len(secret) <= 8 {
+		return strings.Repeat("*", len(secret))
+	}
+	hidden := len(secret) - 6
+	if hidden > 16 {
+		hidden = 16
+	}
+	return secret[:4] + strings.Repeat("*", hidden) + secret[len(secret)-2:]
+}
+
+func quote(data []byte) string {
+	quoted := fmt.Sprintf("%q", data)
+	return quoted[1 : len(quoted)-1]
+}
@@ -22,18 +22,19 @@ import (
 )
 
 type Stats struct {
-	StartedAt            time.Time `json:"startedAt"`
-	FinishedAt           time.Time `json:"finishedAt"`
-	PortOpen             uint32    `json:"portOpen"`
-	PortClosed           uint32    `json:"portClosed"`
-	RequestSuccessful    uint32    `json:"requestSuccessful"`
-	RequestFailed        uint32    `json:"requestFailed"`
-	ResponseCode2xx      uint32    `json:"responseCode2xx"`
-	ResponseCode3xx      uint32    `json:"responseCode3xx"`
-	ResponseCode4xx      uint32    `json:"responseCode4xx"`
-	ResponseCode5xx      uint32    `json:"responseCode5xx"`
-	ScreenshotSuccessful uint32    `json:"screenshotSuccessful"`
-	ScreenshotFailed     uint32    `json:"screenshotFailed"`
+	StartedAt            time.Time                    `json:"startedAt"`
+	FinishedAt           time.Time                    `json:"finishedAt"`
+	PortOpen             uint32                       `json:"portOpen"`
+	PortClosed           uint32                       `json:"portClosed"`
+	RequestSuccessful    uint32                       `json:"requestSuccessful"`
+	RequestFailed        uint32                       `json:"requestFailed"`
+	ResponseCode2xx      uint32                       `json:"responseCode2xx"`
+	ResponseCode3xx      uint32                       `json:"responseCode3xx"`
+	ResponseCode4xx      uint32                       `json:"responseCode4xx"`
+	ResponseCode5xx      uint32                       `json:"responseCode5xx"`
+	ScreenshotSuccessful uint32                       `json:"screenshotSuccessful"`
+	ScreenshotFailed     uint32                       `json:"screenshotFailed"`
+	Timings              map[string]TimingPercentiles `json:"timings"`
 }
 
 func (s *Stats) Duration() time.Duration {
@@ -89,6 +90,18 @@ type Session struct {
 	Pages                  map[string]*Page              `json:"pages"`
 	PageSimilarityClusters map[string][]string           `json:"pageSimilarityClusters"`
 	Ports                  []int                         `json:"-"`
+	Paths                  []string                      `json:"-"`
+	VHostWordlist          []string                      `json:"-"`
+	SecretRules            []*SecretRule                 `json:"-"`
+	SecretIgnore           map[string]bool               `json:"-"`
+	PageClassRules         []*PageClassRule              `json:"-"`
+	WAFRules               []*WAFRule                    `json:"-"`
+	IPRanges               []IPRange                     `json:"-"`
+	UserAgentVariants      []UserAgentVariant            `json:"-"`
+	VirtualHosts           map[string]*VirtualHost       `json:"-"`
+	ClientCertificates     *ClientCertificates           `json:"-"`
+	Proxies                *ProxyPool                    `json:"-"`
+	ProxyUsage             map[string]*ProxyUsage        `json:"proxyUsage"`
 	EventBus               EventBus.Bus                  `json:"-"`
 	WaitGroup              sizedwaitgroup.SizedWaitGroup `json:"-"`
 }
@@ -96,9 +109,19 @@ type Session struct {
 func (s *Session) Start() {
 	s.Pages = make(map[string]*Page)
 	s.PageSimilarityClusters = make(map[string][]string)
+	s.ProxyUsage = make(map[string]*ProxyUsage)
+	s.VirtualHosts = make(map[string]*VirtualHost)
 	s.initStats()
 	s.initLogger()
 	s.initPorts()
+	s.initPaths()
+	s.initVHostWordlist()
+	s.initSecrets()
+	s.initPageClassRules()
+	s.initWAFRules()
+	s.initUserAgentVariants()
+	s.initClientCertificates()
+	s.initProxies()
 	s.initThreads()
 	s.initEventBus()
 	s.initWaitGroup()
@@ -209,6 +232,205 @@ func (s *Session) initPorts() {
 	s.Ports = ports
 }
 
+func (s *Session) initClientCertificates() {
+	s.ClientCertificates = &ClientCertificates{}
+	if s.Options.ClientCert != "" {
+		cert, err := LoadClientCertificate(s.Options.ClientCert, s.Options.ClientKey, s.Options.ClientCertPass)
+		if err != nil {
+			s.Out.Fatal("%s\n", err)
+			os.Exit(1)
+		}
+		s.ClientCertificates.Default = cert
+	}
+
+	for _, mapping := range s.Options.ClientCertHosts {
+		pattern, certFile, keyFile, err := ParseClientCertificateHost(mapping)
+		if err != nil {
+			s.Out.Fatal("%s\n", err)
+			os.Exit(1)
+		}
+		cert, err := LoadClientCertificate(certFile, keyFile, s.Options.ClientCertPass)
+		if err != nil {
+			s.Out.Fatal("%s\n", err)
+			os.Exit(1)
+		}
+		s.ClientCertificates.Hosts = append(s.ClientCertificates.Hosts, hostCertificate{
+			Pattern:     pattern,
+			Certificate: cert,
+		})
+	}
+}
+
+func (s *Session) initPaths() {
+	if s.Options.Paths == "" {
+		return
+	}
+	paths, err := ReadPathList(s.Options.Paths)
+	if err != nil {
+		s.Out.Fatal("Unable to read paths wordlist %s: %s\n", s.Options.Paths, err)
+		os.Exit(1)
+	}
+	if len(paths) == 0 {
+		s.Out.Fatal("Paths wordlist %s is empty\n", s.Options.Paths)
+		os.Exit(1)
+	}
+	s.Paths = paths
+}
+
+func (s *Session) initVHostWordlist() {
+	if s.Options.VHostsWordlist == "" {
+		return
+	}
+	words, err := ReadVirtualHostList(s.Options.VHostsWordlist)
+	if err != nil {
+		s.Out.Fatal("Unable to read virtual hosts wordlist %s: %s\n", s.Options.VHostsWordlist, err)
+		os.Exit(1)
+	}
+	s.VHostWordlist = words
+}
+
+func (s *Session) initSecrets() {
+	if !s.Options.Secrets {
+		return
+	}
+	data, err := s.Asset("static/secrets.json")
+	if err != nil {
+		s.Out.Fatal("Unable to read secret rules: %s\n", err)
+		os.Exit(1)
+	}
+	if s.SecretRules, err = LoadSecretRules(data); err != nil {
+		s.Out.Fatal("Unable to load secret rules: %s\n", err)
+		os.Exit(1)
+	}
+	if s.Options.SecretsRules != "" {
+		data, err := ioutil.ReadFile(s.Options.SecretsRules)
+		if err != nil {
+			s.Out.Fatal("Unable to read secret rules file %s: %s\n", s.Options.SecretsRules, err)
+			os.Exit(1)
+		}
+		rules, err := LoadSecretRules(data)
+		if err != nil {
+			s.Out.Fatal("Unable to load secret rules from %s: %s\n", s.Options.SecretsRules, err)
+			os.Exit(1)
+		}
+		s.SecretRules = append(s.SecretRules, rules...)
+	}
+	if s.Options.SecretsIgnore != "" {
+		if s.SecretIgnore, err = ReadSecretIgnoreList(s.Options.SecretsIgnore); err != nil {
+			s.Out.Fatal("Unable to read secret ignore list %s: %s\n", s.Options.SecretsIgnore, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func (s *Session) initPageClassRules() {
+	var data []byte
+	var err error
+	if s.Options.PageClasses != "" {
+		data, err = ioutil.ReadFile(s.Options.PageClasses)
+	} else {
+		data, err = s.Asset("static/page_classes.json")
+	}
+	if err != nil {
+		s.Out.Fatal("Unable to read page classification rules: %s\n", err)
+		os.Exit(1)
+	}
+	if s.PageClassRules, err = LoadPageClassRules(data); err != nil {
+		s.Out.Fatal("Unable to load page classification rules: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func (s *Session) initWAFRules() {
+	data, err := s.Asset("static/wafs.json")
+	if err != nil {
+		s.Out.Fatal("Unable to read WAF rules: %s\n", err)
+		os.Exit(1)
+	}
+	if s.WAFRules, err = LoadWAFRules(data); err != nil {
+		s.Out.Fatal("Unable to load WAF rules: %s\n", err)
+		os.Exit(1)
+	}
+	if s.Options.CDNRanges != "" {
+		if s.IPRanges, err = LoadIPRanges(s.Options.CDNRanges); err != nil {
+			s.Out.Fatal("Unable to read IP ranges from %s: %s\n", s.Options.CDNRanges, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func (s *Session) initUserAgentVariants() {
+	for _, value := range s.Options.UserAgentVariants {
+		variant, err := ParseUserAgentVariant(value)
+		if err != nil {
+			s.Out.Fatal("%s\n", err)
+			os.Exit(1)
+		}
+		s.UserAgentVariants = append(s.UserAgentVariants, variant)
+	}
+}
+
+func (s *Session) initProxies() {
+	var proxies []string
+	if s.Options.Proxy != "" {
+		proxies = append(proxies, s.Options.Proxy)
+	}
+	if s.Options.ProxyList != "" {
+		list, err := ReadProxyList(s.Options.ProxyList)
+		if err != nil {
+			s.Out.Fatal("Unable to read proxy list %s: %s\n", s.Options.ProxyList, err)
+			os.Exit(1)
+		}
+		proxies = append(proxies, list...)
+	}
+
+	pool, err := NewProxyPool(proxies, s.Options.ProxyRotation)
+	if err != nil {
+		s.Out.Fatal("%s\n", err)
+		os.Exit(1)
+	}
+	s.Proxies = pool
+
+	if pool.Enabled() && s.Options.VHosts {
+		// Proxies resolve hostnames themselves, so requests for virtual
+		// hosts would not reach the address they were found on.
+		s.Out.Fatal("Virtual host discovery can't be used with proxies\n")
+		os.Exit(1)
+	}
+
+	if !pool.Enabled() || !s.Options.ProxyCheck {
+		return
+	}
+	pool.Check(time.Duration(s.Options.ScanTimeout) * time.Millisecond)
+	for _, proxy := range pool.Proxies {
+		if proxy.IsDead() {
+			s.Out.Warn("Proxy %s is unreachable and will not be used\n", proxy)
+		}
+	}
+	if len(pool.Live()) == 0 {
+		s.Out.Fatal("None of the given proxies are reachable\n")
+		os.Exit(1)
+	}
+}
+
+// RecordProxyUse records whether a connection made by component went
+// through a proxy or directly to the target.
+func (s *Session) RecordProxyUse(component string, proxied bool) {
+	s.Lock()
+	usage, ok := s.ProxyUsage[component]
+	if !ok {
+		usage = &ProxyUsage{}
+		s.ProxyUsage[component] = usage
+	}
+	s.Unlock()
+
+	if proxied {
+		atomic.AddUint32(&usage.Proxied, 1)
+	} else {
+		atomic.AddUint32(&usage.Direct, 1)
+	}
+}
+
 func (s *Session) initLogger() {
 	s.Out = &Logger{}
 	s.Out.SetDebugLog(s.GetFilePath("aquatone_log.log"))
@@ -231,7 +453,21 @@ func (s *Session) initWaitGroup() {
 }
 
 func (s *Session) initDirectories() {
-	for _, d := range []string{"headers", "html", "screenshots"} {
+	directories := []string{"headers", "html", "screenshots", "transcripts"}
+	for d, enabled := range map[string]bool{
+		"favicons":  s.Options.Favicons,
+		"wellknown": s.Options.WellKnown,
+		"exposures": s.Options.Exposures,
+		"api":       s.Options.APIDiscovery,
+		"js":        s.Options.CollectJS,
+		"cors":      s.Options.CORS,
+		"methods":   s.Options.Methods,
+	} {
+		if enabled {
+			directories = append(directories, d)
+		}
+	}
+	for _, d := range directories {
 		d = s.GetFilePath(d)
 		if _, err := os.Stat(d); os.IsNotExist(err) {
 			err = os.MkdirAll(d, 0755)
@@ -271,6 +507,20 @@ func (s *Session) ReadFile(p string) ([]byte, error) {
 	return content, nil
 }
 
+// ReadBody reads the saved response body of page and converts it to UTF-8.
+// The file on disk keeps the original bytes.
+func (s *Session) ReadBody(page *Page) ([]byte, error) {
+	body, err := s.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+	if err != nil {
+		return nil, err
+	}
+	name := page.Charset
+	if name == "" {
+		name = DetectCharset(body, page.GetHeader("Content-Type"))
+	}
+	return DecodeCharset(body, name), nil
+}
+
 func (s *Session) ToJSON() string {
 	sessionJSON, _ := json.Marshal(s)
 	return string(sessionJSON)
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"math"
+	"sort"
+)
+
+// Timing holds the duration in milliseconds of each phase of the request
+// for a page.
+type Timing struct {
+	DNSLookup    float64 `json:"dnsLookup"`
+	TCPConnect   float64 `json:"tcpConnect"`
+	TLSHandshake float64 `json:"tlsHandshake"`
+	FirstByte    float64 `json:"firstByte"`
+	Download     float64 `json:"download"`
+	Total        float64 `json:"total"`
+}
+
+type TimingPercentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+var TimingPhases = []string{"dnsLookup", "tcpConnect", "tlsHandshake", "firstByte", "download", "total"}
+
+func (t *Timing) Phase(name string) float64 {
+	switch name {
+	case "dnsLookup":
+		return t.DNSLookup
+	case "tcpConnect":
+		return t.TCPConnect
+	case "tlsHandshake":
+		return t.TLSHandshake
+	case "firstByte":
+		return t.FirstByte
+	case "download":
+		return t.Download
+	case "total":
+		return t.Total
+	}
+	return 0
+}
+
+// CalculateTimings aggregates the request timings of pages into percentiles
+// for each phase. Phases that did not happen for a page are not counted.
+func (s *Stats) CalculateTimings(pages map[string]*Page) {
+	s.Timings = make(map[string]TimingPercentiles)
+	for _, phase := range TimingPhases {
+		var samples []float64
+		for _, page := range pages {
+			if page.Timing == nil {
+				continue
+			}
+			if v := page.Timing.Phase(phase); v > 0 {
+				samples = append(samples, v)
+			}
+		}
+		if len(samples) == 0 {
+			continue
+		}
+		sort.Float64s(samples)
+		s.Timings[phase] = TimingPercentiles{
+			P50: percentile(samples, 50),
+			P90: percentile(samples, 90),
+			P95: percentile(samples, 95),
+			P99: percentile(samples, 99),
+			Max: samples[len(samples)-1],
+		}
+	}
+}
+
+// percentile returns the nearest-rank percentile p of sorted samples.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DesktopUserAgent is sent with page requests when pages are also fetched
+// with other User-Agents, so that all variants are compared with the same
+// desktop browser.
+const DesktopUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+// builtinUserAgents are the User-Agents that can be given by name.
+var builtinUserAgents = map[string]string{
+	"mobile":  "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1",
+	"crawler": "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+}
+
+// UserAgentVariant is the response of a page to a request with another
+// User-Agent, compared with the response to the desktop browser.
+type UserAgentVariant struct {
+	Name        string   `json:"name"`
+	UserAgent   string   `json:"userAgent"`
+	Status      string   `json:"status"`
+	Location    string   `json:"location"`
+	BodyPath    string   `json:"bodyPath"`
+	Similarity  float64  `json:"similarity"`
+	Differs     bool     `json:"differs"`
+	Differences []string `json:"differences"`
+}
+
+// ParseUserAgentVariant parses a User-Agent variant given as one of the
+// built-in names or as name=User-Agent.
+func ParseUserAgentVariant(value string) (UserAgentVariant, error) {
+	value = strings.TrimSpace(value)
+	if parts := strings.SplitN(value, "=", 2); len(parts) == 2 {
+		name, userAgent := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+		if name == "" || userAgent == "" {
+			return UserAgentVariant{}, fmt.Errorf("Invalid User-Agent variant %q, expected name=User-Agent", value)
+		}
+		return UserAgentVariant{Name: name, UserAgent: userAgent}, nil
+	}
+	userAgent, ok := builtinUserAgents[strings.ToLower(value)]
+	if !ok {
+		return UserAgentVariant{}, fmt.Errorf("Unknown User-Agent variant %q, use mobile, crawler or name=User-Agent", value)
+	}
+	return UserAgentVariant{Name: strings.ToLower(value), UserAgent: userAgent}, nil
+}
+
+func (v *UserAgentVariant) Summary() string {
+	if !v.Differs {
+		return fmt.Sprintf("%s User-Agent gets the same page (%.0f%% similar)", v.Name, v.Similarity*100)
+	}
+	return fmt.Sprintf("%s User-Agent gets a different page: %s", v.Name, strings.Join(v.Differences, ", "))
+}
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"bufio"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// VirtualHost is a virtual host found by sending another Host header (and SNI
+// value over HTTPS) to an IP address. Requests for the hostname on the same
+// port are sent to Addr, as the hostname may not resolve to it.
+type VirtualHost struct {
+	Hostname   string  `json:"hostname"`
+	Addr       string  `json:"addr"`
+	BaseURL    string  `json:"baseUrl"`
+	Status     string  `json:"status"`
+	Similarity float64 `json:"similarity"`
+}
+
+// ReadVirtualHostList reads a wordlist with one hostname or subdomain label
+// per line, skipping duplicates, blank lines and comments.
+func ReadVirtualHostList(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var words []string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		words = append(words, line)
+	}
+	return words, scanner.Err()
+}
+
+// VirtualHostCandidates returns the hostnames to try as virtual hosts: the
+// known hostnames, hostnames from the wordlist, and subdomain labels from the
+// wordlist under the registered domains of the known hostnames.
+func VirtualHostCandidates(known []string, wordlist []string) []string {
+	candidates := make(map[string]bool)
+	domains := make(map[string]bool)
+	for _, hostname := range known {
+		hostname = strings.TrimSuffix(strings.ToLower(hostname), ".")
+		if hostname == "" || net.ParseIP(hostname) != nil {
+			continue
+		}
+		candidates[hostname] = true
+		if domain, err := publicsuffix.EffectiveTLDPlusOne(hostname); err == nil {
+			domains[domain] = true
+			candidates[domain] = true
+		}
+	}
+	for _, word := range wordlist {
+		if strings.Contains(word, ".") {
+			candidates[word] = true
+			continue
+		}
+		for domain := range domains {
+			candidates[word+"."+domain] = true
+		}
+	}
+
+	var hostnames []string
+	for hostname := range candidates {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return hostnames
+}
+
+// AddVirtualHost records a virtual host, so that requests for its hostname
+// and port are sent to its address. It returns false if the hostname and
+// port are already mapped to an address.
+func (s *Session) AddVirtualHost(vhost *VirtualHost, port string) bool {
+	s.Lock()
+	defer s.Unlock()
+	key := net.JoinHostPort(vhost.Hostname, port)
+	if _, ok := s.VirtualHosts[key]; ok {
+		return false
+	}
+	s.VirtualHosts[key] = vhost
+	return true
+}
+
+// GetVirtualHost returns the virtual host of a host and port address, or nil
+// if it isn't one.
+func (s *Session) GetVirtualHost(addr string) *VirtualHost {
+	s.RLock()
+	defer s.RUnlock()
+	return s.VirtualHosts[strings.ToLower(addr)]
+}
+
+// GetVirtualHostForURL returns the virtual host a URL is served by, or nil if
+// it isn't served by one.
+func (s *Session) GetVirtualHostForURL(u *url.URL) *VirtualHost {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return s.GetVirtualHost(net.JoinHostPort(u.Hostname(), port))
+}
@@ -0,0 +1,212 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ipRangeToken splits the contents of IP range files into tokens that may be
+// addresses or networks, so both plain lists and JSON files can be read.
+var ipRangeToken = regexp.MustCompile(`[0-9A-Fa-f:.]+(/[0-9]+)?`)
+
+// WAFRule identifies a WAF, CDN or load balancer in front of a page by its
+// response headers, cookie names or block page. A rule matches if any of its
+// header, cookie or body conditions match; the body condition is only checked
+// for the given status codes, if any.
+type WAFRule struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Header   string `json:"header"`
+	Cookie   string `json:"cookie"`
+	Body     string `json:"body"`
+	Status   []int  `json:"status"`
+	header   *regexp.Regexp
+	cookie   *regexp.Regexp
+	body     *regexp.Regexp
+}
+
+// WAFMatch is a WAF, CDN or load balancer detected in front of a page, with
+// the evidence it was detected by.
+type WAFMatch struct {
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Evidence []string `json:"evidence"`
+}
+
+// IPRange is a network belonging to a WAF or CDN provider.
+type IPRange struct {
+	Name    string
+	Network *net.IPNet
+}
+
+// LoadWAFRules loads WAF and CDN detection rules in JSON format and compiles
+// their regular expressions.
+func LoadWAFRules(data []byte) ([]*WAFRule, error) {
+	var rules []*WAFRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.Name == "" || rule.Category == "" {
+			return nil, fmt.Errorf("WAF rule without name or category")
+		}
+		if rule.Header == "" && rule.Cookie == "" && rule.Body == "" {
+			return nil, fmt.Errorf("WAF rule %s has no header, cookie or body condition", rule.Name)
+		}
+		var err error
+		for _, p := range []struct {
+			pattern string
+			regex   **regexp.Regexp
+		}{
+			{rule.Header, &rule.header},
+			{rule.Cookie, &rule.cookie},
+			{rule.Body, &rule.body},
+		} {
+			if p.pattern == "" {
+				continue
+			}
+			if *p.regex, err = regexp.Compile(p.pattern); err != nil {
+				return nil, fmt.Errorf("Invalid pattern for %s: %s", rule.Name, err)
+			}
+		}
+	}
+	return rules, nil
+}
+
+// LoadIPRanges reads IP range files from a file or directory. Every file
+// holds the ranges of one provider, named after the file without its
+// extension, like cloudflare.txt. Any addresses and networks in a file are
+// read, so the lists and JSON files published by providers can be used as
+// they are.
+func LoadIPRanges(path string) ([]IPRange, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(path, "*")); err != nil {
+			return nil, err
+		}
+	}
+
+	var ranges []IPRange
+	for _, file := range files {
+		if info, err := os.Stat(file); err != nil || info.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		for _, token := range ipRangeToken.FindAllString(string(data), -1) {
+			if _, network, err := net.ParseCIDR(token); err == nil {
+				ranges = append(ranges, IPRange{Name: name, Network: network})
+			} else if ip := net.ParseIP(token); ip != nil {
+				bits := 128
+				if ip.To4() != nil {
+					bits = 32
+				}
+				ranges = append(ranges, IPRange{Name: name, Network: &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}})
+			}
+		}
+	}
+	return ranges, nil
+}
+
+// DetectWAF returns the WAFs, CDNs and load balancers identified from the
+// response of a page and the addresses it resolves to. Providers found in
+// the IP ranges are named after a rule with the same name, ignoring case and
+// punctuation, and are otherwise listed as CDN.
+func DetectWAF(rules []*WAFRule, ranges []IPRange, status int, headers []Header, cookies []Cookie, body []byte, addrs []string) []WAFMatch {
+	var lines []string
+	for _, h := range headers {
+		lines = append(lines, fmt.Sprintf("%s: %s", h.Name, h.Value))
+	}
+	sort.Strings(lines)
+	header := strings.Join(lines, "\n")
+
+	var matches []WAFMatch
+	add := func(name string, category string, evidence string) {
+		for i := range matches {
+			if matches[i].Name == name && matches[i].Category == category {
+				for _, e := range matches[i].Evidence {
+					if e == evidence {
+						return
+					}
+				}
+				matches[i].Evidence = append(matches[i].Evidence, evidence)
+				return
+			}
+		}
+		matches = append(matches, WAFMatch{Name: name, Category: category, Evidence: []string{evidence}})
+	}
+
+	for _, rule := range rules {
+		if rule.header != nil {
+			if m := rule.header.FindString(header); m != "" {
+				add(rule.Name, rule.Category, "Header: "+strings.TrimSpace(m))
+			}
+		}
+		if rule.cookie != nil {
+			for _, c := range cookies {
+				if rule.cookie.MatchString(c.Name) {
+					add(rule.Name, rule.Category, "Cookie: "+c.Name)
+				}
+			}
+		}
+		if rule.body != nil && rule.matchStatus(status) && rule.body.Match(body) {
+			add(rule.Name, rule.Category, fmt.Sprintf("Block page (status %d)", status))
+		}
+	}
+
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			continue
+		}
+		for _, r := range ranges {
+			if !r.Network.Contains(ip) {
+				continue
+			}
+			name, category := r.Name, "CDN"
+			for _, rule := range rules {
+				if wafNameKey(rule.Name) == wafNameKey(r.Name) {
+					name, category = rule.Name, rule.Category
+					break
+				}
+			}
+			add(name, category, fmt.Sprintf("IP range: %s in %s", ip, r.Network))
+		}
+	}
+	return matches
+}
+
+func (r *WAFRule) matchStatus(status int) bool {
+	if len(r.Status) == 0 {
+		return true
+	}
+	for _, code := range r.Status {
+		if code == status {
+			return true
+		}
+	}
+	return false
+}
+
+func wafNameKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(name))
+}
@@ -0,0 +1,151 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+const (
+	RobotsTxt           = "robots.txt"
+	SitemapXML          = "sitemap.xml"
+	SecurityTxt         = "security.txt"
+	OpenIDConfiguration = "openid-configuration"
+)
+
+type WellKnownFile struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	Path string `json:"path"`
+}
+
+// WellKnown holds the well-known metadata files found on the origin of a
+// page and what was parsed out of them.
+type WellKnown struct {
+	Files            []WellKnownFile `json:"files"`
+	DisallowedPaths  []string        `json:"disallowedPaths"`
+	SitemapURLs      []string        `json:"sitemapUrls"`
+	SecurityContacts []string        `json:"securityContacts"`
+	OpenIDIssuer     string          `json:"openidIssuer"`
+}
+
+// Summary returns a short description of the files found.
+func (w *WellKnown) Summary() string {
+	found := make(map[string]bool)
+	for _, f := range w.Files {
+		if strings.HasPrefix(f.Name, "sitemap") {
+			found[SitemapXML] = true
+		} else {
+			found[f.Name] = true
+		}
+	}
+
+	var parts []string
+	if found[RobotsTxt] {
+		parts = append(parts, pluralize(len(w.DisallowedPaths), "disallowed path", "disallowed paths")+" in robots.txt")
+	}
+	if found[SitemapXML] {
+		parts = append(parts, pluralize(len(w.SitemapURLs), "URL", "URLs")+" in sitemaps")
+	}
+	if found[SecurityTxt] {
+		parts = append(parts, "security.txt contacts: "+strings.Join(w.SecurityContacts, ", "))
+	}
+	if found[OpenIDConfiguration] {
+		parts = append(parts, "OpenID Connect issuer "+w.OpenIDIssuer)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func pluralize(n int, singular string, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+// ParseRobotsTxt returns the disallowed paths and sitemap URLs listed in a
+// robots.txt file.
+func ParseRobotsTxt(data []byte) (disallowed []string, sitemaps []string) {
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		if value == "" {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "disallow":
+			if !seen[value] {
+				seen[value] = true
+				disallowed = append(disallowed, value)
+			}
+		case "sitemap":
+			sitemaps = append(sitemaps, value)
+		}
+	}
+	return disallowed, sitemaps
+}
+
+type sitemapDocument struct {
+	URLs     []sitemapLoc `xml:"url"`
+	Sitemaps []sitemapLoc `xml:"sitemap"`
+}
+
+type sitemapLoc struct {
+	Loc string `xml:"loc"`
+}
+
+// ParseSitemap returns the page URLs and the nested sitemap URLs listed in a
+// sitemap or sitemap index.
+func ParseSitemap(data []byte) (urls []string, sitemaps []string, err error) {
+	var doc sitemapDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	for _, u := range doc.URLs {
+		if loc := strings.TrimSpace(u.Loc); loc != "" {
+			urls = append(urls, loc)
+		}
+	}
+	for _, s := range doc.Sitemaps {
+		if loc := strings.TrimSpace(s.Loc); loc != "" {
+			sitemaps = append(sitemaps, loc)
+		}
+	}
+	return urls, sitemaps, nil
+}
+
+// ParseSecurityTxt returns the Contact fields of a security.txt file.
+func ParseSecurityTxt(data []byte) (contacts []string) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) == 2 && strings.EqualFold(strings.TrimSpace(parts[0]), "contact") {
+			contacts = append(contacts, strings.TrimSpace(parts[1]))
+		}
+	}
+	return contacts
+}
+
+// ParseOpenIDConfiguration returns the issuer of an OpenID Connect discovery
+// document.
+func ParseOpenIDConfiguration(data []byte) (string, error) {
+	var config struct {
+		Issuer string `json:"issuer"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return "", err
+	}
+	return config.Issuer, nil
+}
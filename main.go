@@ -2,11 +2,13 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 	"io"
@@ -120,8 +122,23 @@ func main() {
 	agents.NewURLPageTitleExtractor().Register(sess)
 	agents.NewURLScreenshotter().Register(sess)
 	agents.NewURLTechnologyFingerprinter().Register(sess)
+	agents.NewURLPageClassifier().Register(sess)
+	agents.NewURLAuthDetector().Register(sess)
+	agents.NewURLWAFDetector().Register(sess)
 	agents.NewURLTakeoverDetector().Register(sess)
 	agents.NewURLTlsChecker().Register(sess)
+	agents.NewURLFaviconFetcher().Register(sess)
+	agents.NewURLWellKnownFetcher().Register(sess)
+	agents.NewURLPathProber().Register(sess)
+	agents.NewURLVHostDiscoverer().Register(sess)
+	agents.NewURLExposureChecker().Register(sess)
+	agents.NewURLAPIDiscoverer().Register(sess)
+	agents.NewURLCrawler().Register(sess)
+	agents.NewURLJSCollector().Register(sess)
+	agents.NewURLCORSProber().Register(sess)
+	agents.NewURLMethodChecker().Register(sess)
+	agents.NewSecretScanner().Register(sess)
+	agents.NewSchemeParityChecker().Register(sess)
 
 	var reader io.Reader
 	if sess.Options.InputFile != "" {
@@ -163,6 +180,12 @@ func main() {
 	sess.Out.Important(" :: Targets          : %d\n", len(targets))
 	sess.Out.Important(" :: Threads          : %d\n", sess.Options.Threads)
 	sess.Out.Important(" :: Ports            : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	if sess.Proxies.Enabled() {
+		sess.Out.Important(" :: Proxies          : %d (%s)\n", len(sess.Proxies.Live()), sess.Options.ProxyRotation)
+	}
+	if len(sess.Paths) > 0 {
+		sess.Out.Important(" :: Paths            : %d (budget %d per host)\n", len(sess.Paths), sess.Options.PathsBudget)
+	}
 	sess.Out.Important(" :: Output Directory : %s\n\n", sess.Options.OutDir)
 
 	sess.EventBus.Publish(core.SessionStart)
@@ -189,16 +212,16 @@ func main() {
 	sess.Out.Important("Calculating page structures...")
 	f, _ := os.OpenFile(sess.GetFilePath("aquatone_urls.txt"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	for _, page := range sess.Pages {
-		filename := sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))
-		body, err := os.Open(filename)
+		body, err := sess.ReadBody(page)
 		if err != nil {
 			continue
 		}
-		structure, _ := core.GetPageStructure(body)
+		structure, _ := core.GetPageStructure(bytes.NewReader(body))
 		page.PageStructure = structure
 		f.WriteString(page.URL + "\n")
 	}
 	f.Close()
+	sess.Stats.CalculateTimings(sess.Pages)
 	sess.Out.Important(" done\n")
 
 	sess.Out.Important("Clustering similar pages...")
@@ -261,6 +284,11 @@ func main() {
 		sess.Out.Error("Failed!\n")
 		sess.Out.Debug("Error: %v\n", err)
 	}
+	err = sess.SaveCookiesToFile("aquatone_cookies.csv")
+	if err != nil {
+		sess.Out.Error("Failed to write cookies file!\n")
+		sess.Out.Debug("Error: %v\n", err)
+	}
 
 	sess.Out.Important("Time:\n")
 	sess.Out.Info(" - Started at  : %v\n", sess.Stats.StartedAt.Format(time.RFC3339))
@@ -276,9 +304,33 @@ func main() {
 	sess.Out.Info(" - 4xx : %v\n", sess.Stats.ResponseCode4xx)
 	sess.Out.Info(" - 5xx : %v\n\n", sess.Stats.ResponseCode5xx)
 
+	if len(sess.Stats.Timings) > 0 {
+		sess.Out.Important("Timings (p50 / p95 / max):\n")
+		for _, phase := range core.TimingPhases {
+			if t, ok := sess.Stats.Timings[phase]; ok {
+				sess.Out.Info(" - %-12s : %.0f / %.0f / %.0f ms\n", phase, t.P50, t.P95, t.Max)
+			}
+		}
+		sess.Out.Info("\n")
+	}
+
 	sess.Out.Important("Screenshots:\n")
 	sess.Out.Info(" - Successful : %v\n", sess.Stats.ScreenshotSuccessful)
 	sess.Out.Info(" - Failed     : %v\n\n", sess.Stats.ScreenshotFailed)
 
+	if sess.Proxies.Enabled() {
+		sess.Out.Important("Proxy usage:\n")
+		components := make([]string, 0, len(sess.ProxyUsage))
+		for component := range sess.ProxyUsage {
+			components = append(components, component)
+		}
+		sort.Strings(components)
+		for _, component := range components {
+			usage := sess.ProxyUsage[component]
+			sess.Out.Info(" - %-32s : %v proxied, %v direct\n", component, usage.Proxied, usage.Direct)
+		}
+		sess.Out.Info(" - Live proxies : %d/%d\n\n", len(sess.Proxies.Live()), len(sess.Proxies.Proxies))
+	}
+
 	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
 }